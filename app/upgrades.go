@@ -18,6 +18,10 @@ import (
 // v0.47.x to v0.50.x.
 const UpgradeName = "v047-to-v050"
 
+// EscrowV2UpgradeName defines the on-chain upgrade name for the migration of
+// x/escrow from the consensus version 1 to 2.
+const EscrowV2UpgradeName = "escrow-v1-to-v2"
+
 func (app App) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
 		UpgradeName,
@@ -26,6 +30,14 @@ func (app App) RegisterUpgradeHandlers() {
 		},
 	)
 
+	// no store upgrades, as x/escrow keeps its store
+	app.UpgradeKeeper.SetUpgradeHandler(
+		EscrowV2UpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
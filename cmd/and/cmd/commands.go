@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
@@ -31,6 +33,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
 	"github.com/0tech/andromeda/app"
 )
@@ -143,12 +146,60 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 func genesisCommand(txConfig client.TxConfig, basicManager module.BasicManager, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome)
 
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == "validate" {
+			wrapValidateGenesisCmd(subCmd, basicManager)
+		}
+	}
+
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
 	}
 	return cmd
 }
 
+// genesisAccountsValidator is implemented by the modules whose genesis
+// states depend on the accounts in the genesis state of x/auth.
+type genesisAccountsValidator interface {
+	ValidateGenesisAccounts(cdc codec.JSONCodec, genesis map[string]json.RawMessage) error
+}
+
+// wrapValidateGenesisCmd makes the genesis validation command also check the
+// accounts the modules depend on, which the validation of each module cannot
+// see.
+func wrapValidateGenesisCmd(cmd *cobra.Command, basicManager module.BasicManager) {
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		clientCtx := client.GetClientContextFromCmd(cmd)
+
+		genesis := serverCtx.Config.GenesisFile()
+		if len(args) != 0 {
+			genesis = args[0]
+		}
+
+		appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+		if err != nil {
+			return err
+		}
+
+		var genState map[string]json.RawMessage
+		if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+			return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
+		}
+
+		for _, mod := range basicManager {
+			if validator, ok := mod.(genesisAccountsValidator); ok {
+				if err := validator.ValidateGenesisAccounts(clientCtx.Codec, genState); err != nil {
+					return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+				}
+			}
+		}
+
+		return runE(cmd, args)
+	}
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
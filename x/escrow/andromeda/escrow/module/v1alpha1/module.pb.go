@@ -27,6 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Module struct {
 	// authority defines the custom module authority. If not set, defaults to the governance module.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hooks_order specifies the order of the escrow hooks and should be a list
+	// of module names which provide an escrow hooks instance. If no order is
+	// provided, then hooks will be applied in alphabetical order of module names.
+	HooksOrder []string `protobuf:"bytes,2,rep,name=hooks_order,json=hooksOrder,proto3" json:"hooks_order,omitempty"`
+	// recreate_agent_accounts specifies whether to recreate the missing accounts
+	// of the agents on InitGenesis, from their derivation by next_agent. If not
+	// set, InitGenesis fails on a missing agent account.
+	RecreateAgentAccounts bool `protobuf:"varint,3,opt,name=recreate_agent_accounts,json=recreateAgentAccounts,proto3" json:"recreate_agent_accounts,omitempty"`
 }
 
 func (m *Module) Reset()         { *m = Module{} }
@@ -69,6 +77,20 @@ func (m *Module) GetAuthority() string {
 	return ""
 }
 
+func (m *Module) GetHooksOrder() []string {
+	if m != nil {
+		return m.HooksOrder
+	}
+	return nil
+}
+
+func (m *Module) GetRecreateAgentAccounts() bool {
+	if m != nil {
+		return m.RecreateAgentAccounts
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Module)(nil), "andromeda.escrow.module.v1alpha1.Module")
 }
@@ -78,25 +100,29 @@ func init() {
 }
 
 var fileDescriptor_8c32a5ff8b008dfb = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xcc, 0x4b, 0x29,
-	0xca, 0xcf, 0x4d, 0x4d, 0x49, 0xd4, 0x4f, 0x2d, 0x4e, 0x2e, 0xca, 0x2f, 0xd7, 0xcf, 0xcd, 0x4f,
-	0x29, 0xcd, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0x84, 0xf2, 0xf5, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x14, 0xe0, 0xca, 0xf5, 0x20, 0xca, 0xf5, 0xa0, 0xd2, 0x30, 0xe5,
-	0x52, 0x0a, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x89, 0x05, 0x05, 0xd8, 0xcd, 0x50, 0x0a,
-	0xe6, 0x62, 0xf3, 0x05, 0xf3, 0x85, 0x64, 0xb8, 0x38, 0x13, 0x4b, 0x4b, 0x32, 0xf2, 0x8b, 0x32,
-	0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x10, 0x02, 0x56, 0xda, 0xbb, 0x0e, 0x4c,
-	0xbb, 0xc5, 0xa8, 0xca, 0xa5, 0x9c, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x6f, 0x50, 0x92, 0x9a, 0x9c, 0xa1, 0x8f, 0x70, 0x73, 0x05, 0xd4, 0xd5, 0x4e, 0xf3, 0x99, 0x4e,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81, 0x4b, 0x25, 0x39, 0x3f, 0x57, 0x8f, 0x90,
-	0xbb, 0x9d, 0xb8, 0x21, 0x6e, 0x0a, 0x00, 0x39, 0x31, 0x80, 0x31, 0x4a, 0x9f, 0x50, 0xb8, 0x58,
-	0x43, 0xf8, 0x30, 0xee, 0x22, 0x26, 0x66, 0x47, 0x57, 0xdf, 0x55, 0x4c, 0x0a, 0x8e, 0x70, 0x8b,
-	0x5c, 0x21, 0x16, 0x41, 0xcc, 0xd5, 0x0b, 0x83, 0x2a, 0x3c, 0x85, 0xa4, 0x24, 0x06, 0xa2, 0x24,
-	0x06, 0xa2, 0x24, 0x06, 0xa6, 0xe4, 0x11, 0x93, 0x0e, 0x21, 0x25, 0x31, 0xee, 0x01, 0x4e, 0xbe,
-	0xa9, 0x25, 0x89, 0x29, 0x89, 0x25, 0x89, 0xaf, 0x98, 0x94, 0xe1, 0xca, 0xad, 0xac, 0x20, 0xea,
-	0xad, 0xac, 0x20, 0x1a, 0xac, 0xac, 0x60, 0x3a, 0x92, 0xd8, 0xc0, 0xa1, 0x6f, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x02, 0xe9, 0x4c, 0xf8, 0xf2, 0x01, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x7b, 0x29, 0x14, 0x7b, 0xdd, 0x02, 0x62, 0x11, 0x89, 0xc1, 0x2a, 0x14, 0xd4, 0x9c,
+	0x45, 0x70, 0x38, 0xa7, 0x14, 0x8a, 0x53, 0xb1, 0x74, 0x70, 0x90, 0x40, 0xb9, 0x5e, 0x8e, 0xa6,
+	0xd8, 0xf4, 0x0d, 0x97, 0x8b, 0x7f, 0xbe, 0x85, 0x93, 0xab, 0xe0, 0x28, 0x7e, 0x02, 0x27, 0x47,
+	0x71, 0xea, 0x28, 0x4e, 0x92, 0x6e, 0x7e, 0x0a, 0x69, 0x2f, 0x69, 0x1d, 0x84, 0x8e, 0xcf, 0xf3,
+	0xfc, 0xee, 0x7d, 0x79, 0x9f, 0xc3, 0x87, 0x6c, 0xec, 0x4b, 0x08, 0x85, 0xcf, 0x88, 0x88, 0xb9,
+	0x84, 0x1b, 0x12, 0x82, 0x9f, 0x8c, 0x04, 0xb9, 0x6e, 0xb0, 0x51, 0x14, 0xb0, 0x46, 0xa6, 0x9d,
+	0x48, 0x82, 0x02, 0xd3, 0x5e, 0xe0, 0x8e, 0xc6, 0x9d, 0x2c, 0xce, 0xf1, 0x4d, 0x9b, 0x43, 0x1c,
+	0x42, 0x4c, 0x58, 0x14, 0xfd, 0x3f, 0x63, 0xe7, 0x05, 0xe1, 0x52, 0x7b, 0x6e, 0x98, 0x5b, 0xb8,
+	0xcc, 0x12, 0x15, 0x80, 0x1c, 0xaa, 0xbb, 0x2a, 0xb2, 0x51, 0xbd, 0xdc, 0x5d, 0x1a, 0xe6, 0x36,
+	0xae, 0x04, 0x00, 0x57, 0x71, 0x0f, 0xa4, 0x2f, 0x64, 0xd5, 0xb0, 0x8b, 0xf5, 0x72, 0x17, 0xcf,
+	0xad, 0xf3, 0x99, 0x63, 0x9e, 0xe0, 0x0d, 0x29, 0xb8, 0x14, 0x4c, 0x89, 0x1e, 0x1b, 0x88, 0xb1,
+	0xea, 0x31, 0xce, 0x21, 0x19, 0xab, 0xb8, 0x5a, 0xb4, 0x51, 0x7d, 0xad, 0xbb, 0x9e, 0xc7, 0xee,
+	0x2c, 0x75, 0xb3, 0x90, 0xee, 0xbf, 0xbe, 0x3d, 0x7c, 0xa1, 0x3d, 0x5c, 0x1b, 0x0c, 0x55, 0x90,
+	0xf4, 0x1d, 0x0e, 0x21, 0x39, 0x52, 0x82, 0x07, 0x64, 0xd9, 0xc6, 0x6d, 0xd6, 0x47, 0xf3, 0xd1,
+	0x78, 0x4f, 0x2d, 0x34, 0x49, 0x2d, 0xf4, 0x9d, 0x5a, 0xe8, 0x7e, 0x6a, 0x15, 0x26, 0x53, 0xab,
+	0xf0, 0x39, 0xb5, 0x0a, 0x78, 0x97, 0x43, 0xe8, 0xac, 0x6a, 0xa4, 0x59, 0xd1, 0xc7, 0x76, 0x66,
+	0xc7, 0x77, 0xd0, 0x25, 0x59, 0xd5, 0xf8, 0xa9, 0xd6, 0xb9, 0x7c, 0x32, 0x8a, 0x6e, 0xab, 0xfd,
+	0x6c, 0xd8, 0xee, 0x62, 0x51, 0x4b, 0x2f, 0xd2, 0x73, 0x9d, 0x8b, 0x0c, 0xfc, 0xf8, 0x83, 0x78,
+	0x1a, 0xf1, 0x34, 0xe2, 0xe5, 0x48, 0x6a, 0x1c, 0xac, 0x42, 0xbc, 0xb3, 0x4e, 0xb3, 0x2d, 0x14,
+	0xf3, 0x99, 0x62, 0x3f, 0x46, 0x6d, 0x81, 0x53, 0xaa, 0x79, 0x4a, 0xf5, 0x03, 0x4a, 0xf3, 0x17,
+	0xfd, 0xd2, 0xfc, 0x5f, 0x8f, 0x7f, 0x07, 0x00, 0x28, 0x51, 0xdb, 0xfc, 0x4c, 0x02, 0x00, 0x00,
 }
 
 func (m *Module) Marshal() (dAtA []byte, err error) {
@@ -119,6 +145,25 @@ func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecreateAgentAccounts {
+		i--
+		if m.RecreateAgentAccounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HooksOrder) > 0 {
+		for iNdEx := len(m.HooksOrder) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HooksOrder[iNdEx])
+			copy(dAtA[i:], m.HooksOrder[iNdEx])
+			i = encodeVarintModule(dAtA, i, uint64(len(m.HooksOrder[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -150,6 +195,15 @@ func (m *Module) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModule(uint64(l))
 	}
+	if len(m.HooksOrder) > 0 {
+		for _, s := range m.HooksOrder {
+			l = len(s)
+			n += 1 + l + sovModule(uint64(l))
+		}
+	}
+	if m.RecreateAgentAccounts {
+		n += 2
+	}
 	return n
 }
 
@@ -220,6 +274,58 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HooksOrder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HooksOrder = append(m.HooksOrder, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecreateAgentAccounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecreateAgentAccounts = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModule(dAtA[iNdEx:])
@@ -0,0 +1,295 @@
+package escrowv1alpha1
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// gasCostPerIteration is the gas consumed per agent or action checked.
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = (*EscrowAuthorization)(nil)
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a EscrowAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept.
+func (a EscrowAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, ErrInvalidMessage.Wrap("type mismatch")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var agents []string
+	var value sdk.Coins
+	switch msg := msg.(type) {
+	case *MsgSubmitProposal:
+		agents = []string{msg.Agent}
+
+		proposer, err := addressStringToBytes(msg.Proposer)
+		if err != nil {
+			return authz.AcceptResponse{}, errors.Wrap(err, "proposer")
+		}
+
+		for _, actions := range []struct {
+			name     string
+			actions  []*codectypes.Any
+			quantity uint64
+		}{
+			{
+				name:     "pre_actions",
+				actions:  msg.PreActions,
+				quantity: 1,
+			},
+			{
+				name:     "post_actions",
+				actions:  msg.PostActions,
+				quantity: max(msg.Quantity, 1),
+			},
+			{
+				name:     "refund_actions",
+				actions:  msg.RefundActions,
+				quantity: 1,
+			},
+		} {
+			spent, err := a.acceptActions(sdkCtx, proposer, actions.actions)
+			if err != nil {
+				return authz.AcceptResponse{}, errors.Wrap(err, actions.name)
+			}
+
+			for _, coin := range spent {
+				value = value.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(math.NewIntFromUint64(actions.quantity))))
+			}
+		}
+	case *MsgExec:
+		agents = msg.Agents
+
+		executor, err := addressStringToBytes(msg.Executor)
+		if err != nil {
+			return authz.AcceptResponse{}, errors.Wrap(err, "executor")
+		}
+
+		spent, err := a.acceptActions(sdkCtx, executor, msg.Actions)
+		if err != nil {
+			return authz.AcceptResponse{}, errors.Wrap(err, "actions")
+		}
+		value = spent
+	default:
+		return authz.AcceptResponse{}, ErrInvalidMessage.Wrap("type mismatch")
+	}
+
+	// the deposit or the fee is charged to the granter
+	value = value.Add(a.ChargePerUse...)
+
+	if len(a.Agents) != 0 {
+		candidates := make([][]byte, len(a.Agents))
+		for i, candidate := range a.Agents {
+			var err error
+			candidates[i], err = addressStringToBytes(candidate)
+			if err != nil {
+				return authz.AcceptResponse{}, errors.Wrapf(err, "agents: index %d", i)
+			}
+		}
+
+		for i, agentStr := range agents {
+			agent, err := addressStringToBytes(agentStr)
+			if err != nil {
+				return authz.AcceptResponse{}, errors.Wrapf(err, "agent: index %d", i)
+			}
+
+			allowed := false
+			for _, candidate := range candidates {
+				sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "escrow authorization")
+				if bytes.Equal(candidate, agent) {
+					allowed = true
+					break
+				}
+			}
+
+			if !allowed {
+				return authz.AcceptResponse{}, ErrPermissionDenied.Wrapf("agent %s not allowed", agentStr)
+			}
+		}
+	}
+
+	spendLimit, isNegative := a.SpendLimit.SafeSub(value...)
+	if isNegative {
+		return authz.AcceptResponse{}, ErrPermissionDenied.Wrapf("value %s over spend_limit %s", value, a.SpendLimit)
+	}
+
+	usesLeft := a.UsesLeft
+	if usesLeft != 0 {
+		usesLeft--
+		if usesLeft == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	if spendLimit.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept: true,
+		Updated: &EscrowAuthorization{
+			MsgTypeUrl:         a.MsgTypeUrl,
+			Agents:             a.Agents,
+			SpendLimit:         spendLimit,
+			AllowedActionTypes: a.AllowedActionTypes,
+			UsesLeft:           usesLeft,
+			ChargePerUse:       a.ChargePerUse,
+		},
+	}, nil
+}
+
+// acceptActions checks the types of the actions, and returns the value sent
+// from the granter by the actions through x/bank. The addresses are compared
+// in bytes, so an address in another case is counted as well.
+func (a EscrowAuthorization) acceptActions(ctx sdk.Context, granter []byte, actions []*codectypes.Any) (sdk.Coins, error) {
+	isGranter := func(addressStr string) (bool, error) {
+		address, err := addressStringToBytes(addressStr)
+		if err != nil {
+			return false, err
+		}
+
+		return bytes.Equal(address, granter), nil
+	}
+
+	var value sdk.Coins
+	for i, action := range actions {
+		if err := a.acceptActionType(ctx, action.TypeUrl); err != nil {
+			return nil, errors.Wrapf(err, "index %d", i)
+		}
+
+		switch action.TypeUrl {
+		case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+			var msg banktypes.MsgSend
+			if err := proto.Unmarshal(action.Value, &msg); err != nil {
+				return nil, errors.Wrapf(ErrInvalidMessage.Wrap(err.Error()), "index %d", i)
+			}
+
+			fromGranter, err := isGranter(msg.FromAddress)
+			if err != nil {
+				return nil, errors.Wrapf(err, "index %d", i)
+			}
+
+			if fromGranter {
+				value = value.Add(msg.Amount...)
+			}
+		case sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):
+			var msg banktypes.MsgMultiSend
+			if err := proto.Unmarshal(action.Value, &msg); err != nil {
+				return nil, errors.Wrapf(ErrInvalidMessage.Wrap(err.Error()), "index %d", i)
+			}
+
+			for _, input := range msg.Inputs {
+				fromGranter, err := isGranter(input.Address)
+				if err != nil {
+					return nil, errors.Wrapf(err, "index %d", i)
+				}
+
+				if fromGranter {
+					value = value.Add(input.Coins...)
+				}
+			}
+		}
+	}
+
+	return value, nil
+}
+
+// acceptActionType checks whether the type of the action is allowed.
+func (a EscrowAuthorization) acceptActionType(ctx sdk.Context, typeURL string) error {
+	if isEscrowTypeURL(typeURL) {
+		return ErrMessageNotAllowed.Wrapf("%s in actions", typeURL)
+	}
+
+	for _, allowed := range a.AllowedActionTypes {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "escrow authorization")
+		if allowed == typeURL {
+			return nil
+		}
+	}
+
+	return ErrMessageNotAllowed.Wrapf("%s not in allowed_action_types", typeURL)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a EscrowAuthorization) ValidateBasic() error {
+	switch a.MsgTypeUrl {
+	case sdk.MsgTypeURL(&MsgSubmitProposal{}), sdk.MsgTypeURL(&MsgExec{}):
+	case "":
+		return ErrUnimplemented.Wrap("nil msg_type_url")
+	default:
+		return errors.Wrap(ErrInvalidMessage.Wrapf("unsupported message %s", a.MsgTypeUrl), "msg_type_url")
+	}
+
+	if err := validateNoDuplicates(a.Agents); err != nil {
+		return errors.Wrap(err, "agents")
+	}
+
+	if len(a.SpendLimit) == 0 {
+		return ErrUnimplemented.Wrap("nil spend_limit")
+	}
+	if err := a.SpendLimit.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidMessage.Wrap(err.Error()), "spend_limit")
+	}
+
+	if err := a.ChargePerUse.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidMessage.Wrap(err.Error()), "charge_per_use")
+	}
+
+	if err := validateNoDuplicates(a.AllowedActionTypes); err != nil {
+		return errors.Wrap(err, "allowed_action_types")
+	}
+	for i, typeURL := range a.AllowedActionTypes {
+		if isEscrowTypeURL(typeURL) {
+			return errors.Wrapf(ErrMessageNotAllowed.Wrap(typeURL), "allowed_action_types: index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// addressStringToBytes decodes the bech32 address regardless of its prefix and
+// case, so Accept compares the addresses without the address codec.
+func addressStringToBytes(addressStr string) ([]byte, error) {
+	_, address, err := bech32.DecodeAndConvert(addressStr)
+	if err != nil {
+		return nil, ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	return address, nil
+}
+
+func validateNoDuplicates(entries []string) error {
+	seen := map[string]bool{}
+	for i, entry := range entries {
+		if seen[entry] {
+			return errors.Wrapf(ErrDuplicateEntry.Wrap(entry), "index %d", i)
+		}
+		seen[entry] = true
+	}
+
+	return nil
+}
+
+// isEscrowTypeURL returns whether the type url belongs to this module,
+// regardless of its version.
+func isEscrowTypeURL(typeURL string) bool {
+	return strings.HasPrefix(typeURL, "/andromeda."+ModuleName+".")
+}
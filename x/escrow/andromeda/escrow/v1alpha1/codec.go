@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -12,6 +13,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateAgent{},
 		&MsgSubmitProposal{},
 		&MsgExec{},
+		&MsgCancelProposal{},
+		&MsgUpdateProposal{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&EscrowAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
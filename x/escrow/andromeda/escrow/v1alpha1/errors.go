@@ -34,12 +34,38 @@ const (
 	errorCodeProposalNotFound
 	errorCodePermissionDenied
 	errorCodeLargeMetadata
+	errorCodeProposalExpired
+	errorCodeInsufficientQuantity
+	errorCodeTooManyPreActions
+	errorCodeTooManyPostActions
+	errorCodeTooManyActions
+	errorCodeTooManyAgents
+	errorCodeLargeAction
+	errorCodeMessageNotAllowed
+	errorCodeNestingTooDeep
+	errorCodeExecGasExceeded
+	errorCodeAccountNotFound
+	errorCodeTooManyRefundActions
+	errorCodeNoRefundActions
 )
 
 var (
-	ErrInvariantBroken  = errors.RegisterWithGRPCCode(errorCodespace, errorCodeInvariantBroken, codes.Internal, "invariant broken")
-	ErrAgentNotFound    = errors.RegisterWithGRPCCode(errorCodespace, errorCodeAgentNotFound, codes.NotFound, "agent not found")
-	ErrProposalNotFound = errors.RegisterWithGRPCCode(errorCodespace, errorCodeProposalNotFound, codes.NotFound, "proposal not found")
-	ErrPermissionDenied = errors.RegisterWithGRPCCode(errorCodespace, errorCodePermissionDenied, codes.PermissionDenied, "permission denied")
-	ErrLargeMetadata    = errors.RegisterWithGRPCCode(errorCodespace, errorCodeLargeMetadata, codes.ResourceExhausted, "large metadata")
+	ErrInvariantBroken      = errors.RegisterWithGRPCCode(errorCodespace, errorCodeInvariantBroken, codes.Internal, "invariant broken")
+	ErrAgentNotFound        = errors.RegisterWithGRPCCode(errorCodespace, errorCodeAgentNotFound, codes.NotFound, "agent not found")
+	ErrProposalNotFound     = errors.RegisterWithGRPCCode(errorCodespace, errorCodeProposalNotFound, codes.NotFound, "proposal not found")
+	ErrPermissionDenied     = errors.RegisterWithGRPCCode(errorCodespace, errorCodePermissionDenied, codes.PermissionDenied, "permission denied")
+	ErrLargeMetadata        = errors.RegisterWithGRPCCode(errorCodespace, errorCodeLargeMetadata, codes.ResourceExhausted, "large metadata")
+	ErrProposalExpired      = errors.RegisterWithGRPCCode(errorCodespace, errorCodeProposalExpired, codes.FailedPrecondition, "proposal expired")
+	ErrInsufficientQuantity = errors.RegisterWithGRPCCode(errorCodespace, errorCodeInsufficientQuantity, codes.FailedPrecondition, "insufficient quantity")
+	ErrTooManyPreActions    = errors.RegisterWithGRPCCode(errorCodespace, errorCodeTooManyPreActions, codes.ResourceExhausted, "too many pre_actions")
+	ErrTooManyPostActions   = errors.RegisterWithGRPCCode(errorCodespace, errorCodeTooManyPostActions, codes.ResourceExhausted, "too many post_actions")
+	ErrTooManyActions       = errors.RegisterWithGRPCCode(errorCodespace, errorCodeTooManyActions, codes.ResourceExhausted, "too many actions")
+	ErrTooManyAgents        = errors.RegisterWithGRPCCode(errorCodespace, errorCodeTooManyAgents, codes.ResourceExhausted, "too many agents")
+	ErrLargeAction          = errors.RegisterWithGRPCCode(errorCodespace, errorCodeLargeAction, codes.ResourceExhausted, "large action")
+	ErrMessageNotAllowed    = errors.RegisterWithGRPCCode(errorCodespace, errorCodeMessageNotAllowed, codes.PermissionDenied, "message not allowed")
+	ErrNestingTooDeep       = errors.RegisterWithGRPCCode(errorCodespace, errorCodeNestingTooDeep, codes.ResourceExhausted, "nesting too deep")
+	ErrExecGasExceeded      = errors.RegisterWithGRPCCode(errorCodespace, errorCodeExecGasExceeded, codes.ResourceExhausted, "exec gas exceeded")
+	ErrAccountNotFound      = errors.RegisterWithGRPCCode(errorCodespace, errorCodeAccountNotFound, codes.NotFound, "account not found")
+	ErrTooManyRefundActions = errors.RegisterWithGRPCCode(errorCodespace, errorCodeTooManyRefundActions, codes.ResourceExhausted, "too many refund_actions")
+	ErrNoRefundActions      = errors.RegisterWithGRPCCode(errorCodespace, errorCodeNoRefundActions, codes.FailedPrecondition, "no refund_actions")
 )
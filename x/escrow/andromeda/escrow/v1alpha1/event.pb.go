@@ -6,17 +6,24 @@ package escrowv1alpha1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +37,59 @@ type EventUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// the maximum length allowed for metadata
 	MaxMetadataLength uint64 `protobuf:"varint,2,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *types.Coin `protobuf:"bytes,3,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,4,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_proposal_deposit"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,6,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,7,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,8,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,9,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,10,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,11,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,12,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,13,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,14,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,15,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,16,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,17,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,18,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,19,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,20,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
 }
 
 func (m *EventUpdateParams) Reset()         { *m = EventUpdateParams{} }
@@ -79,6 +139,132 @@ func (m *EventUpdateParams) GetMaxMetadataLength() uint64 {
 	return 0
 }
 
+func (m *EventUpdateParams) GetExecFeeFlat() *types.Coin {
+	if m != nil {
+		return m.ExecFeeFlat
+	}
+	return nil
+}
+
+func (m *EventUpdateParams) GetExecFeeBps() uint32 {
+	if m != nil {
+		return m.ExecFeeBps
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMinProposalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinProposalDeposit
+	}
+	return nil
+}
+
+func (m *EventUpdateParams) GetBurnExpiredDeposits() bool {
+	if m != nil {
+		return m.BurnExpiredDeposits
+	}
+	return false
+}
+
+func (m *EventUpdateParams) GetMaxPreActions() uint64 {
+	if m != nil {
+		return m.MaxPreActions
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMaxPostActions() uint64 {
+	if m != nil {
+		return m.MaxPostActions
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMaxActions() uint64 {
+	if m != nil {
+		return m.MaxActions
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMaxAgentsPerExec() uint64 {
+	if m != nil {
+		return m.MaxAgentsPerExec
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMaxActionSize() uint64 {
+	if m != nil {
+		return m.MaxActionSize
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetAllowedMessageTypes() []string {
+	if m != nil {
+		return m.AllowedMessageTypes
+	}
+	return nil
+}
+
+func (m *EventUpdateParams) GetDeniedMessageTypes() []string {
+	if m != nil {
+		return m.DeniedMessageTypes
+	}
+	return nil
+}
+
+func (m *EventUpdateParams) GetMaxNestingDepth() uint64 {
+	if m != nil {
+		return m.MaxNestingDepth
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetGasPerAgent() uint64 {
+	if m != nil {
+		return m.GasPerAgent
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetGasPerAction() uint64 {
+	if m != nil {
+		return m.GasPerAction
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetGasPerActionByte() uint64 {
+	if m != nil {
+		return m.GasPerActionByte
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMaxPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMaxRefundActions() uint64 {
+	if m != nil {
+		return m.MaxRefundActions
+	}
+	return 0
+}
+
+func (m *EventUpdateParams) GetMaxSimulateGas() uint64 {
+	if m != nil {
+		return m.MaxSimulateGas
+	}
+	return 0
+}
+
 // EventCreateAgent is emitted on Msg/CreateAgent.
 type EventCreateAgent struct {
 	// the address of the created agent
@@ -141,11 +327,33 @@ type EventSubmitProposal struct {
 	// the address of the agent in charge
 	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
 	// the messages which has been executed on the submission
-	PreActions []*types.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
 	// the messages which will be executed after the actions included in Msg/Exec
-	PostActions []*types.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the total quantity of the units
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
 }
 
 func (m *EventSubmitProposal) Reset()         { *m = EventSubmitProposal{} }
@@ -195,14 +403,14 @@ func (m *EventSubmitProposal) GetAgent() string {
 	return ""
 }
 
-func (m *EventSubmitProposal) GetPreActions() []*types.Any {
+func (m *EventSubmitProposal) GetPreActions() []*types1.Any {
 	if m != nil {
 		return m.PreActions
 	}
 	return nil
 }
 
-func (m *EventSubmitProposal) GetPostActions() []*types.Any {
+func (m *EventSubmitProposal) GetPostActions() []*types1.Any {
 	if m != nil {
 		return m.PostActions
 	}
@@ -216,28 +424,86 @@ func (m *EventSubmitProposal) GetMetadata() string {
 	return ""
 }
 
-// EventExec is emitted on Msg/Exec.
-type EventExec struct {
-	// the address of the account executed the proposal
-	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
-	// the addresses of the agents in charge
-	Agents []string `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
-	// the messages executed on the execution
-	Actions []*types.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+func (m *EventSubmitProposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
 }
 
-func (m *EventExec) Reset()         { *m = EventExec{} }
-func (m *EventExec) String() string { return proto.CompactTextString(m) }
-func (*EventExec) ProtoMessage()    {}
-func (*EventExec) Descriptor() ([]byte, []int) {
+func (m *EventSubmitProposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *EventSubmitProposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *EventSubmitProposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *EventSubmitProposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *EventSubmitProposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
+}
+
+func (m *EventSubmitProposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *EventSubmitProposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
+	}
+	return 0
+}
+
+// EventCancelProposal is emitted on Msg/CancelProposal.
+type EventCancelProposal struct {
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the messages executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,3,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the deposit refunded to the proposer
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *EventCancelProposal) Reset()         { *m = EventCancelProposal{} }
+func (m *EventCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*EventCancelProposal) ProtoMessage()    {}
+func (*EventCancelProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_499596c4087ad6c5, []int{3}
 }
-func (m *EventExec) XXX_Unmarshal(b []byte) error {
+func (m *EventCancelProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventExec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCancelProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -247,392 +513,3142 @@ func (m *EventExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventExec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventExec.Merge(m, src)
+func (m *EventCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelProposal.Merge(m, src)
 }
-func (m *EventExec) XXX_Size() int {
+func (m *EventCancelProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventExec) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventExec.DiscardUnknown(m)
+func (m *EventCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventExec proto.InternalMessageInfo
+var xxx_messageInfo_EventCancelProposal proto.InternalMessageInfo
 
-func (m *EventExec) GetExecutor() string {
+func (m *EventCancelProposal) GetProposer() string {
 	if m != nil {
-		return m.Executor
+		return m.Proposer
 	}
 	return ""
 }
 
-func (m *EventExec) GetAgents() []string {
+func (m *EventCancelProposal) GetAgent() string {
 	if m != nil {
-		return m.Agents
+		return m.Agent
+	}
+	return ""
+}
+
+func (m *EventCancelProposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
 	}
 	return nil
 }
 
-func (m *EventExec) GetActions() []*types.Any {
+func (m *EventCancelProposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Actions
+		return m.Deposit
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EventUpdateParams)(nil), "andromeda.escrow.v1alpha1.EventUpdateParams")
-	proto.RegisterType((*EventCreateAgent)(nil), "andromeda.escrow.v1alpha1.EventCreateAgent")
-	proto.RegisterType((*EventSubmitProposal)(nil), "andromeda.escrow.v1alpha1.EventSubmitProposal")
-	proto.RegisterType((*EventExec)(nil), "andromeda.escrow.v1alpha1.EventExec")
+// EventExpireProposal is emitted on the pruning of an expired proposal.
+type EventExpireProposal struct {
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the messages executed on the expiry
+	RefundActions []*types1.Any `protobuf:"bytes,3,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the error raised by the refund_actions, if any
+	// Note: the proposal is kept with refund_failed set if the refund_actions fail.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// the deposit forfeited
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// whether the deposit has been burned
+	// Note: false means the deposit has been sent to the community pool.
+	DepositBurned bool `protobuf:"varint,6,opt,name=deposit_burned,json=depositBurned,proto3" json:"deposit_burned,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("andromeda/escrow/v1alpha1/event.proto", fileDescriptor_499596c4087ad6c5)
+func (m *EventExpireProposal) Reset()         { *m = EventExpireProposal{} }
+func (m *EventExpireProposal) String() string { return proto.CompactTextString(m) }
+func (*EventExpireProposal) ProtoMessage()    {}
+func (*EventExpireProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499596c4087ad6c5, []int{4}
+}
+func (m *EventExpireProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpireProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpireProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExpireProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpireProposal.Merge(m, src)
+}
+func (m *EventExpireProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpireProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpireProposal.DiscardUnknown(m)
 }
 
-var fileDescriptor_499596c4087ad6c5 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x8a, 0x13, 0x41,
-	0x10, 0xc7, 0x33, 0x93, 0xfd, 0x4a, 0xc7, 0x83, 0xdb, 0xbb, 0x87, 0x49, 0xc4, 0x21, 0x04, 0x16,
-	0x72, 0xb1, 0xc7, 0x5d, 0xbf, 0x20, 0x9e, 0x26, 0x12, 0xbc, 0x28, 0x0c, 0x59, 0x14, 0x91, 0x40,
-	0xe8, 0xcc, 0x94, 0x93, 0x40, 0x66, 0x7a, 0xe8, 0xee, 0xc4, 0x04, 0x1f, 0xc0, 0xab, 0xcf, 0xa0,
-	0x37, 0xcf, 0x3e, 0x84, 0x78, 0x5a, 0x3c, 0x79, 0x94, 0xe4, 0xe6, 0xd5, 0x17, 0x90, 0xe9, 0x4e,
-	0xcf, 0x7a, 0x49, 0xb2, 0xb7, 0x2e, 0xfe, 0xbf, 0x7f, 0x55, 0x57, 0x15, 0x85, 0xce, 0x68, 0x1a,
-	0x71, 0x96, 0x40, 0x44, 0x3d, 0x10, 0x21, 0x67, 0xef, 0xbd, 0xd9, 0x39, 0x9d, 0x64, 0x23, 0x7a,
-	0xee, 0xc1, 0x0c, 0x52, 0x49, 0x32, 0xce, 0x24, 0xc3, 0xb5, 0x02, 0x23, 0x1a, 0x23, 0x06, 0xab,
-	0xd7, 0x42, 0x26, 0x12, 0x26, 0x06, 0x0a, 0xf4, 0x74, 0xa0, 0x5d, 0xf5, 0x5a, 0xcc, 0x58, 0x3c,
-	0x01, 0x4f, 0x45, 0xc3, 0xe9, 0x3b, 0x8f, 0xa6, 0x0b, 0x2d, 0x35, 0x3f, 0xa0, 0xe3, 0x6e, 0x9e,
-	0xff, 0x55, 0x16, 0x51, 0x09, 0x01, 0xe5, 0x34, 0x11, 0xf8, 0x31, 0xaa, 0xd0, 0xa9, 0x1c, 0x31,
-	0x3e, 0x96, 0x0b, 0xc7, 0x6a, 0x58, 0xad, 0x4a, 0xc7, 0xf9, 0xf9, 0xed, 0xde, 0xe9, 0x3a, 0xa9,
-	0x1f, 0x45, 0x1c, 0x84, 0xb8, 0x94, 0x7c, 0x9c, 0xc6, 0xbd, 0x6b, 0x14, 0x13, 0x74, 0x92, 0xd0,
-	0xf9, 0x20, 0x01, 0x49, 0x23, 0x2a, 0xe9, 0x60, 0x02, 0x69, 0x2c, 0x47, 0x8e, 0xdd, 0xb0, 0x5a,
-	0x7b, 0xbd, 0xe3, 0x84, 0xce, 0x5f, 0xae, 0x95, 0x17, 0x4a, 0x68, 0xce, 0xd0, 0x6d, 0x55, 0xfc,
-	0x19, 0x07, 0x2a, 0xc1, 0x8f, 0x21, 0x95, 0x98, 0xa0, 0x7d, 0x9a, 0x3f, 0x76, 0xd6, 0xd5, 0x18,
-	0xbe, 0x40, 0x87, 0x61, 0x6e, 0x67, 0x5c, 0xd5, 0xd9, 0xe6, 0x30, 0x60, 0xf3, 0xa3, 0x8d, 0x4e,
-	0x54, 0xe1, 0xcb, 0xe9, 0x30, 0x19, 0xcb, 0x80, 0xb3, 0x8c, 0x09, 0x3a, 0xc1, 0x0f, 0xd1, 0x51,
-	0xa6, 0xde, 0xc0, 0x77, 0x96, 0x2f, 0xc8, 0xeb, 0x1f, 0xdb, 0x37, 0xfb, 0xf1, 0x23, 0x54, 0xcd,
-	0x38, 0x0c, 0x68, 0x28, 0xc7, 0x2c, 0x15, 0x4e, 0xb9, 0x51, 0x6e, 0x55, 0x2f, 0x4e, 0x89, 0xde,
-	0x11, 0x31, 0x3b, 0x22, 0x7e, 0xba, 0xe8, 0xa1, 0x8c, 0x83, 0xaf, 0x39, 0xfc, 0x04, 0xdd, 0xca,
-	0x98, 0x90, 0x85, 0x6f, 0x6f, 0x8b, 0xaf, 0x9a, 0x93, 0xc6, 0x58, 0x47, 0x47, 0x66, 0x23, 0xce,
-	0x7e, 0xfe, 0xc5, 0x5e, 0x11, 0x37, 0xbf, 0x58, 0xa8, 0xa2, 0x26, 0xd1, 0x9d, 0x43, 0x98, 0xf7,
-	0x0f, 0x73, 0x08, 0xa7, 0xf9, 0x30, 0x77, 0xf6, 0x6f, 0x48, 0x7c, 0x1f, 0x1d, 0xa8, 0xc6, 0x84,
-	0x63, 0x37, 0xca, 0x5b, 0x3d, 0x6b, 0x0e, 0x13, 0x74, 0x78, 0x93, 0xee, 0x0d, 0xd4, 0xf9, 0x6b,
-	0x7d, 0x5f, 0xba, 0xd6, 0xd5, 0xd2, 0xb5, 0x7e, 0x2f, 0x5d, 0xeb, 0xd3, 0xca, 0x2d, 0x5d, 0xad,
-	0xdc, 0xd2, 0xaf, 0x95, 0x5b, 0x42, 0x77, 0x43, 0x96, 0x90, 0x8d, 0x47, 0xd1, 0x41, 0xaa, 0xb9,
-	0x20, 0xcf, 0x1a, 0x58, 0x6f, 0x5b, 0x1b, 0x8f, 0xec, 0xa9, 0x8e, 0x4d, 0xf8, 0xd9, 0x2e, 0xfb,
-	0xdd, 0x37, 0x5f, 0xed, 0x9a, 0x5f, 0x64, 0xee, 0xea, 0xcc, 0xaf, 0xd7, 0xc4, 0x8f, 0xff, 0xb4,
-	0xbe, 0xd6, 0xfa, 0x46, 0x5b, 0xda, 0x67, 0x1b, 0xb5, 0xfe, 0xf3, 0xa0, 0x63, 0xae, 0xe0, 0x8f,
-	0x7d, 0xa7, 0xe0, 0xda, 0x6d, 0x0d, 0xb6, 0xdb, 0x86, 0x1c, 0x1e, 0xa8, 0x61, 0x3c, 0xf8, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0xa4, 0xab, 0x98, 0x5d, 0x1b, 0x04, 0x00, 0x00,
+var xxx_messageInfo_EventExpireProposal proto.InternalMessageInfo
+
+func (m *EventExpireProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
 }
 
-func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventExpireProposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventExpireProposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
 }
 
-func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxMetadataLength != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.MaxMetadataLength))
-		i--
-		dAtA[i] = 0x10
+func (m *EventExpireProposal) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventExpireProposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventCreateAgent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventExpireProposal) GetDepositBurned() bool {
+	if m != nil {
+		return m.DepositBurned
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventCreateAgent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventUpdateProposal is emitted on Msg/UpdateProposal.
+type EventUpdateProposal struct {
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the additional messages executed on the update
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the post_actions before the update
+	PostActionsBefore []*types1.Any `protobuf:"bytes,4,rep,name=post_actions_before,json=postActionsBefore,proto3" json:"post_actions_before,omitempty"`
+	// the post_actions after the update
+	PostActionsAfter []*types1.Any `protobuf:"bytes,5,rep,name=post_actions_after,json=postActionsAfter,proto3" json:"post_actions_after,omitempty"`
+	// the metadata before the update
+	MetadataBefore string `protobuf:"bytes,6,opt,name=metadata_before,json=metadataBefore,proto3" json:"metadata_before,omitempty"`
+	// the metadata after the update
+	MetadataAfter string `protobuf:"bytes,7,opt,name=metadata_after,json=metadataAfter,proto3" json:"metadata_after,omitempty"`
+	// the refund_actions before the update
+	RefundActionsBefore []*types1.Any `protobuf:"bytes,8,rep,name=refund_actions_before,json=refundActionsBefore,proto3" json:"refund_actions_before,omitempty"`
+	// the refund_actions after the update
+	RefundActionsAfter []*types1.Any `protobuf:"bytes,9,rep,name=refund_actions_after,json=refundActionsAfter,proto3" json:"refund_actions_after,omitempty"`
 }
 
-func (m *EventCreateAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventUpdateProposal) Reset()         { *m = EventUpdateProposal{} }
+func (m *EventUpdateProposal) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateProposal) ProtoMessage()    {}
+func (*EventUpdateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499596c4087ad6c5, []int{5}
+}
+func (m *EventUpdateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Agent) > 0 {
-		i -= len(m.Agent)
-		copy(dAtA[i:], m.Agent)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateProposal.Merge(m, src)
+}
+func (m *EventUpdateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateProposal proto.InternalMessageInfo
+
+func (m *EventUpdateProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSubmitProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUpdateProposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUpdateProposal) GetPreActions() []*types1.Any {
+	if m != nil {
+		return m.PreActions
+	}
+	return nil
 }
 
-func (m *EventSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventUpdateProposal) GetPostActionsBefore() []*types1.Any {
+	if m != nil {
+		return m.PostActionsBefore
 	}
-	if len(m.PostActions) > 0 {
-		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return nil
+}
+
+func (m *EventUpdateProposal) GetPostActionsAfter() []*types1.Any {
+	if m != nil {
+		return m.PostActionsAfter
 	}
-	if len(m.PreActions) > 0 {
-		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return nil
+}
+
+func (m *EventUpdateProposal) GetMetadataBefore() string {
+	if m != nil {
+		return m.MetadataBefore
 	}
-	if len(m.Agent) > 0 {
-		i -= len(m.Agent)
-		copy(dAtA[i:], m.Agent)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventUpdateProposal) GetMetadataAfter() string {
+	if m != nil {
+		return m.MetadataAfter
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventUpdateProposal) GetRefundActionsBefore() []*types1.Any {
+	if m != nil {
+		return m.RefundActionsBefore
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventExec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUpdateProposal) GetRefundActionsAfter() []*types1.Any {
+	if m != nil {
+		return m.RefundActionsAfter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventExec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventExec is emitted on Msg/Exec.
+type EventExec struct {
+	// the address of the account executed the proposal
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// the addresses of the agents in charge
+	Agents []string `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
+	// the messages executed on the execution
+	Actions []*types1.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// the quantities of the units filled, in the same order of the agents
+	Quantities []uint64 `protobuf:"varint,4,rep,packed,name=quantities,proto3" json:"quantities,omitempty"`
+	// the value of the execution declared by the executor
+	DeclaredValue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=declared_value,json=declaredValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"declared_value"`
+	// the fee charged to the executor
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Actions) > 0 {
-		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Agents) > 0 {
-		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Agents[iNdEx])
-			copy(dAtA[i:], m.Agents[iNdEx])
-			i = encodeVarintEvent(dAtA, i, uint64(len(m.Agents[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *EventExec) Reset()         { *m = EventExec{} }
+func (m *EventExec) String() string { return proto.CompactTextString(m) }
+func (*EventExec) ProtoMessage()    {}
+func (*EventExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499596c4087ad6c5, []int{6}
+}
+func (m *EventExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Executor) > 0 {
-		i -= len(m.Executor)
-		copy(dAtA[i:], m.Executor)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExec.Merge(m, src)
+}
+func (m *EventExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExec.DiscardUnknown(m)
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventExec proto.InternalMessageInfo
+
+func (m *EventExec) GetExecutor() string {
+	if m != nil {
+		return m.Executor
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.MaxMetadataLength != 0 {
-		n += 1 + sovEvent(uint64(m.MaxMetadataLength))
+
+func (m *EventExec) GetAgents() []string {
+	if m != nil {
+		return m.Agents
 	}
-	return n
+	return nil
 }
 
-func (m *EventCreateAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *EventExec) GetActions() []*types1.Any {
+	if m != nil {
+		return m.Actions
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *EventExec) GetQuantities() []uint64 {
+	if m != nil {
+		return m.Quantities
 	}
-	return n
+	return nil
 }
 
-func (m *EventSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventExec) GetDeclaredValue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DeclaredValue
 	}
-	var l int
+	return nil
+}
+
+func (m *EventExec) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// EventExecProposal is emitted on Msg/Exec for each proposal executed, after
+// the execution of its post_actions.
+type EventExecProposal struct {
+	// the address of the account executed the proposal
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the post_actions executed on the execution
+	// Note: the post_actions are scaled by the quantity if the proposal is
+	// fillable partially.
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// the quantity of the units filled
+	// Note: zero means the proposal is not fillable partially.
+	Quantity uint64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (m *EventExecProposal) Reset()         { *m = EventExecProposal{} }
+func (m *EventExecProposal) String() string { return proto.CompactTextString(m) }
+func (*EventExecProposal) ProtoMessage()    {}
+func (*EventExecProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499596c4087ad6c5, []int{7}
+}
+func (m *EventExecProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExecProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExecProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExecProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExecProposal.Merge(m, src)
+}
+func (m *EventExecProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExecProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExecProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExecProposal proto.InternalMessageInfo
+
+func (m *EventExecProposal) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+func (m *EventExecProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *EventExecProposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
+	}
+	return ""
+}
+
+func (m *EventExecProposal) GetPostActions() []*types1.Any {
+	if m != nil {
+		return m.PostActions
+	}
+	return nil
+}
+
+func (m *EventExecProposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "andromeda.escrow.v1alpha1.EventUpdateParams")
+	proto.RegisterType((*EventCreateAgent)(nil), "andromeda.escrow.v1alpha1.EventCreateAgent")
+	proto.RegisterType((*EventSubmitProposal)(nil), "andromeda.escrow.v1alpha1.EventSubmitProposal")
+	proto.RegisterType((*EventCancelProposal)(nil), "andromeda.escrow.v1alpha1.EventCancelProposal")
+	proto.RegisterType((*EventExpireProposal)(nil), "andromeda.escrow.v1alpha1.EventExpireProposal")
+	proto.RegisterType((*EventUpdateProposal)(nil), "andromeda.escrow.v1alpha1.EventUpdateProposal")
+	proto.RegisterType((*EventExec)(nil), "andromeda.escrow.v1alpha1.EventExec")
+	proto.RegisterType((*EventExecProposal)(nil), "andromeda.escrow.v1alpha1.EventExecProposal")
+}
+
+func init() {
+	proto.RegisterFile("andromeda/escrow/v1alpha1/event.proto", fileDescriptor_499596c4087ad6c5)
+}
+
+var fileDescriptor_499596c4087ad6c5 = []byte{
+	// 1356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x6f, 0x5b, 0x45,
+	0x14, 0x8e, 0x5f, 0x49, 0x3c, 0x8e, 0xf3, 0x18, 0xbb, 0xd2, 0x4d, 0x10, 0x8e, 0x65, 0x08, 0x58,
+	0x88, 0x5e, 0x37, 0xe1, 0x25, 0xa5, 0x62, 0x61, 0xb7, 0x69, 0x8b, 0x44, 0x91, 0xe5, 0x94, 0x0a,
+	0xa1, 0xa2, 0xab, 0xb1, 0xef, 0xf1, 0xf5, 0x55, 0x7d, 0x1f, 0xcc, 0x8c, 0x53, 0xa7, 0x12, 0x3b,
+	0x7e, 0x40, 0x57, 0x2c, 0x41, 0x62, 0xd9, 0x35, 0xfc, 0x87, 0x8a, 0x55, 0xc5, 0x8a, 0x05, 0xa2,
+	0x28, 0xdd, 0x21, 0xb1, 0xe2, 0x0f, 0xa0, 0x79, 0xdd, 0xd8, 0xa9, 0xea, 0x04, 0x68, 0x90, 0xba,
+	0xb2, 0xe7, 0x9c, 0xef, 0x3c, 0xe6, 0xcc, 0x39, 0xdf, 0xcc, 0x45, 0x5b, 0x24, 0x74, 0x69, 0x14,
+	0x80, 0x4b, 0x1a, 0xc0, 0x7a, 0x34, 0xba, 0xd7, 0x38, 0xd8, 0x26, 0xc3, 0x78, 0x40, 0xb6, 0x1b,
+	0x70, 0x00, 0x21, 0xb7, 0x63, 0x1a, 0xf1, 0x08, 0xaf, 0x27, 0x30, 0x5b, 0xc1, 0x6c, 0x03, 0xdb,
+	0xa8, 0xf4, 0x22, 0x16, 0x44, 0xac, 0xd1, 0x25, 0x0c, 0x1a, 0x07, 0xdb, 0x5d, 0xe0, 0x64, 0xbb,
+	0xd1, 0x8b, 0xfc, 0x50, 0x99, 0x6e, 0xac, 0x2b, 0xbd, 0x23, 0x57, 0x0d, 0xb5, 0xd0, 0xaa, 0xb2,
+	0x17, 0x79, 0x91, 0x92, 0x8b, 0x7f, 0xc6, 0xc0, 0x8b, 0x22, 0x6f, 0x08, 0x0d, 0xb9, 0xea, 0x8e,
+	0xfa, 0x0d, 0x12, 0x1e, 0x6a, 0xd5, 0xe6, 0x49, 0x15, 0xf7, 0x03, 0x60, 0x9c, 0x04, 0xb1, 0x02,
+	0xd4, 0xfe, 0x5c, 0x40, 0x6b, 0x7b, 0x22, 0xef, 0x4f, 0x63, 0x97, 0x70, 0x68, 0x13, 0x4a, 0x02,
+	0x86, 0xdf, 0x47, 0x79, 0x32, 0xe2, 0x83, 0x88, 0xfa, 0xfc, 0xd0, 0x4a, 0x55, 0x53, 0xf5, 0x7c,
+	0xcb, 0xfa, 0xf9, 0x87, 0x8b, 0x65, 0x9d, 0x4c, 0xd3, 0x75, 0x29, 0x30, 0xb6, 0xcf, 0xa9, 0x1f,
+	0x7a, 0x9d, 0x63, 0x28, 0xb6, 0x51, 0x29, 0x20, 0x63, 0x27, 0x00, 0x4e, 0x5c, 0xc2, 0x89, 0x33,
+	0x84, 0xd0, 0xe3, 0x03, 0x2b, 0x5d, 0x4d, 0xd5, 0xb3, 0x9d, 0xb5, 0x80, 0x8c, 0x6f, 0x6a, 0xcd,
+	0xc7, 0x52, 0x81, 0x3f, 0x44, 0x45, 0x18, 0x43, 0xcf, 0xe9, 0x03, 0x38, 0xfd, 0x21, 0xe1, 0x56,
+	0xa6, 0x9a, 0xaa, 0x17, 0x76, 0xd6, 0x6d, 0x1d, 0x48, 0x94, 0xc8, 0xd6, 0x25, 0xb2, 0xaf, 0x44,
+	0x7e, 0xd8, 0x29, 0x08, 0xfc, 0x35, 0x80, 0x6b, 0x43, 0xc2, 0x71, 0x15, 0x2d, 0x25, 0xe6, 0xdd,
+	0x98, 0x59, 0xd9, 0x6a, 0xaa, 0x5e, 0xec, 0x20, 0x0d, 0x69, 0xc5, 0x0c, 0x7f, 0x85, 0xca, 0x81,
+	0x1f, 0x8a, 0x52, 0xc6, 0x11, 0x23, 0x43, 0xc7, 0x85, 0x38, 0x62, 0x3e, 0xb7, 0x72, 0xd5, 0xcc,
+	0xcc, 0x38, 0xad, 0x4b, 0x8f, 0x7e, 0xdb, 0x9c, 0x7b, 0xf8, 0x64, 0xb3, 0xee, 0xf9, 0x7c, 0x30,
+	0xea, 0xda, 0xbd, 0x28, 0xd0, 0x47, 0xa1, 0x7f, 0x2e, 0x32, 0xf7, 0x6e, 0x83, 0x1f, 0xc6, 0xc0,
+	0xa4, 0x01, 0xeb, 0xe0, 0xc0, 0x0f, 0xdb, 0x3a, 0xce, 0x55, 0x15, 0x06, 0xef, 0xa0, 0x0b, 0xdd,
+	0x11, 0x0d, 0x1d, 0x18, 0xc7, 0x3e, 0x05, 0xd7, 0x84, 0x67, 0xd6, 0x7c, 0x35, 0x55, 0x5f, 0xec,
+	0x94, 0x84, 0x72, 0x4f, 0xe9, 0xb4, 0x09, 0xc3, 0x6f, 0xa0, 0x15, 0x51, 0xc3, 0x98, 0x82, 0x43,
+	0x7a, 0xdc, 0x8f, 0x42, 0x66, 0x2d, 0xc8, 0xfa, 0x15, 0x03, 0x32, 0x6e, 0x53, 0x68, 0x2a, 0x21,
+	0xae, 0xa3, 0x55, 0x89, 0x8b, 0x18, 0x4f, 0x80, 0x8b, 0x12, 0xb8, 0x2c, 0x80, 0x11, 0xe3, 0x06,
+	0xb9, 0x89, 0x0a, 0x02, 0x69, 0x40, 0x79, 0x09, 0x42, 0x01, 0x19, 0x1b, 0xc0, 0x45, 0x75, 0x6c,
+	0xc4, 0x83, 0x90, 0x33, 0x27, 0x06, 0xea, 0x88, 0x12, 0x5a, 0x48, 0x02, 0x45, 0x94, 0xa6, 0xd4,
+	0xb4, 0x81, 0xee, 0x8d, 0xa1, 0x67, 0x32, 0x54, 0xfe, 0x1c, 0xe6, 0xdf, 0x07, 0xab, 0x90, 0x64,
+	0xa8, 0x7c, 0xee, 0xfb, 0xf7, 0x41, 0xec, 0x9e, 0x0c, 0x87, 0xd1, 0x3d, 0x70, 0x9d, 0x00, 0x18,
+	0x23, 0x1e, 0x38, 0xb2, 0x60, 0xd6, 0x52, 0x35, 0x53, 0xcf, 0x77, 0x4a, 0x5a, 0x79, 0x53, 0xe9,
+	0x6e, 0x09, 0x15, 0xbe, 0x84, 0xca, 0x2e, 0x84, 0xfe, 0x33, 0x26, 0x45, 0x69, 0x82, 0x95, 0x6e,
+	0xca, 0xe2, 0x2d, 0x24, 0x1a, 0xcb, 0x09, 0x81, 0x71, 0x3f, 0xf4, 0x44, 0x89, 0xf9, 0xc0, 0x5a,
+	0x96, 0xf9, 0x88, 0x34, 0x3f, 0x51, 0xf2, 0xab, 0x42, 0x8c, 0x6b, 0xa8, 0xe8, 0x11, 0xb5, 0x43,
+	0xb9, 0x59, 0x6b, 0x45, 0xe2, 0x0a, 0x1e, 0x11, 0x9b, 0x93, 0xbb, 0xc4, 0xaf, 0xa3, 0xe5, 0x04,
+	0x23, 0xf7, 0x62, 0xad, 0x4a, 0xd0, 0x92, 0x06, 0x49, 0x99, 0x28, 0xd9, 0x34, 0xca, 0xe9, 0x1e,
+	0x72, 0xb0, 0xd6, 0x54, 0xc9, 0x26, 0xa1, 0xad, 0x43, 0x0e, 0xb8, 0x81, 0xca, 0xea, 0x50, 0x47,
+	0x21, 0x28, 0xab, 0xee, 0x30, 0xea, 0xdd, 0xb5, 0x70, 0x32, 0x19, 0x6d, 0xa9, 0x6a, 0x03, 0x6d,
+	0x09, 0x05, 0x7e, 0x1b, 0x61, 0x61, 0x40, 0xa1, 0x3f, 0x0a, 0xdd, 0xe4, 0xe8, 0x4a, 0xc9, 0x89,
+	0x74, 0xa4, 0xe2, 0x44, 0x2f, 0x30, 0x3f, 0x18, 0x0d, 0x09, 0x07, 0xc7, 0x23, 0xcc, 0x2a, 0x27,
+	0xbd, 0xb0, 0xaf, 0xc5, 0xd7, 0x09, 0xab, 0x1d, 0xa0, 0x55, 0x39, 0xee, 0x57, 0x28, 0x10, 0x0e,
+	0x6a, 0xc7, 0x36, 0xca, 0xa9, 0x6a, 0x9c, 0x36, 0xe9, 0x0a, 0x86, 0x77, 0xd0, 0x42, 0x4f, 0x98,
+	0x47, 0xd4, 0x4a, 0x9f, 0x62, 0x61, 0x80, 0xb5, 0x1f, 0x73, 0xa8, 0x24, 0x03, 0xef, 0x8f, 0xba,
+	0x81, 0xcf, 0xcd, 0xa0, 0xe0, 0x77, 0xd1, 0xa2, 0x1a, 0x4e, 0xa0, 0xa7, 0x86, 0x4f, 0x90, 0xc7,
+	0x19, 0xa7, 0xcf, 0x96, 0xf1, 0x7b, 0xa8, 0x30, 0x39, 0x4f, 0x19, 0x39, 0xfd, 0x65, 0x5b, 0x91,
+	0xa3, 0x6d, 0xc8, 0xd1, 0x6e, 0x86, 0x87, 0x1d, 0x14, 0x1f, 0x8f, 0xd8, 0x07, 0x68, 0x69, 0x6a,
+	0xbc, 0xb2, 0x33, 0xec, 0x0a, 0xf1, 0xc4, 0xc4, 0x6d, 0xa0, 0x45, 0xc3, 0x81, 0x56, 0x4e, 0xa4,
+	0xd8, 0x49, 0xd6, 0xf8, 0x32, 0x5a, 0x3e, 0x71, 0xaa, 0xf3, 0x33, 0xdc, 0x16, 0xe9, 0xd4, 0x41,
+	0xbf, 0x26, 0x08, 0x53, 0xf0, 0x85, 0x33, 0x00, 0xdf, 0x1b, 0x70, 0x4d, 0x0d, 0x4b, 0x4a, 0x78,
+	0x43, 0xca, 0x70, 0x13, 0x15, 0x34, 0x48, 0xb0, 0xbd, 0x24, 0x85, 0xc2, 0xce, 0xc6, 0x33, 0xee,
+	0x6f, 0x99, 0xab, 0xa0, 0x95, 0x7d, 0xf0, 0x64, 0x33, 0xd5, 0x41, 0xca, 0x48, 0x88, 0xc5, 0x06,
+	0xbe, 0x1c, 0x91, 0x90, 0x0b, 0xfe, 0x57, 0x7c, 0x91, 0xac, 0xc5, 0xe5, 0x20, 0xe8, 0x61, 0xc4,
+	0x23, 0xca, 0x2c, 0x54, 0xcd, 0xcc, 0x3c, 0x80, 0x63, 0xa8, 0x18, 0x54, 0xb3, 0x70, 0x3c, 0x1a,
+	0x8d, 0x62, 0xc7, 0x77, 0x35, 0x71, 0xac, 0x18, 0xc5, 0x75, 0x21, 0xff, 0xc8, 0xc5, 0x80, 0x16,
+	0x0c, 0x55, 0x2f, 0xbd, 0x78, 0xaa, 0x36, 0xbe, 0xc5, 0x05, 0x22, 0xe6, 0x46, 0x5e, 0x22, 0x62,
+	0x66, 0x8a, 0x09, 0x35, 0x0a, 0xa2, 0x13, 0xf3, 0xf2, 0x5d, 0x5a, 0xf7, 0xed, 0x15, 0x12, 0xf6,
+	0x60, 0xf8, 0x3f, 0xf7, 0xed, 0xb3, 0xbd, 0x92, 0x39, 0x7b, 0xaf, 0x4c, 0xd4, 0x30, 0x7b, 0x7e,
+	0x35, 0xac, 0xfd, 0x6a, 0x2a, 0xa4, 0x2e, 0xb2, 0x97, 0xa9, 0x42, 0x65, 0x94, 0x03, 0x4a, 0x23,
+	0x2a, 0x1f, 0x0e, 0xf9, 0x8e, 0x5a, 0x4c, 0xd6, 0x2d, 0x77, 0x8e, 0xbd, 0xb7, 0x85, 0x96, 0xf5,
+	0x5f, 0x47, 0x3c, 0x03, 0xc0, 0xd5, 0x8f, 0x82, 0xa2, 0x96, 0xb6, 0xa4, 0xb0, 0xf6, 0x6d, 0x16,
+	0x95, 0x26, 0x1f, 0x68, 0x2f, 0x05, 0x71, 0x5e, 0x45, 0xa5, 0x49, 0xe2, 0x74, 0xba, 0xd0, 0x8f,
+	0x28, 0xcc, 0xe4, 0xcf, 0xb5, 0x09, 0xfe, 0x6c, 0x49, 0x38, 0x6e, 0x21, 0x3c, 0xe5, 0x85, 0xf4,
+	0x39, 0x50, 0x2b, 0x37, 0xc3, 0xc9, 0xea, 0x84, 0x93, 0xa6, 0x40, 0xe3, 0x37, 0xd1, 0x4a, 0xf2,
+	0x1a, 0xd5, 0x59, 0xcc, 0xcb, 0xc3, 0x5e, 0x36, 0x62, 0x1d, 0x6c, 0x0b, 0x25, 0x12, 0x1d, 0x68,
+	0x41, 0xe2, 0x8a, 0x46, 0xaa, 0xfc, 0xdd, 0x40, 0x17, 0xa6, 0xfb, 0xcd, 0x78, 0x5d, 0x9c, 0x91,
+	0x56, 0x69, 0xaa, 0xed, 0x74, 0xc0, 0x6b, 0xa8, 0x7c, 0xc2, 0x93, 0x0a, 0x9b, 0x9f, 0xe1, 0x08,
+	0x4f, 0x39, 0x92, 0x19, 0xd5, 0xbe, 0xc9, 0xa0, 0xbc, 0x9e, 0x3f, 0xe8, 0x89, 0xb6, 0x30, 0x5c,
+	0x7a, 0x7a, 0x5b, 0x18, 0x24, 0xbe, 0x84, 0xe6, 0xd5, 0xe3, 0xcf, 0x4a, 0x9f, 0xc2, 0xe7, 0x1a,
+	0x87, 0x6d, 0xb4, 0x70, 0x96, 0xa6, 0x30, 0x20, 0x5c, 0x41, 0x48, 0x5f, 0x20, 0x3e, 0xa8, 0x8b,
+	0x34, 0xdb, 0x99, 0x90, 0x60, 0x2a, 0xa6, 0xa1, 0x37, 0x24, 0xe2, 0x95, 0x7c, 0x40, 0x86, 0x23,
+	0x38, 0x8f, 0xd9, 0x2b, 0x9a, 0x10, 0xb7, 0x45, 0x04, 0xfc, 0x05, 0xca, 0xf4, 0x01, 0xac, 0xf9,
+	0x17, 0x1f, 0x48, 0xf8, 0xad, 0x7d, 0x9d, 0x46, 0x6b, 0xc9, 0xc1, 0x4c, 0xce, 0xed, 0xbf, 0x38,
+	0xa0, 0xc9, 0x69, 0x4f, 0xff, 0xf3, 0x69, 0xcf, 0x9c, 0x6d, 0xda, 0xff, 0xcb, 0x7b, 0x27, 0x79,
+	0x2e, 0xe4, 0xa6, 0x9f, 0x0b, 0xad, 0xbf, 0x52, 0x8f, 0x8e, 0x2a, 0xa9, 0xc7, 0x47, 0x95, 0xd4,
+	0xef, 0x47, 0x95, 0xd4, 0x83, 0xa7, 0x95, 0xb9, 0xc7, 0x4f, 0x2b, 0x73, 0xbf, 0x3c, 0xad, 0xcc,
+	0xa1, 0x57, 0x7b, 0x51, 0x60, 0x3f, 0xf7, 0x43, 0xb9, 0x85, 0x64, 0xf5, 0xda, 0x22, 0x6a, 0x3b,
+	0xf5, 0x79, 0xfd, 0xb9, 0x1f, 0xde, 0x97, 0xd5, 0xda, 0x2c, 0xbf, 0x4f, 0x67, 0x9a, 0x7b, 0x9f,
+	0x3d, 0x4c, 0xaf, 0x37, 0x13, 0xcf, 0x7b, 0xca, 0xf3, 0x6d, 0x8d, 0xf8, 0x69, 0x42, 0x77, 0x47,
+	0xe9, 0xee, 0x18, 0xdd, 0x51, 0x7a, 0xeb, 0xb9, 0xba, 0x3b, 0xd7, 0xdb, 0x2d, 0xf3, 0x05, 0xfb,
+	0x47, 0xfa, 0x95, 0x04, 0xb7, 0xbb, 0xab, 0x80, 0xbb, 0xbb, 0x06, 0xd9, 0x9d, 0x97, 0xc5, 0x7a,
+	0xe7, 0xef, 0x01, 0x00, 0xd8, 0x35, 0xf7, 0xfc, 0x2f, 0x10, 0x00, 0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.MaxSimulateGas != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxSimulateGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.MaxRefundActions != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxRefundActions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if len(m.PreActions) > 0 {
-		for _, e := range m.PreActions {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	if m.MaxPrunesPerBlock != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxPrunesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.GasPerActionByte != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasPerActionByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.GasPerAction != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasPerAction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GasPerAgent != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasPerAgent))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for iNdEx := len(m.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMessageTypes[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.DeniedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.PostActions) > 0 {
-		for _, e := range m.PostActions {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	if len(m.AllowedMessageTypes) > 0 {
+		for iNdEx := len(m.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMessageTypes[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.AllowedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxActionSize != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxActionSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxAgentsPerExec != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxAgentsPerExec))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxActions != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxActions))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxPostActions != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxPostActions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPreActions != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxPreActions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BurnExpiredDeposits {
+		i--
+		if m.BurnExpiredDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for iNdEx := len(m.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExecFeeBps != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExecFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExecFeeFlat != nil {
+		{
+			size, err := m.ExecFeeFlat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxMetadataLength != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxMetadataLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExecGas != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintEvent(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpireProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpireProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpireProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositBurned {
+		i--
+		if m.DepositBurned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundActionsAfter) > 0 {
+		for iNdEx := len(m.RefundActionsAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActionsAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RefundActionsBefore) > 0 {
+		for iNdEx := len(m.RefundActionsBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActionsBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MetadataAfter) > 0 {
+		i -= len(m.MetadataAfter)
+		copy(dAtA[i:], m.MetadataAfter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MetadataAfter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MetadataBefore) > 0 {
+		i -= len(m.MetadataBefore)
+		copy(dAtA[i:], m.MetadataBefore)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MetadataBefore)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PostActionsAfter) > 0 {
+		for iNdEx := len(m.PostActionsAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActionsAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PostActionsBefore) > 0 {
+		for iNdEx := len(m.PostActionsBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActionsBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DeclaredValue) > 0 {
+		for iNdEx := len(m.DeclaredValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeclaredValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Quantities) > 0 {
+		dAtA4 := make([]byte, len(m.Quantities)*10)
+		var j3 int
+		for _, num := range m.Quantities {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvent(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agents) > 0 {
+		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Agents[iNdEx])
+			copy(dAtA[i:], m.Agents[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Agents[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExecProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExecProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExecProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantity != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MaxMetadataLength != 0 {
+		n += 1 + sovEvent(uint64(m.MaxMetadataLength))
+	}
+	if m.ExecFeeFlat != nil {
+		l = m.ExecFeeFlat.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ExecFeeBps != 0 {
+		n += 1 + sovEvent(uint64(m.ExecFeeBps))
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for _, e := range m.MinProposalDeposit {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.BurnExpiredDeposits {
+		n += 2
+	}
+	if m.MaxPreActions != 0 {
+		n += 1 + sovEvent(uint64(m.MaxPreActions))
+	}
+	if m.MaxPostActions != 0 {
+		n += 1 + sovEvent(uint64(m.MaxPostActions))
+	}
+	if m.MaxActions != 0 {
+		n += 1 + sovEvent(uint64(m.MaxActions))
+	}
+	if m.MaxAgentsPerExec != 0 {
+		n += 1 + sovEvent(uint64(m.MaxAgentsPerExec))
+	}
+	if m.MaxActionSize != 0 {
+		n += 1 + sovEvent(uint64(m.MaxActionSize))
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for _, s := range m.AllowedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for _, s := range m.DeniedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovEvent(uint64(m.MaxNestingDepth))
+	}
+	if m.GasPerAgent != 0 {
+		n += 1 + sovEvent(uint64(m.GasPerAgent))
+	}
+	if m.GasPerAction != 0 {
+		n += 2 + sovEvent(uint64(m.GasPerAction))
+	}
+	if m.GasPerActionByte != 0 {
+		n += 2 + sovEvent(uint64(m.GasPerActionByte))
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		n += 2 + sovEvent(uint64(m.MaxPrunesPerBlock))
+	}
+	if m.MaxRefundActions != 0 {
+		n += 2 + sovEvent(uint64(m.MaxRefundActions))
+	}
+	if m.MaxSimulateGas != 0 {
+		n += 2 + sovEvent(uint64(m.MaxSimulateGas))
+	}
+	return n
+}
+
+func (m *EventCreateAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovEvent(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovEvent(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovEvent(uint64(m.MaxExecGas))
+	}
+	return n
+}
+
+func (m *EventCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventExpireProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.DepositBurned {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.PostActionsBefore) > 0 {
+		for _, e := range m.PostActionsBefore {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.PostActionsAfter) > 0 {
+		for _, e := range m.PostActionsAfter {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.MetadataBefore)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.MetadataAfter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.RefundActionsBefore) > 0 {
+		for _, e := range m.RefundActionsBefore {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.RefundActionsAfter) > 0 {
+		for _, e := range m.RefundActionsAfter {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Agents) > 0 {
+		for _, s := range m.Agents {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Quantities) > 0 {
+		l = 0
+		for _, e := range m.Quantities {
+			l += sovEvent(uint64(e))
+		}
+		n += 1 + sovEvent(uint64(l)) + l
+	}
+	if len(m.DeclaredValue) > 0 {
+		for _, e := range m.DeclaredValue {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventExecProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovEvent(uint64(m.Quantity))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+			}
+			m.MaxMetadataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecFeeFlat == nil {
+				m.ExecFeeFlat = &types.Coin{}
+			}
+			if err := m.ExecFeeFlat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
+			}
+			m.ExecFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinProposalDeposit = append(m.MinProposalDeposit, types.Coin{})
+			if err := m.MinProposalDeposit[len(m.MinProposalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnExpiredDeposits = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
+			}
+			m.MaxPreActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPreActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
+			}
+			m.MaxPostActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPostActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
+			}
+			m.MaxActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+			}
+			m.MaxAgentsPerExec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+			}
+			m.MaxActionSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActionSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessageTypes = append(m.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMessageTypes = append(m.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+			}
+			m.GasPerAgent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAgent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+			}
+			m.GasPerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+			}
+			m.GasPerActionByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerActionByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+			}
+			m.MaxPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+			}
+			m.MaxRefundActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
+			}
+			m.MaxSimulateGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSimulateGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpireProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpireProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpireProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositBurned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositBurned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
 
-func (m *EventExec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Executor)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if len(m.Agents) > 0 {
-		for _, s := range m.Agents {
-			l = len(s)
-			n += 1 + l + sovEvent(uint64(l))
-		}
-	}
-	if len(m.Actions) > 0 {
-		for _, e := range m.Actions {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -651,21 +3667,119 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActionsBefore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -675,29 +3789,31 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.PostActionsBefore = append(m.PostActionsBefore, &types1.Any{})
+			if err := m.PostActionsBefore[len(m.PostActionsBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActionsAfter", wireType)
 			}
-			m.MaxMetadataLength = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -707,64 +3823,29 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMetadataLength |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventCreateAgent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PostActionsAfter = append(m.PostActionsAfter, &types1.Any{})
+			if err := m.PostActionsAfter[len(m.PostActionsAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateAgent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataBefore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -792,11 +3873,11 @@ func (m *EventCreateAgent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.MetadataBefore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAfter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -824,7 +3905,75 @@ func (m *EventCreateAgent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.MetadataAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActionsBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActionsBefore = append(m.RefundActionsBefore, &types1.Any{})
+			if err := m.RefundActionsBefore[len(m.RefundActionsBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActionsAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActionsAfter = append(m.RefundActionsAfter, &types1.Any{})
+			if err := m.RefundActionsAfter[len(m.RefundActionsAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -847,7 +3996,7 @@ func (m *EventCreateAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *EventExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -870,15 +4019,15 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -906,11 +4055,11 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -938,11 +4087,11 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.Agents = append(m.Agents, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -969,14 +4118,90 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreActions = append(m.PreActions, &types.Any{})
-			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Actions = append(m.Actions, &types1.Any{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Quantities = append(m.Quantities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Quantities) == 0 {
+					m.Quantities = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Quantities = append(m.Quantities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantities", wireType)
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclaredValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1003,16 +4228,16 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostActions = append(m.PostActions, &types.Any{})
-			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeclaredValue = append(m.DeclaredValue, types.Coin{})
+			if err := m.DeclaredValue[len(m.DeclaredValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1022,23 +4247,25 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1061,7 +4288,7 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExec) Unmarshal(dAtA []byte) error {
+func (m *EventExecProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1084,10 +4311,10 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExecProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExecProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1124,7 +4351,7 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1152,11 +4379,43 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agents = append(m.Agents, string(dAtA[iNdEx:postIndex]))
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1183,11 +4442,30 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = append(m.Actions, &types.Any{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -6,17 +6,24 @@ package escrowv1alpha1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -101,6 +108,59 @@ func (m *GenesisState) GetProposals() []*GenesisState_Proposal {
 type GenesisState_Params struct {
 	// the maximum length allowed for metadata
 	MaxMetadataLength uint64 `protobuf:"varint,1,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *types.Coin `protobuf:"bytes,2,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,3,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_proposal_deposit"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,5,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,6,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,7,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,8,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,9,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,10,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,11,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,12,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,13,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,14,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,15,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,16,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,17,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,18,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,19,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
 }
 
 func (m *GenesisState_Params) Reset()         { *m = GenesisState_Params{} }
@@ -143,6 +203,132 @@ func (m *GenesisState_Params) GetMaxMetadataLength() uint64 {
 	return 0
 }
 
+func (m *GenesisState_Params) GetExecFeeFlat() *types.Coin {
+	if m != nil {
+		return m.ExecFeeFlat
+	}
+	return nil
+}
+
+func (m *GenesisState_Params) GetExecFeeBps() uint32 {
+	if m != nil {
+		return m.ExecFeeBps
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMinProposalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinProposalDeposit
+	}
+	return nil
+}
+
+func (m *GenesisState_Params) GetBurnExpiredDeposits() bool {
+	if m != nil {
+		return m.BurnExpiredDeposits
+	}
+	return false
+}
+
+func (m *GenesisState_Params) GetMaxPreActions() uint64 {
+	if m != nil {
+		return m.MaxPreActions
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMaxPostActions() uint64 {
+	if m != nil {
+		return m.MaxPostActions
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMaxActions() uint64 {
+	if m != nil {
+		return m.MaxActions
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMaxAgentsPerExec() uint64 {
+	if m != nil {
+		return m.MaxAgentsPerExec
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMaxActionSize() uint64 {
+	if m != nil {
+		return m.MaxActionSize
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetAllowedMessageTypes() []string {
+	if m != nil {
+		return m.AllowedMessageTypes
+	}
+	return nil
+}
+
+func (m *GenesisState_Params) GetDeniedMessageTypes() []string {
+	if m != nil {
+		return m.DeniedMessageTypes
+	}
+	return nil
+}
+
+func (m *GenesisState_Params) GetMaxNestingDepth() uint64 {
+	if m != nil {
+		return m.MaxNestingDepth
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetGasPerAgent() uint64 {
+	if m != nil {
+		return m.GasPerAgent
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetGasPerAction() uint64 {
+	if m != nil {
+		return m.GasPerAction
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetGasPerActionByte() uint64 {
+	if m != nil {
+		return m.GasPerActionByte
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMaxPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMaxRefundActions() uint64 {
+	if m != nil {
+		return m.MaxRefundActions
+	}
+	return 0
+}
+
+func (m *GenesisState_Params) GetMaxSimulateGas() uint64 {
+	if m != nil {
+		return m.MaxSimulateGas
+	}
+	return 0
+}
+
 // Agent defines an account taking charge of a proposal.
 type GenesisState_Agent struct {
 	// the address of the agent
@@ -205,11 +391,43 @@ type GenesisState_Proposal struct {
 	// the address of the proposer
 	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// the messages which has been executed on the submission
-	PreActions []*types.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
 	// the messages which will be executed after the actions included in Msg/Exec
-	PostActions []*types.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
 }
 
 func (m *GenesisState_Proposal) Reset()         { *m = GenesisState_Proposal{} }
@@ -259,14 +477,14 @@ func (m *GenesisState_Proposal) GetProposer() string {
 	return ""
 }
 
-func (m *GenesisState_Proposal) GetPreActions() []*types.Any {
+func (m *GenesisState_Proposal) GetPreActions() []*types1.Any {
 	if m != nil {
 		return m.PreActions
 	}
 	return nil
 }
 
-func (m *GenesisState_Proposal) GetPostActions() []*types.Any {
+func (m *GenesisState_Proposal) GetPostActions() []*types1.Any {
 	if m != nil {
 		return m.PostActions
 	}
@@ -280,6 +498,83 @@ func (m *GenesisState_Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *GenesisState_Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
+}
+
+func (m *GenesisState_Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *GenesisState_Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *GenesisState_Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *GenesisState_Proposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *GenesisState_Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
+}
+
+func (m *GenesisState_Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *GenesisState_Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
+	}
+	return 0
+}
+
+func (m *GenesisState_Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *GenesisState_Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
+
+func (m *GenesisState_Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "andromeda.escrow.v1alpha1.GenesisState")
 	proto.RegisterType((*GenesisState_Params)(nil), "andromeda.escrow.v1alpha1.GenesisState.Params")
@@ -292,40 +587,79 @@ func init() {
 }
 
 var fileDescriptor_6d8dfc87c909dc5a = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6b, 0xe7, 0x07, 0xc9, 0x4b, 0x17, 0x8e, 0x0e, 0x8e, 0x51, 0xad, 0x08, 0x09, 0x91,
-	0xa5, 0x67, 0x1a, 0x40, 0xa0, 0x30, 0x39, 0x52, 0xe8, 0x02, 0x28, 0x72, 0x25, 0x84, 0x50, 0x24,
-	0xeb, 0x6a, 0x1f, 0x6e, 0xa4, 0xd8, 0x67, 0xdd, 0x1d, 0x90, 0xfe, 0x17, 0xfc, 0x0d, 0x8c, 0xcc,
-	0x88, 0xbf, 0x01, 0x31, 0x55, 0x4c, 0x8c, 0x28, 0xd9, 0xd8, 0xd9, 0x18, 0x90, 0xef, 0x7c, 0xa6,
-	0x4b, 0xda, 0x8e, 0xef, 0xbe, 0x9f, 0xef, 0x7b, 0xf7, 0xde, 0xbd, 0x83, 0x7b, 0x24, 0x4f, 0x38,
-	0xcb, 0x68, 0x42, 0x7c, 0x2a, 0x62, 0xce, 0x3e, 0xf8, 0xef, 0x0f, 0xc9, 0xb2, 0x38, 0x25, 0x87,
-	0x7e, 0x4a, 0x73, 0x2a, 0x16, 0x02, 0x17, 0x9c, 0x49, 0x86, 0xfa, 0x35, 0x88, 0x35, 0x88, 0x0d,
-	0xe8, 0xf6, 0x63, 0x26, 0x32, 0x26, 0x22, 0x05, 0xfa, 0x3a, 0xd0, 0x2e, 0xb7, 0x9f, 0x32, 0x96,
-	0x2e, 0xa9, 0xaf, 0xa2, 0x93, 0x77, 0x6f, 0x7d, 0x92, 0x9f, 0x69, 0xe9, 0xce, 0xd7, 0x16, 0xec,
-	0x1e, 0xe9, 0x12, 0xc7, 0x92, 0x48, 0x8a, 0x9e, 0x41, 0xbb, 0x20, 0x9c, 0x64, 0xc2, 0xb1, 0x06,
-	0xd6, 0xb0, 0x37, 0xc2, 0x78, 0x6b, 0x49, 0x7c, 0xd1, 0x88, 0x67, 0xca, 0x15, 0x56, 0x6e, 0xb4,
-	0x0f, 0x90, 0xd3, 0x95, 0x8c, 0x48, 0x4a, 0x73, 0xe9, 0xd8, 0x03, 0x6b, 0xd8, 0x0c, 0xbb, 0xe5,
-	0x49, 0x50, 0x1e, 0xa0, 0x29, 0xb4, 0x95, 0x22, 0x9c, 0xc6, 0xa0, 0x31, 0xec, 0x8d, 0x0e, 0xae,
-	0x5b, 0x46, 0xd9, 0xc3, 0xca, 0x8c, 0x5e, 0x42, 0xb7, 0xe0, 0xac, 0x60, 0x82, 0x2c, 0x85, 0xd3,
-	0x54, 0x99, 0xee, 0x5f, 0xfb, 0xc2, 0x95, 0x31, 0xfc, 0x9f, 0xc2, 0x7d, 0x02, 0x6d, 0xdd, 0x07,
-	0xc2, 0x70, 0x2b, 0x23, 0xab, 0x28, 0xa3, 0x92, 0x24, 0x44, 0x92, 0x68, 0x49, 0xf3, 0x54, 0x9e,
-	0xaa, 0xa1, 0x34, 0xc3, 0x9b, 0x19, 0x59, 0xbd, 0xa8, 0x94, 0xe7, 0x4a, 0x70, 0x19, 0xb4, 0x74,
-	0x67, 0x23, 0xb8, 0x41, 0x92, 0x84, 0x53, 0xa1, 0x27, 0xd8, 0x9d, 0x38, 0x3f, 0xbe, 0x1c, 0xec,
-	0x55, 0xef, 0x11, 0x68, 0xe5, 0x58, 0xf2, 0x45, 0x9e, 0x86, 0x06, 0x2c, 0x3d, 0x31, 0xa7, 0x44,
-	0x32, 0xae, 0x26, 0x75, 0xa9, 0xa7, 0x02, 0xdd, 0xbf, 0x16, 0x74, 0x4c, 0x0b, 0x08, 0x43, 0x4b,
-	0x0f, 0xfa, 0xaa, 0x92, 0x1a, 0x43, 0x0f, 0xa1, 0xa3, 0x9b, 0xa6, 0x57, 0x57, 0xac, 0x49, 0xf4,
-	0x08, 0x7a, 0x05, 0xa7, 0x11, 0x89, 0xe5, 0x82, 0xe5, 0xe6, 0xe5, 0xf6, 0xb0, 0xde, 0x2e, 0x6c,
-	0xb6, 0x0b, 0x07, 0xf9, 0x59, 0x08, 0x05, 0xa7, 0x81, 0xe6, 0xd0, 0x63, 0xd8, 0x2d, 0x98, 0x90,
-	0xb5, 0xaf, 0x79, 0x89, 0xaf, 0x57, 0x92, 0xc6, 0xe8, 0x42, 0xc7, 0xcc, 0xdf, 0x69, 0x95, 0xb7,
-	0x0c, 0xeb, 0x78, 0xf2, 0xc7, 0xfa, 0xb6, 0xf6, 0xac, 0xf3, 0xb5, 0x67, 0xfd, 0x5a, 0x7b, 0xd6,
-	0xc7, 0x8d, 0xb7, 0x73, 0xbe, 0xf1, 0x76, 0x7e, 0x6e, 0xbc, 0x1d, 0xd8, 0x8f, 0x59, 0xb6, 0x7d,
-	0x09, 0x26, 0x66, 0xdf, 0x67, 0x65, 0xdd, 0x99, 0xf5, 0x66, 0xb8, 0xf5, 0xf3, 0x3d, 0xd5, 0xb1,
-	0x09, 0x3f, 0xd9, 0x8d, 0x60, 0xfa, 0xfa, 0xb3, 0xdd, 0x0f, 0xea, 0xdc, 0x53, 0x9d, 0xfb, 0x55,
-	0x45, 0x7c, 0xbf, 0xa0, 0xcd, 0xb5, 0x36, 0x37, 0xda, 0xda, 0xbe, 0xbb, 0x55, 0x9b, 0x1f, 0xcd,
-	0x26, 0x66, 0x9f, 0x7e, 0xdb, 0xb7, 0x6b, 0x6e, 0x3c, 0xd6, 0xe0, 0x78, 0x6c, 0xc8, 0x93, 0xb6,
-	0x1a, 0xd7, 0x83, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x01, 0x46, 0x41, 0x33, 0x04, 0x00,
-	0x00,
+	// 1148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0xb6, 0x64, 0x5b, 0x96, 0x96, 0x92, 0xed, 0xac, 0x55, 0x80, 0x56, 0x11, 0x59, 0x48, 0xfa,
+	0x10, 0x8a, 0x9a, 0xb2, 0xd5, 0x17, 0xe0, 0xa0, 0x07, 0xa9, 0xb1, 0xdd, 0x02, 0x4d, 0x20, 0xd0,
+	0x41, 0x51, 0x14, 0x06, 0x88, 0x95, 0x38, 0xa6, 0x88, 0x90, 0x5c, 0x96, 0xbb, 0x4a, 0xa4, 0x00,
+	0xfd, 0x0f, 0xf9, 0x0d, 0x3d, 0xfa, 0x5c, 0xa0, 0x7f, 0x21, 0xe8, 0x29, 0xe8, 0xa9, 0xa7, 0xa6,
+	0xb0, 0x7b, 0xea, 0xbd, 0xf7, 0x62, 0x5f, 0xf4, 0x23, 0xf0, 0xe3, 0xd0, 0x93, 0xb4, 0xf3, 0x7d,
+	0xdf, 0xec, 0xce, 0xcc, 0xce, 0x2c, 0xd1, 0x87, 0x24, 0xf1, 0x33, 0x1a, 0x83, 0x4f, 0x3a, 0xc0,
+	0x46, 0x19, 0x7d, 0xde, 0x79, 0xb6, 0x4d, 0xa2, 0x74, 0x4c, 0xb6, 0x3b, 0x01, 0x24, 0xc0, 0x42,
+	0xe6, 0xa4, 0x19, 0xe5, 0x14, 0xaf, 0xe7, 0x44, 0x47, 0x11, 0x1d, 0x43, 0x6c, 0x34, 0x47, 0x94,
+	0xc5, 0x94, 0x75, 0x86, 0x84, 0x41, 0xe7, 0xd9, 0xf6, 0x10, 0x38, 0xd9, 0xee, 0x8c, 0x68, 0x98,
+	0x28, 0x69, 0x63, 0x5d, 0xe1, 0x9e, 0x5c, 0x75, 0xd4, 0x42, 0x43, 0xf5, 0x80, 0x06, 0x54, 0xd9,
+	0xc5, 0x3f, 0x23, 0x08, 0x28, 0x0d, 0x22, 0xe8, 0xc8, 0xd5, 0x70, 0x72, 0xd4, 0x21, 0xc9, 0x4c,
+	0x43, 0x1b, 0x97, 0x21, 0x1e, 0xc6, 0xc0, 0x38, 0x89, 0x53, 0x45, 0xb8, 0xf7, 0xf7, 0x2a, 0xaa,
+	0xee, 0xab, 0x93, 0x1f, 0x70, 0xc2, 0x01, 0xef, 0xa1, 0x52, 0x4a, 0x32, 0x12, 0x33, 0xbb, 0xd0,
+	0x2a, 0xb4, 0xad, 0xae, 0xe3, 0x5c, 0x19, 0x89, 0x73, 0x5e, 0xe8, 0x0c, 0xa4, 0xca, 0xd5, 0x6a,
+	0x7c, 0x17, 0xa1, 0x04, 0xa6, 0xdc, 0x23, 0x01, 0x24, 0xdc, 0x2e, 0xb6, 0x0a, 0xed, 0x05, 0xb7,
+	0x22, 0x2c, 0x3d, 0x61, 0xc0, 0xbb, 0xa8, 0x24, 0x11, 0x66, 0xcf, 0xb7, 0xe6, 0xdb, 0x56, 0x77,
+	0xf3, 0xb6, 0xdb, 0x48, 0xb9, 0xab, 0xc5, 0xf8, 0x31, 0xaa, 0xa4, 0x19, 0x4d, 0x29, 0x23, 0x11,
+	0xb3, 0x17, 0xa4, 0xa7, 0xad, 0x5b, 0x1f, 0x58, 0x0b, 0xdd, 0x33, 0x17, 0x8d, 0xe3, 0x25, 0x54,
+	0x52, 0x81, 0x60, 0x07, 0xad, 0xc5, 0x64, 0xea, 0xc5, 0xc0, 0x89, 0x4f, 0x38, 0xf1, 0x22, 0x48,
+	0x02, 0x3e, 0x96, 0x59, 0x59, 0x70, 0xef, 0xc4, 0x64, 0xfa, 0x48, 0x23, 0xdf, 0x4a, 0x00, 0x7f,
+	0x89, 0x6a, 0x30, 0x85, 0x91, 0x77, 0x04, 0xe0, 0x1d, 0x45, 0x44, 0xc5, 0x6c, 0x75, 0xd7, 0x1d,
+	0x5d, 0x41, 0x51, 0x6e, 0x47, 0x97, 0xdb, 0xf9, 0x8a, 0x86, 0x89, 0x6b, 0x09, 0xfe, 0x1e, 0xc0,
+	0x5e, 0x44, 0x38, 0x6e, 0xa1, 0x6a, 0x2e, 0x1f, 0xa6, 0x22, 0x2d, 0x85, 0x76, 0xcd, 0x45, 0x9a,
+	0xd2, 0x4f, 0x19, 0xfe, 0x09, 0xd5, 0xe3, 0x30, 0xf1, 0xcc, 0x61, 0x3d, 0x1f, 0x52, 0xca, 0x42,
+	0xae, 0xc3, 0xbe, 0x7a, 0x9f, 0xfe, 0xd6, 0xab, 0x3f, 0x37, 0xe6, 0x8e, 0xdf, 0x6c, 0xb4, 0x83,
+	0x90, 0x8f, 0x27, 0x43, 0x67, 0x44, 0x63, 0x7d, 0xad, 0xf4, 0xcf, 0x26, 0xf3, 0x9f, 0x76, 0xf8,
+	0x2c, 0x05, 0x26, 0x05, 0xcc, 0xc5, 0x71, 0x98, 0x98, 0xf4, 0x3c, 0x54, 0xdb, 0xe0, 0x2e, 0x7a,
+	0x67, 0x38, 0xc9, 0x12, 0x0f, 0xa6, 0x69, 0x98, 0x81, 0x6f, 0xb6, 0x67, 0xf6, 0x62, 0xab, 0xd0,
+	0x2e, 0xbb, 0x6b, 0x02, 0xdc, 0x55, 0x98, 0x96, 0x30, 0xfc, 0x01, 0x5a, 0x11, 0x39, 0x4c, 0x33,
+	0xf0, 0xc8, 0x88, 0x87, 0x34, 0x61, 0x76, 0x49, 0xe6, 0xaf, 0x16, 0x93, 0xe9, 0x20, 0x83, 0x9e,
+	0x32, 0xe2, 0x36, 0x5a, 0x95, 0x3c, 0xca, 0x78, 0x4e, 0x5c, 0x92, 0xc4, 0x65, 0x41, 0xa4, 0x8c,
+	0x1b, 0xe6, 0x06, 0xb2, 0x04, 0xd3, 0x90, 0xca, 0x92, 0x84, 0x62, 0x32, 0x35, 0x84, 0x4d, 0x55,
+	0x36, 0x75, 0x3f, 0xbc, 0x14, 0x32, 0x4f, 0xa4, 0xd0, 0xae, 0x48, 0xa2, 0xd8, 0x45, 0x5e, 0x20,
+	0x36, 0x80, 0x6c, 0x77, 0x0a, 0x23, 0x73, 0x42, 0xe5, 0xcf, 0x63, 0xe1, 0x0b, 0xb0, 0x51, 0x7e,
+	0x42, 0xe5, 0xf3, 0x20, 0x7c, 0x01, 0x22, 0x7a, 0x12, 0x45, 0xf4, 0x39, 0xf8, 0x5e, 0x0c, 0x8c,
+	0x91, 0x00, 0x3c, 0x99, 0x30, 0xdb, 0x6a, 0xcd, 0xb7, 0x2b, 0xee, 0x9a, 0x06, 0x1f, 0x29, 0xec,
+	0x89, 0x80, 0xf0, 0x16, 0xaa, 0xfb, 0x90, 0x84, 0x6f, 0x49, 0xaa, 0x52, 0x82, 0x15, 0x76, 0x41,
+	0xf1, 0x11, 0x12, 0x17, 0xcb, 0x4b, 0x80, 0xf1, 0x30, 0x09, 0x44, 0x8a, 0xf9, 0xd8, 0xae, 0xc9,
+	0xf3, 0x88, 0x63, 0x3e, 0x56, 0xf6, 0x87, 0xc2, 0x8c, 0xef, 0xa1, 0x5a, 0x40, 0x54, 0x84, 0xaa,
+	0xc7, 0x96, 0x25, 0xcf, 0x0a, 0x88, 0x08, 0x4e, 0x75, 0xd9, 0x7b, 0x68, 0x39, 0xe7, 0xc8, 0x58,
+	0xec, 0x15, 0x49, 0xaa, 0x6a, 0x92, 0xb4, 0x89, 0x94, 0x5d, 0x64, 0x79, 0xc3, 0x19, 0x07, 0x7b,
+	0x55, 0xa5, 0xec, 0x3c, 0xb5, 0x3f, 0xe3, 0x80, 0x3b, 0xa8, 0xae, 0x8a, 0x3a, 0x49, 0x40, 0xa9,
+	0x86, 0x11, 0x1d, 0x3d, 0xb5, 0xef, 0xe4, 0x9d, 0x31, 0x90, 0xd0, 0x00, 0xb2, 0xbe, 0x00, 0xf0,
+	0xc7, 0x08, 0x0b, 0x41, 0x06, 0x47, 0x93, 0xc4, 0xcf, 0x4b, 0x87, 0xf3, 0x8a, 0xb8, 0x12, 0xb8,
+	0x74, 0x17, 0x58, 0x18, 0x4f, 0x22, 0xc2, 0xc1, 0x0b, 0x08, 0xb3, 0xd7, 0xf2, 0xbb, 0x70, 0xa0,
+	0xcd, 0xfb, 0x84, 0x35, 0x28, 0x5a, 0x54, 0x61, 0x76, 0xd1, 0x12, 0xf1, 0xfd, 0x0c, 0x98, 0x1a,
+	0x5a, 0x95, 0xbe, 0xfd, 0xfb, 0x2f, 0x9b, 0x75, 0xdd, 0x0f, 0x3d, 0x85, 0x1c, 0xf0, 0x2c, 0x4c,
+	0x02, 0xd7, 0x10, 0x85, 0x66, 0x94, 0x01, 0xe1, 0x34, 0xb3, 0x8b, 0x37, 0x69, 0x34, 0xb1, 0xf1,
+	0x6b, 0x09, 0x95, 0x4d, 0x5b, 0x60, 0x07, 0x2d, 0xaa, 0xbc, 0xdf, 0xb4, 0xa5, 0xa2, 0xe1, 0x4f,
+	0x51, 0x59, 0xb5, 0x2e, 0xdc, 0xbc, 0x63, 0xce, 0xc4, 0x9f, 0x21, 0xeb, 0x7c, 0xf7, 0xa8, 0x61,
+	0x59, 0x77, 0xd4, 0x58, 0x77, 0xcc, 0x58, 0x77, 0x7a, 0xc9, 0xcc, 0x45, 0xe9, 0x59, 0x43, 0x7d,
+	0x81, 0xaa, 0x17, 0x9a, 0x69, 0xe1, 0x1a, 0x9d, 0x95, 0x9e, 0xeb, 0xaf, 0x06, 0x2a, 0x9b, 0x89,
+	0x27, 0x1b, 0xbb, 0xe2, 0xe6, 0x6b, 0xfc, 0x00, 0x2d, 0x5f, 0xaa, 0x61, 0xe9, 0x1a, 0xb7, 0xb5,
+	0xec, 0x42, 0x59, 0xef, 0x8b, 0xf1, 0x28, 0xa6, 0x83, 0x37, 0x86, 0x30, 0x18, 0x73, 0xdd, 0xdf,
+	0x55, 0x65, 0xfc, 0x5a, 0xda, 0x70, 0x0f, 0x59, 0x9a, 0x24, 0xde, 0x29, 0xd9, 0xdd, 0x56, 0xb7,
+	0xf1, 0x96, 0xfb, 0x27, 0xe6, 0x11, 0xeb, 0x2f, 0xbc, 0x7c, 0xb3, 0x51, 0x70, 0x91, 0x12, 0x09,
+	0xb3, 0x08, 0xe0, 0xc7, 0x09, 0x49, 0x78, 0xc8, 0x67, 0xba, 0xe9, 0xf3, 0x35, 0xfe, 0x1c, 0x55,
+	0xc4, 0x30, 0x98, 0x70, 0x9a, 0x31, 0x1b, 0xb5, 0xe6, 0xaf, 0xad, 0xc1, 0x19, 0x55, 0xb4, 0xa5,
+	0x59, 0x78, 0x41, 0x46, 0x27, 0xa9, 0x17, 0xfa, 0xb6, 0xa5, 0xda, 0xd2, 0x00, 0xfb, 0xc2, 0xfe,
+	0x8d, 0x8f, 0x01, 0x2d, 0x99, 0xc1, 0x5c, 0xfd, 0xff, 0x07, 0xb3, 0xf1, 0x2d, 0x9e, 0x0b, 0xd1,
+	0x25, 0xf2, 0xc9, 0x10, 0x1d, 0x52, 0xcb, 0x07, 0xa1, 0x18, 0x6b, 0xfb, 0x44, 0x26, 0x9c, 0x4d,
+	0x86, 0x71, 0xc8, 0x4d, 0xc2, 0xd5, 0x7c, 0xa8, 0x2a, 0xe3, 0x59, 0xc2, 0x35, 0x49, 0x26, 0x7c,
+	0xe5, 0xb6, 0x09, 0x57, 0x22, 0x99, 0xf0, 0xfb, 0x48, 0x57, 0xda, 0x3b, 0x22, 0x61, 0x04, 0xbe,
+	0x9c, 0x1b, 0x65, 0xb7, 0xaa, 0x8c, 0x7b, 0xd2, 0xd6, 0xff, 0xb7, 0xf0, 0xea, 0xa4, 0x59, 0x78,
+	0x7d, 0xd2, 0x2c, 0xfc, 0x75, 0xd2, 0x2c, 0xbc, 0x3c, 0x6d, 0xce, 0xbd, 0x3e, 0x6d, 0xce, 0xfd,
+	0x71, 0xda, 0x9c, 0x43, 0x77, 0x47, 0x34, 0xbe, 0xfa, 0xc9, 0xee, 0x9b, 0xaf, 0x93, 0x81, 0x38,
+	0xcb, 0xa0, 0xf0, 0x43, 0xfb, 0xca, 0x2f, 0xb0, 0x07, 0x6a, 0x6d, 0x96, 0x3f, 0x17, 0xe7, 0x7b,
+	0xbb, 0xdf, 0x1f, 0x17, 0xd7, 0x7b, 0xb9, 0xef, 0x5d, 0xe5, 0xfb, 0x3b, 0xcd, 0xf8, 0xed, 0x1c,
+	0x76, 0xa8, 0xb0, 0x43, 0x83, 0x9d, 0x14, 0xdf, 0xbf, 0x12, 0x3b, 0xdc, 0x1f, 0xf4, 0xcd, 0xe3,
+	0xff, 0x4f, 0xf1, 0xdd, 0x9c, 0xb7, 0xb3, 0xa3, 0x88, 0x3b, 0x3b, 0x86, 0x39, 0x2c, 0xc9, 0x14,
+	0x7e, 0xf2, 0xdf, 0x00, 0x88, 0x2b, 0xbd, 0xcd, 0x38, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +750,133 @@ func (m *GenesisState_Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSimulateGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSimulateGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxRefundActions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRefundActions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPrunesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.GasPerActionByte != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerActionByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GasPerAction != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerAction))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GasPerAgent != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerAgent))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for iNdEx := len(m.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMessageTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeniedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for iNdEx := len(m.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMessageTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxActionSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxActionSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxAgentsPerExec != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAgentsPerExec))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxActions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxActions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPostActions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPostActions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPreActions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPreActions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BurnExpiredDeposits {
+		i--
+		if m.BurnExpiredDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for iNdEx := len(m.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExecFeeBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecFeeFlat != nil {
+		{
+			size, err := m.ExecFeeFlat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MaxMetadataLength != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMetadataLength))
 		i--
@@ -481,6 +942,100 @@ func (m *GenesisState_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SubmitTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGenesis(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintGenesis(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -581,14 +1136,78 @@ func (m *GenesisState_Params) Size() (n int) {
 	if m.MaxMetadataLength != 0 {
 		n += 1 + sovGenesis(uint64(m.MaxMetadataLength))
 	}
-	return n
-}
-
-func (m *GenesisState_Agent) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ExecFeeFlat != nil {
+		l = m.ExecFeeFlat.Size()
+		n += 1 + l + sovGenesis(uint64(l))
 	}
-	var l int
+	if m.ExecFeeBps != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecFeeBps))
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for _, e := range m.MinProposalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BurnExpiredDeposits {
+		n += 2
+	}
+	if m.MaxPreActions != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPreActions))
+	}
+	if m.MaxPostActions != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPostActions))
+	}
+	if m.MaxActions != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxActions))
+	}
+	if m.MaxAgentsPerExec != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxAgentsPerExec))
+	}
+	if m.MaxActionSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxActionSize))
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for _, s := range m.AllowedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for _, s := range m.DeniedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxNestingDepth))
+	}
+	if m.GasPerAgent != 0 {
+		n += 1 + sovGenesis(uint64(m.GasPerAgent))
+	}
+	if m.GasPerAction != 0 {
+		n += 1 + sovGenesis(uint64(m.GasPerAction))
+	}
+	if m.GasPerActionByte != 0 {
+		n += 2 + sovGenesis(uint64(m.GasPerActionByte))
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxPrunesPerBlock))
+	}
+	if m.MaxRefundActions != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxRefundActions))
+	}
+	if m.MaxSimulateGas != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxSimulateGas))
+	}
+	return n
+}
+
+func (m *GenesisState_Agent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Address)
 	if l > 0 {
@@ -631,6 +1250,50 @@ func (m *GenesisState_Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovGenesis(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 3
+	}
 	return n
 }
 
@@ -861,61 +1524,11 @@ func (m *GenesisState_Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState_Agent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -925,29 +1538,33 @@ func (m *GenesisState_Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.ExecFeeFlat == nil {
+				m.ExecFeeFlat = &types.Coin{}
+			}
+			if err := m.ExecFeeFlat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
 			}
-			var stringLen uint64
+			m.ExecFeeBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -957,79 +1574,16 @@ func (m *GenesisState_Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecFeeBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState_Proposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1039,29 +1593,31 @@ func (m *GenesisState_Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.MinProposalDeposit = append(m.MinProposalDeposit, types.Coin{})
+			if err := m.MinProposalDeposit[len(m.MinProposalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1071,29 +1627,17 @@ func (m *GenesisState_Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			m.BurnExpiredDeposits = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
 			}
-			var msglen int
+			m.MaxPreActions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1103,31 +1647,16 @@ func (m *GenesisState_Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPreActions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreActions = append(m.PreActions, &types.Any{})
-			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
 			}
-			var msglen int
+			m.MaxPostActions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1137,22 +1666,557 @@ func (m *GenesisState_Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPostActions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			m.MaxActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+			}
+			m.MaxAgentsPerExec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+			}
+			m.MaxActionSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActionSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessageTypes = append(m.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMessageTypes = append(m.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+			}
+			m.GasPerAgent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAgent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+			}
+			m.GasPerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+			}
+			m.GasPerActionByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerActionByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+			}
+			m.MaxPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+			}
+			m.MaxRefundActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
+			}
+			m.MaxSimulateGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSimulateGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState_Agent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState_Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostActions = append(m.PostActions, &types.Any{})
+			m.PostActions = append(m.PostActions, &types1.Any{})
 			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1189,6 +2253,293 @@ func (m *GenesisState_Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
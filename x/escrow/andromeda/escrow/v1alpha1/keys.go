@@ -4,3 +4,15 @@ const (
 	// ModuleName is the module name constant used in many places
 	ModuleName = "escrow"
 )
+
+// the keys of the attributes tagged on the events emitted by the actions
+const (
+	// AttributeKeyAgent is the address of the agent in charge of the actions
+	AttributeKeyAgent = "escrow_agent"
+
+	// AttributeKeyPhase is the phase of the actions (e.g. post_actions)
+	AttributeKeyPhase = "escrow_phase"
+
+	// AttributeKeyActionIndex is the index of the action in the phase
+	AttributeKeyActionIndex = "escrow_action_index"
+)
@@ -0,0 +1,31 @@
+package escrowv1alpha1
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxExecFeeBps is the basis points equivalent to 100%.
+const MaxExecFeeBps = 10000
+
+// ExecFee returns the fee of Msg/Exec. A zero flat fee is treated as unset.
+// The fee in basis points is charged on the value declared by the executor,
+// which is not checked against the actions.
+func (p Params) ExecFee(declaredValue sdk.Coins) sdk.Coins {
+	if p.ExecFeeFlat != nil && !p.ExecFeeFlat.IsZero() {
+		return sdk.NewCoins(*p.ExecFeeFlat)
+	}
+
+	fee := sdk.NewCoins()
+	if p.ExecFeeBps == 0 {
+		return fee
+	}
+
+	for _, coin := range declaredValue {
+		amount := coin.Amount.Mul(math.NewInt(int64(p.ExecFeeBps))).QuoRaw(MaxExecFeeBps)
+		fee = fee.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return fee
+}
@@ -7,23 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -72,6 +79,59 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// the maximum length allowed for metadata
 	MaxMetadataLength uint64 `protobuf:"varint,1,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *types.Coin `protobuf:"bytes,2,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,3,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_proposal_deposit"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,5,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,6,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,7,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,8,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,9,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,10,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,11,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,12,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,13,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,14,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,15,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,16,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,17,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,18,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,19,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -114,6 +174,132 @@ func (m *QueryParamsResponse) GetMaxMetadataLength() uint64 {
 	return 0
 }
 
+func (m *QueryParamsResponse) GetExecFeeFlat() *types.Coin {
+	if m != nil {
+		return m.ExecFeeFlat
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) GetExecFeeBps() uint32 {
+	if m != nil {
+		return m.ExecFeeBps
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMinProposalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinProposalDeposit
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) GetBurnExpiredDeposits() bool {
+	if m != nil {
+		return m.BurnExpiredDeposits
+	}
+	return false
+}
+
+func (m *QueryParamsResponse) GetMaxPreActions() uint64 {
+	if m != nil {
+		return m.MaxPreActions
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMaxPostActions() uint64 {
+	if m != nil {
+		return m.MaxPostActions
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMaxActions() uint64 {
+	if m != nil {
+		return m.MaxActions
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMaxAgentsPerExec() uint64 {
+	if m != nil {
+		return m.MaxAgentsPerExec
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMaxActionSize() uint64 {
+	if m != nil {
+		return m.MaxActionSize
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetAllowedMessageTypes() []string {
+	if m != nil {
+		return m.AllowedMessageTypes
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) GetDeniedMessageTypes() []string {
+	if m != nil {
+		return m.DeniedMessageTypes
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) GetMaxNestingDepth() uint64 {
+	if m != nil {
+		return m.MaxNestingDepth
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetGasPerAgent() uint64 {
+	if m != nil {
+		return m.GasPerAgent
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetGasPerAction() uint64 {
+	if m != nil {
+		return m.GasPerAction
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetGasPerActionByte() uint64 {
+	if m != nil {
+		return m.GasPerActionByte
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMaxPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMaxRefundActions() uint64 {
+	if m != nil {
+		return m.MaxRefundActions
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMaxSimulateGas() uint64 {
+	if m != nil {
+		return m.MaxSimulateGas
+	}
+	return 0
+}
+
 // QueryAgentRequest is the request type for the Query/Agent RPC method.
 type QueryAgentRequest struct {
 	// the address of an agent
@@ -681,11 +867,43 @@ type QueryProposalResponse_Proposal struct {
 	// the address of the proposer
 	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// the messages which has been executed on the submission
-	PreActions []*types.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
 	// the messages which will be executed after the actions included in Msg/Exec
-	PostActions []*types.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
 }
 
 func (m *QueryProposalResponse_Proposal) Reset()         { *m = QueryProposalResponse_Proposal{} }
@@ -735,14 +953,14 @@ func (m *QueryProposalResponse_Proposal) GetProposer() string {
 	return ""
 }
 
-func (m *QueryProposalResponse_Proposal) GetPreActions() []*types.Any {
+func (m *QueryProposalResponse_Proposal) GetPreActions() []*types1.Any {
 	if m != nil {
 		return m.PreActions
 	}
 	return nil
 }
 
-func (m *QueryProposalResponse_Proposal) GetPostActions() []*types.Any {
+func (m *QueryProposalResponse_Proposal) GetPostActions() []*types1.Any {
 	if m != nil {
 		return m.PostActions
 	}
@@ -756,6 +974,83 @@ func (m *QueryProposalResponse_Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *QueryProposalResponse_Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
+}
+
+func (m *QueryProposalResponse_Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *QueryProposalResponse_Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *QueryProposalResponse_Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *QueryProposalResponse_Proposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *QueryProposalResponse_Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
+}
+
+func (m *QueryProposalResponse_Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *QueryProposalResponse_Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
+	}
+	return 0
+}
+
+func (m *QueryProposalResponse_Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *QueryProposalResponse_Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
+
+func (m *QueryProposalResponse_Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
+	}
+	return false
+}
+
 // QueryProposalsByProposerRequest is the request type for the Query/ProposalsByProposer RPC method.
 type QueryProposalsByProposerRequest struct {
 	// the address of a proposer
@@ -873,11 +1168,43 @@ type QueryProposalsByProposerResponse_Proposal struct {
 	// the address of the proposer
 	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// the messages which has been executed on the submission
-	PreActions []*types.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
 	// the messages which will be executed after the actions included in Msg/Exec
-	PostActions []*types.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
 }
 
 func (m *QueryProposalsByProposerResponse_Proposal) Reset() {
@@ -931,14 +1258,14 @@ func (m *QueryProposalsByProposerResponse_Proposal) GetProposer() string {
 	return ""
 }
 
-func (m *QueryProposalsByProposerResponse_Proposal) GetPreActions() []*types.Any {
+func (m *QueryProposalsByProposerResponse_Proposal) GetPreActions() []*types1.Any {
 	if m != nil {
 		return m.PreActions
 	}
 	return nil
 }
 
-func (m *QueryProposalsByProposerResponse_Proposal) GetPostActions() []*types.Any {
+func (m *QueryProposalsByProposerResponse_Proposal) GetPostActions() []*types1.Any {
 	if m != nil {
 		return m.PostActions
 	}
@@ -952,24 +1279,107 @@ func (m *QueryProposalsByProposerResponse_Proposal) GetMetadata() string {
 	return ""
 }
 
-// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
-type QueryProposalsRequest struct {
+func (m *QueryProposalsByProposerResponse_Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
+	}
+	return 0
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
+	}
+	return false
+}
+
+// QueryProposalsByMessageTypeRequest is the request type for the Query/ProposalsByMessageType RPC method.
+type QueryProposalsByMessageTypeRequest struct {
+	// the type url of a message (e.g. /cosmos.nft.v1beta1.MsgSend)
+	MessageType string `protobuf:"bytes,1,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	// the phase of the actions having the message (i.e. pre_actions or
+	// post_actions)
+	// Note: empty means either of the phases.
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
 	// optional pagination for the request
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
-func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalsRequest) ProtoMessage()    {}
-func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryProposalsByMessageTypeRequest) Reset()         { *m = QueryProposalsByMessageTypeRequest{} }
+func (m *QueryProposalsByMessageTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByMessageTypeRequest) ProtoMessage()    {}
+func (*QueryProposalsByMessageTypeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84a41d203399b1b7, []int{12}
 }
-func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalsByMessageTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalsByMessageTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalsByMessageTypeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -979,45 +1389,59 @@ func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
+func (m *QueryProposalsByMessageTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByMessageTypeRequest.Merge(m, src)
 }
-func (m *QueryProposalsRequest) XXX_Size() int {
+func (m *QueryProposalsByMessageTypeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
+func (m *QueryProposalsByMessageTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByMessageTypeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalsByMessageTypeRequest proto.InternalMessageInfo
 
-func (m *QueryProposalsRequest) GetPagination() *query.PageRequest {
+func (m *QueryProposalsByMessageTypeRequest) GetMessageType() string {
+	if m != nil {
+		return m.MessageType
+	}
+	return ""
+}
+
+func (m *QueryProposalsByMessageTypeRequest) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *QueryProposalsByMessageTypeRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryProposalsResponse is the response type for the Query/Proposals RPC method.
-type QueryProposalsResponse struct {
-	// all the proposals
-	Proposals []*QueryProposalsResponse_Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+// QueryProposalsByMessageTypeResponse is the response type for the Query/ProposalsByMessageType RPC method.
+type QueryProposalsByMessageTypeResponse struct {
+	// all the proposals having the message type in their actions
+	Proposals []*QueryProposalsByMessageTypeResponse_Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// pagination in the response
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
-func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalsResponse) ProtoMessage()    {}
-func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryProposalsByMessageTypeResponse) Reset()         { *m = QueryProposalsByMessageTypeResponse{} }
+func (m *QueryProposalsByMessageTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByMessageTypeResponse) ProtoMessage()    {}
+func (*QueryProposalsByMessageTypeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84a41d203399b1b7, []int{13}
 }
-func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalsByMessageTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalsByMessageTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalsByMessageTypeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1027,26 +1451,26 @@ func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+func (m *QueryProposalsByMessageTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByMessageTypeResponse.Merge(m, src)
 }
-func (m *QueryProposalsResponse) XXX_Size() int {
+func (m *QueryProposalsByMessageTypeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+func (m *QueryProposalsByMessageTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByMessageTypeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalsByMessageTypeResponse proto.InternalMessageInfo
 
-func (m *QueryProposalsResponse) GetProposals() []*QueryProposalsResponse_Proposal {
+func (m *QueryProposalsByMessageTypeResponse) GetProposals() []*QueryProposalsByMessageTypeResponse_Proposal {
 	if m != nil {
 		return m.Proposals
 	}
 	return nil
 }
 
-func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
+func (m *QueryProposalsByMessageTypeResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
@@ -1054,31 +1478,67 @@ func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
 }
 
 // Proposal defines a proposal.
-type QueryProposalsResponse_Proposal struct {
+type QueryProposalsByMessageTypeResponse_Proposal struct {
 	// the address of the agent in charge
 	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
 	// the address of the proposer
 	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// the messages which has been executed on the submission
-	PreActions []*types.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
 	// the messages which will be executed after the actions included in Msg/Exec
-	PostActions []*types.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
+}
+
+func (m *QueryProposalsByMessageTypeResponse_Proposal) Reset() {
+	*m = QueryProposalsByMessageTypeResponse_Proposal{}
+}
+func (m *QueryProposalsByMessageTypeResponse_Proposal) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryProposalsResponse_Proposal) Reset()         { *m = QueryProposalsResponse_Proposal{} }
-func (m *QueryProposalsResponse_Proposal) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalsResponse_Proposal) ProtoMessage()    {}
-func (*QueryProposalsResponse_Proposal) Descriptor() ([]byte, []int) {
+func (*QueryProposalsByMessageTypeResponse_Proposal) ProtoMessage() {}
+func (*QueryProposalsByMessageTypeResponse_Proposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_84a41d203399b1b7, []int{13, 0}
 }
-func (m *QueryProposalsResponse_Proposal) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalsResponse_Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalsResponse_Proposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalsByMessageTypeResponse_Proposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1088,1692 +1548,11587 @@ func (m *QueryProposalsResponse_Proposal) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalsResponse_Proposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalsResponse_Proposal.Merge(m, src)
+func (m *QueryProposalsByMessageTypeResponse_Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByMessageTypeResponse_Proposal.Merge(m, src)
 }
-func (m *QueryProposalsResponse_Proposal) XXX_Size() int {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalsResponse_Proposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalsResponse_Proposal.DiscardUnknown(m)
+func (m *QueryProposalsByMessageTypeResponse_Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByMessageTypeResponse_Proposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalsResponse_Proposal proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalsByMessageTypeResponse_Proposal proto.InternalMessageInfo
 
-func (m *QueryProposalsResponse_Proposal) GetAgent() string {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetAgent() string {
 	if m != nil {
 		return m.Agent
 	}
 	return ""
 }
 
-func (m *QueryProposalsResponse_Proposal) GetProposer() string {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetProposer() string {
 	if m != nil {
 		return m.Proposer
 	}
 	return ""
 }
 
-func (m *QueryProposalsResponse_Proposal) GetPreActions() []*types.Any {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetPreActions() []*types1.Any {
 	if m != nil {
 		return m.PreActions
 	}
 	return nil
 }
 
-func (m *QueryProposalsResponse_Proposal) GetPostActions() []*types.Any {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetPostActions() []*types1.Any {
 	if m != nil {
 		return m.PostActions
 	}
 	return nil
 }
 
-func (m *QueryProposalsResponse_Proposal) GetMetadata() string {
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetMetadata() string {
 	if m != nil {
 		return m.Metadata
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "andromeda.escrow.v1alpha1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "andromeda.escrow.v1alpha1.QueryParamsResponse")
-	proto.RegisterType((*QueryAgentRequest)(nil), "andromeda.escrow.v1alpha1.QueryAgentRequest")
-	proto.RegisterType((*QueryAgentResponse)(nil), "andromeda.escrow.v1alpha1.QueryAgentResponse")
-	proto.RegisterType((*QueryAgentResponse_Agent)(nil), "andromeda.escrow.v1alpha1.QueryAgentResponse.Agent")
-	proto.RegisterType((*QueryAgentsByCreatorRequest)(nil), "andromeda.escrow.v1alpha1.QueryAgentsByCreatorRequest")
-	proto.RegisterType((*QueryAgentsByCreatorResponse)(nil), "andromeda.escrow.v1alpha1.QueryAgentsByCreatorResponse")
-	proto.RegisterType((*QueryAgentsByCreatorResponse_Agent)(nil), "andromeda.escrow.v1alpha1.QueryAgentsByCreatorResponse.Agent")
-	proto.RegisterType((*QueryAgentsRequest)(nil), "andromeda.escrow.v1alpha1.QueryAgentsRequest")
-	proto.RegisterType((*QueryAgentsResponse)(nil), "andromeda.escrow.v1alpha1.QueryAgentsResponse")
-	proto.RegisterType((*QueryAgentsResponse_Agent)(nil), "andromeda.escrow.v1alpha1.QueryAgentsResponse.Agent")
-	proto.RegisterType((*QueryProposalRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalRequest")
-	proto.RegisterType((*QueryProposalResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalResponse")
-	proto.RegisterType((*QueryProposalResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalResponse.Proposal")
-	proto.RegisterType((*QueryProposalsByProposerRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByProposerRequest")
-	proto.RegisterType((*QueryProposalsByProposerResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByProposerResponse")
-	proto.RegisterType((*QueryProposalsByProposerResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByProposerResponse.Proposal")
-	proto.RegisterType((*QueryProposalsRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalsRequest")
-	proto.RegisterType((*QueryProposalsResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalsResponse")
-	proto.RegisterType((*QueryProposalsResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalsResponse.Proposal")
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("andromeda/escrow/v1alpha1/query.proto", fileDescriptor_84a41d203399b1b7)
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
 }
 
-var fileDescriptor_84a41d203399b1b7 = []byte{
-	// 1000 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xbf, 0x59, 0xe7, 0x8e, 0xf3, 0x18, 0x09, 0x65, 0xce, 0x20, 0xdb, 0x09, 0x26, 0x2c, 0x24,
-	0x5c, 0xe0, 0x3c, 0x13, 0x3b, 0x26, 0x51, 0x7c, 0x50, 0xd8, 0xe1, 0x88, 0x90, 0x82, 0x64, 0x8c,
-	0x40, 0x11, 0xb2, 0x64, 0x8d, 0xed, 0xc1, 0xb1, 0x64, 0xef, 0x6c, 0x76, 0xf6, 0x42, 0x4e, 0xa7,
-	0x34, 0xfc, 0x05, 0x08, 0x0a, 0x44, 0x01, 0x48, 0x74, 0xd0, 0xa4, 0xa1, 0xa2, 0xa2, 0x44, 0x14,
-	0xe8, 0x80, 0x86, 0x12, 0xf9, 0xa8, 0x90, 0xa8, 0x68, 0x29, 0x90, 0xe7, 0x63, 0xbd, 0xf6, 0xf9,
-	0x63, 0x7d, 0x1c, 0x14, 0x40, 0x75, 0x3b, 0xf3, 0xde, 0xfb, 0xcd, 0xef, 0x7d, 0xcc, 0x9b, 0x77,
-	0x86, 0xe7, 0xa9, 0xd3, 0xf6, 0x78, 0x9f, 0xb5, 0x29, 0x61, 0xa2, 0xe5, 0xf1, 0x77, 0xc8, 0xdd,
-	0x3c, 0xed, 0xb9, 0xb7, 0x69, 0x9e, 0xdc, 0xd9, 0x65, 0xde, 0x1e, 0x76, 0x3d, 0xee, 0x73, 0x94,
-	0x0e, 0xd4, 0xb0, 0x52, 0xc3, 0x46, 0x2d, 0xf3, 0x6c, 0x8b, 0x8b, 0x3e, 0x17, 0xa4, 0x49, 0x05,
-	0x53, 0x36, 0xe4, 0x6e, 0xbe, 0xc9, 0x7c, 0x9a, 0x27, 0x2e, 0xed, 0x74, 0x1d, 0xea, 0x77, 0xb9,
-	0xa3, 0x60, 0x32, 0x69, 0xa5, 0xdb, 0x90, 0x2b, 0xa2, 0x16, 0x5a, 0x74, 0xb6, 0xc3, 0x79, 0xa7,
-	0xc7, 0x08, 0x75, 0xbb, 0x84, 0x3a, 0x0e, 0xf7, 0xa5, 0x9d, 0x91, 0xa6, 0xb5, 0x54, 0xae, 0x9a,
-	0xbb, 0x6f, 0x13, 0xea, 0x68, 0x6a, 0x76, 0x12, 0xa2, 0xd7, 0x86, 0xa7, 0x56, 0xa9, 0x47, 0xfb,
-	0xa2, 0xc6, 0xee, 0xec, 0x32, 0xe1, 0xdb, 0x3b, 0x70, 0x63, 0x6c, 0x57, 0xb8, 0xdc, 0x11, 0x0c,
-	0x61, 0xb8, 0xd1, 0xa7, 0xf7, 0x1a, 0x7d, 0xe6, 0xd3, 0x36, 0xf5, 0x69, 0xa3, 0xc7, 0x9c, 0x8e,
-	0x7f, 0x3b, 0x05, 0xce, 0x81, 0xcd, 0x53, 0xb5, 0xd3, 0x7d, 0x7a, 0xef, 0x55, 0x2d, 0xb9, 0x29,
-	0x05, 0xf6, 0x75, 0x78, 0x5a, 0xc2, 0x94, 0x3b, 0xcc, 0xf1, 0x35, 0x36, 0xc2, 0x70, 0x95, 0x0e,
-	0xd7, 0xd2, 0x2c, 0x5e, 0x49, 0xfd, 0xf0, 0x65, 0x2e, 0xa9, 0x7d, 0x29, 0xb7, 0xdb, 0x1e, 0x13,
-	0xe2, 0x75, 0xdf, 0xeb, 0x3a, 0x9d, 0x9a, 0x52, 0xb3, 0x0f, 0x80, 0xa6, 0xa8, 0x51, 0x34, 0x97,
-	0x57, 0xc2, 0x30, 0x89, 0xc2, 0x65, 0x3c, 0x33, 0xc6, 0xf8, 0xa8, 0x35, 0x56, 0x2b, 0x85, 0x90,
-	0xe1, 0x70, 0x55, 0xae, 0x51, 0x01, 0x3e, 0x44, 0x15, 0x85, 0x85, 0xe4, 0x8c, 0xe2, 0xd0, 0xa6,
-	0xe5, 0x31, 0xea, 0x73, 0x2f, 0x65, 0x2d, 0xb2, 0xd1, 0x8a, 0xf6, 0x47, 0x00, 0x9e, 0x19, 0x91,
-	0x12, 0x95, 0xbd, 0xeb, 0x4a, 0x60, 0x42, 0x14, 0xc2, 0x04, 0x11, 0x31, 0xd1, 0xcb, 0x10, 0x8e,
-	0x0a, 0x46, 0x52, 0x49, 0x14, 0x2e, 0x60, 0x6d, 0x33, 0xac, 0x2e, 0xac, 0x2a, 0x52, 0x57, 0x17,
-	0xae, 0xd2, 0x0e, 0xd3, 0xe7, 0xd5, 0x42, 0x96, 0xf6, 0x03, 0x0b, 0x9e, 0x9d, 0xce, 0x4d, 0x07,
-	0xfe, 0x0d, 0xb8, 0x26, 0xc3, 0x36, 0x8c, 0x51, 0x6c, 0x33, 0x51, 0x78, 0x31, 0x52, 0xe4, 0x8f,
-	0x02, 0xe9, 0x1c, 0x68, 0x30, 0x74, 0x63, 0x0a, 0xff, 0x67, 0x16, 0xf2, 0x57, 0x50, 0x61, 0x07,
-	0xfe, 0xf9, 0x6c, 0xd6, 0xc3, 0xf5, 0x69, 0xae, 0xd0, 0x44, 0x3e, 0xc0, 0xb1, 0xf3, 0xf1, 0xb1,
-	0xa5, 0xef, 0xa2, 0x81, 0xd7, 0x69, 0xb8, 0x39, 0x91, 0x86, 0x62, 0xb4, 0x34, 0xfc, 0xeb, 0xa2,
-	0xbf, 0x05, 0x93, 0xaa, 0x55, 0x79, 0xdc, 0xe5, 0x82, 0xf6, 0x4c, 0xfc, 0x93, 0x63, 0x6d, 0xc6,
-	0x34, 0x93, 0xdf, 0x2c, 0xf8, 0xe8, 0x84, 0x7a, 0x50, 0xd6, 0xeb, 0xae, 0xde, 0xd3, 0xd9, 0xba,
-	0xb6, 0x28, 0xa2, 0x93, 0x18, 0x38, 0xd8, 0x08, 0xa0, 0x32, 0x7f, 0x00, 0xb8, 0x6e, 0xb6, 0x97,
-	0x6d, 0x7d, 0xa8, 0x68, 0x38, 0xb1, 0xc5, 0x01, 0x09, 0x34, 0xd1, 0xf3, 0x30, 0xe1, 0x7a, 0xac,
-	0x41, 0x5b, 0xf2, 0x09, 0x48, 0xc5, 0x64, 0x79, 0x24, 0xb1, 0x7a, 0x03, 0xb0, 0x79, 0x03, 0x70,
-	0xd9, 0xd9, 0xab, 0x41, 0xd7, 0x63, 0x65, 0xa5, 0x87, 0xae, 0xc2, 0x87, 0x5d, 0x2e, 0xfc, 0xc0,
-	0xee, 0xd4, 0x1c, 0xbb, 0xc4, 0x50, 0xd3, 0x18, 0x66, 0xe0, 0xba, 0x79, 0x11, 0x52, 0xab, 0x32,
-	0xd8, 0xc1, 0xda, 0xfe, 0x14, 0xc0, 0x27, 0xc6, 0x62, 0x25, 0x2a, 0xfa, 0x93, 0x05, 0xdd, 0x2e,
-	0xec, 0x25, 0x88, 0xec, 0xe5, 0x49, 0xf5, 0xbb, 0xef, 0x62, 0xf0, 0xdc, 0x6c, 0x86, 0xba, 0x38,
-	0x9a, 0x30, 0x6e, 0x32, 0x6a, 0xee, 0xdb, 0x4b, 0x51, 0xab, 0x63, 0x0a, 0xde, 0xa8, 0x50, 0x46,
-	0xb0, 0x27, 0x77, 0x05, 0xff, 0xe3, 0x25, 0xd7, 0x98, 0xb8, 0xe1, 0x27, 0xde, 0x91, 0xbf, 0x8a,
-	0xc1, 0xc7, 0x26, 0x4f, 0xd0, 0x75, 0x72, 0xeb, 0x68, 0x9d, 0x94, 0x22, 0xd7, 0xc9, 0xff, 0xd5,
-	0xf1, 0xf7, 0x57, 0x47, 0xe1, 0x41, 0x1c, 0xae, 0xca, 0xb0, 0xa3, 0xf7, 0x01, 0x5c, 0x53, 0xf3,
-	0x2d, 0xca, 0x2d, 0xcc, 0x51, 0x78, 0x3a, 0xce, 0xe0, 0xa8, 0xea, 0x2a, 0xfc, 0xf6, 0xc5, 0x77,
-	0x7f, 0xfc, 0xe5, 0x03, 0xeb, 0x29, 0xf4, 0x24, 0x99, 0xfd, 0xef, 0x82, 0xab, 0x98, 0x7c, 0x08,
-	0xcc, 0xfb, 0xb9, 0x15, 0x71, 0xa0, 0x55, 0x94, 0x72, 0x4b, 0x8d, 0xbf, 0x76, 0x5e, 0x32, 0x7a,
-	0x0e, 0x5d, 0x9c, 0xc3, 0x48, 0x4d, 0x06, 0x64, 0x5f, 0xfe, 0xbd, 0x8f, 0xbe, 0x06, 0xf0, 0x91,
-	0x89, 0x49, 0x0e, 0x5d, 0x59, 0x7a, 0xf4, 0x53, 0x6c, 0xaf, 0x1e, 0x73, 0x64, 0xb4, 0x5f, 0x90,
-	0xbc, 0xaf, 0xa0, 0xe2, 0x1c, 0xde, 0x7a, 0x30, 0x10, 0x64, 0x5f, 0x7f, 0xdd, 0xd7, 0xae, 0xc8,
-	0x8c, 0x2b, 0x64, 0x94, 0x8b, 0x3a, 0x2d, 0x45, 0xcc, 0xf8, 0xf8, 0x70, 0x15, 0x29, 0xe3, 0x9a,
-	0xd4, 0xe7, 0xe1, 0xfb, 0x48, 0xa2, 0x8f, 0x1c, 0x8a, 0xd8, 0xa5, 0x65, 0x67, 0x14, 0xbb, 0x24,
-	0xa9, 0x15, 0x51, 0x21, 0x72, 0xea, 0x89, 0xe9, 0x42, 0xe8, 0x7b, 0x00, 0x37, 0xa6, 0x3c, 0x6b,
-	0xa8, 0x74, 0xac, 0xb7, 0x50, 0x79, 0xb0, 0xfd, 0x17, 0xde, 0x51, 0xbb, 0x2c, 0x9d, 0xd9, 0x46,
-	0xd7, 0xe6, 0xdd, 0x2c, 0x6d, 0x24, 0xc8, 0xbe, 0xf9, 0x1c, 0xb9, 0x24, 0xd0, 0x27, 0x00, 0xc6,
-	0x83, 0x23, 0xd0, 0xa5, 0x25, 0xba, 0xb5, 0xe2, 0x9f, 0x5f, 0xba, 0xbf, 0xdb, 0x5b, 0x92, 0xf5,
-	0x05, 0xf4, 0xf4, 0x42, 0xd6, 0x43, 0xaf, 0x7f, 0x07, 0xdf, 0x0c, 0xb2, 0xe0, 0x60, 0x90, 0x05,
-	0x3f, 0x0f, 0xb2, 0xe0, 0xbd, 0xc3, 0xec, 0xca, 0xc1, 0x61, 0x76, 0xe5, 0xa7, 0xc3, 0xec, 0x0a,
-	0x7c, 0xbc, 0xc5, 0xfb, 0xb3, 0x8f, 0xaf, 0x40, 0x73, 0xbe, 0xcf, 0xab, 0xe0, 0xad, 0xcd, 0x99,
-	0x87, 0x6d, 0xab, 0xb5, 0x59, 0x7e, 0x66, 0xc5, 0xca, 0x3b, 0xb7, 0xbe, 0xb0, 0xd2, 0xe5, 0x00,
-	0x79, 0x47, 0x21, 0xbf, 0xa9, 0x35, 0xbe, 0x0d, 0xc9, 0xea, 0x4a, 0x56, 0x37, 0xb2, 0x81, 0x75,
-	0x7e, 0xa6, 0xac, 0x7e, 0xa3, 0x5a, 0x31, 0x3f, 0x11, 0xfc, 0x6a, 0x9d, 0x09, 0xf4, 0x4a, 0x25,
-	0xa5, 0x58, 0x2a, 0x19, 0xcd, 0xe6, 0x9a, 0x6c, 0xef, 0x97, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0xdf, 0x8a, 0xf0, 0xf7, 0x62, 0x11, 0x00, 0x00,
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Agent queries an agent.
-	Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error)
-	// AgentsByCreator queries all the agents by its creator.
-	AgentsByCreator(ctx context.Context, in *QueryAgentsByCreatorRequest, opts ...grpc.CallOption) (*QueryAgentsByCreatorResponse, error)
-	// Agents queries all the agents.
-	Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error)
-	// Proposal queries a proposal.
-	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
-	// ProposalsByProposer queries all the proposals by its proposer.
-	ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error)
-	// Proposals queries all the proposals.
-	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error) {
-	out := new(QueryAgentResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Agent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AgentsByCreator(ctx context.Context, in *QueryAgentsByCreatorRequest, opts ...grpc.CallOption) (*QueryAgentsByCreatorResponse, error) {
-	out := new(QueryAgentsByCreatorResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/AgentsByCreator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error) {
-	out := new(QueryAgentsResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Agents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
-	out := new(QueryProposalResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Proposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByMessageTypeResponse_Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error) {
-	out := new(QueryProposalsByProposerResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/ProposalsByProposer", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryProposalsByOfferedAssetRequest is the request type for the Query/ProposalsByOfferedAsset RPC method.
+type QueryProposalsByOfferedAssetRequest struct {
+	// the denom of coins
+	// Note: exactly one of denom and class_id must be provided.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the id of an x/nft class
+	// Note: exactly one of denom and class_id must be provided.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByOfferedAssetRequest) Reset()         { *m = QueryProposalsByOfferedAssetRequest{} }
+func (m *QueryProposalsByOfferedAssetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByOfferedAssetRequest) ProtoMessage()    {}
+func (*QueryProposalsByOfferedAssetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{14}
+}
+func (m *QueryProposalsByOfferedAssetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByOfferedAssetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByOfferedAssetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProposalsByOfferedAssetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByOfferedAssetRequest.Merge(m, src)
+}
+func (m *QueryProposalsByOfferedAssetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByOfferedAssetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByOfferedAssetRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
-	out := new(QueryProposalsResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Proposals", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalsByOfferedAssetRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByOfferedAssetRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Agent queries an agent.
-	Agent(context.Context, *QueryAgentRequest) (*QueryAgentResponse, error)
-	// AgentsByCreator queries all the agents by its creator.
-	AgentsByCreator(context.Context, *QueryAgentsByCreatorRequest) (*QueryAgentsByCreatorResponse, error)
-	// Agents queries all the agents.
-	Agents(context.Context, *QueryAgentsRequest) (*QueryAgentsResponse, error)
-	// Proposal queries a proposal.
-	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
-	// ProposalsByProposer queries all the proposals by its proposer.
-	ProposalsByProposer(context.Context, *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error)
-	// Proposals queries all the proposals.
-	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+func (m *QueryProposalsByOfferedAssetRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryProposalsByOfferedAssetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryProposalsByOfferedAssetResponse is the response type for the Query/ProposalsByOfferedAsset RPC method.
+type QueryProposalsByOfferedAssetResponse struct {
+	// all the proposals offering the asset
+	Proposals []*QueryProposalsByOfferedAssetResponse_Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Agent(ctx context.Context, req *QueryAgentRequest) (*QueryAgentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Agent not implemented")
+
+func (m *QueryProposalsByOfferedAssetResponse) Reset()         { *m = QueryProposalsByOfferedAssetResponse{} }
+func (m *QueryProposalsByOfferedAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByOfferedAssetResponse) ProtoMessage()    {}
+func (*QueryProposalsByOfferedAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{15}
 }
-func (*UnimplementedQueryServer) AgentsByCreator(ctx context.Context, req *QueryAgentsByCreatorRequest) (*QueryAgentsByCreatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AgentsByCreator not implemented")
+func (m *QueryProposalsByOfferedAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Agents(ctx context.Context, req *QueryAgentsRequest) (*QueryAgentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Agents not implemented")
+func (m *QueryProposalsByOfferedAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByOfferedAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+func (m *QueryProposalsByOfferedAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByOfferedAssetResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ProposalsByProposer(ctx context.Context, req *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByProposer not implemented")
+func (m *QueryProposalsByOfferedAssetResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+func (m *QueryProposalsByOfferedAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByOfferedAssetResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryProposalsByOfferedAssetResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/andromeda.escrow.v1alpha1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryProposalsByOfferedAssetResponse) GetProposals() []*QueryProposalsByOfferedAssetResponse_Proposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Agent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Agent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/andromeda.escrow.v1alpha1.Query/Agent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Agent(ctx, req.(*QueryAgentRequest))
+func (m *QueryProposalsByOfferedAssetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AgentsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentsByCreatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AgentsByCreator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/andromeda.escrow.v1alpha1.Query/AgentsByCreator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AgentsByCreator(ctx, req.(*QueryAgentsByCreatorRequest))
+// Proposal defines a proposal.
+type QueryProposalsByOfferedAssetResponse_Proposal struct {
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the messages which has been executed on the submission
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the messages which will be executed after the actions included in Msg/Exec
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// any arbitrary metadata attached to the proposal
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
+}
+
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) Reset() {
+	*m = QueryProposalsByOfferedAssetResponse_Proposal{}
+}
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryProposalsByOfferedAssetResponse_Proposal) ProtoMessage() {}
+func (*QueryProposalsByOfferedAssetResponse_Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{15, 0}
+}
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByOfferedAssetResponse_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByOfferedAssetResponse_Proposal.Merge(m, src)
+}
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByOfferedAssetResponse_Proposal.DiscardUnknown(m)
 }
 
-func _Query_Agents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Agents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/andromeda.escrow.v1alpha1.Query/Agents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Agents(ctx, req.(*QueryAgentsRequest))
+var xxx_messageInfo_QueryProposalsByOfferedAssetResponse_Proposal proto.InternalMessageInfo
+
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/andromeda.escrow.v1alpha1.Query/Proposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ProposalsByProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalsByProposerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProposalsByProposer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/andromeda.escrow.v1alpha1.Query/ProposalsByProposer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProposalsByProposer(ctx, req.(*QueryProposalsByProposerRequest))
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetPreActions() []*types1.Any {
+	if m != nil {
+		return m.PreActions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/andromeda.escrow.v1alpha1.Query/Proposals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetPostActions() []*types1.Any {
+	if m != nil {
+		return m.PostActions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "andromeda.escrow.v1alpha1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Agent",
-			Handler:    _Query_Agent_Handler,
-		},
-		{
-			MethodName: "AgentsByCreator",
-			Handler:    _Query_AgentsByCreator_Handler,
-		},
-		{
-			MethodName: "Agents",
-			Handler:    _Query_Agents_Handler,
-		},
-		{
-			MethodName: "Proposal",
-			Handler:    _Query_Proposal_Handler,
-		},
-		{
-			MethodName: "ProposalsByProposer",
-			Handler:    _Query_ProposalsByProposer_Handler,
-		},
-		{
-			MethodName: "Proposals",
-			Handler:    _Query_Proposals_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "andromeda/escrow/v1alpha1/query.proto",
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxMetadataLength != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMetadataLength))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAgentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
+	}
+	return 0
 }
 
-func (m *QueryAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Agent) > 0 {
-		i -= len(m.Agent)
-		copy(dAtA[i:], m.Agent)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAgentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
+	}
+	return false
 }
 
-func (m *QueryAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Agent != nil {
-		{
-			size, err := m.Agent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryProposalsByAskedAssetRequest is the request type for the Query/ProposalsByAskedAsset RPC method.
+type QueryProposalsByAskedAssetRequest struct {
+	// the denom of coins
+	// Note: exactly one of denom and class_id must be provided.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the id of an x/nft class
+	// Note: exactly one of denom and class_id must be provided.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentResponse_Agent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByAskedAssetRequest) Reset()         { *m = QueryProposalsByAskedAssetRequest{} }
+func (m *QueryProposalsByAskedAssetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByAskedAssetRequest) ProtoMessage()    {}
+func (*QueryProposalsByAskedAssetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{16}
+}
+func (m *QueryProposalsByAskedAssetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByAskedAssetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByAskedAssetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAgentResponse_Agent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByAskedAssetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByAskedAssetRequest.Merge(m, src)
+}
+func (m *QueryProposalsByAskedAssetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByAskedAssetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByAskedAssetRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAgentResponse_Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryProposalsByAskedAssetRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByAskedAssetRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryProposalsByAskedAssetRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAgentsByCreatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByAskedAssetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposalsByAskedAssetResponse is the response type for the Query/ProposalsByAskedAsset RPC method.
+type QueryProposalsByAskedAssetResponse struct {
+	// all the proposals asking the asset
+	Proposals []*QueryProposalsByAskedAssetResponse_Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProposalsByAskedAssetResponse) Reset()         { *m = QueryProposalsByAskedAssetResponse{} }
+func (m *QueryProposalsByAskedAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByAskedAssetResponse) ProtoMessage()    {}
+func (*QueryProposalsByAskedAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{17}
+}
+func (m *QueryProposalsByAskedAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByAskedAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByAskedAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalsByAskedAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByAskedAssetResponse.Merge(m, src)
+}
+func (m *QueryProposalsByAskedAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByAskedAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByAskedAssetResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAgentsByCreatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalsByAskedAssetResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByAskedAssetResponse) GetProposals() []*QueryProposalsByAskedAssetResponse_Proposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByAskedAssetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAgentsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Agents) > 0 {
-		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// Proposal defines a proposal.
+type QueryProposalsByAskedAssetResponse_Proposal struct {
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the messages which has been executed on the submission
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the messages which will be executed after the actions included in Msg/Exec
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// any arbitrary metadata attached to the proposal
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
+}
+
+func (m *QueryProposalsByAskedAssetResponse_Proposal) Reset() {
+	*m = QueryProposalsByAskedAssetResponse_Proposal{}
+}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryProposalsByAskedAssetResponse_Proposal) ProtoMessage() {}
+func (*QueryProposalsByAskedAssetResponse_Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{17, 0}
+}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByAskedAssetResponse_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByAskedAssetResponse_Proposal.Merge(m, src)
+}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByAskedAssetResponse_Proposal.DiscardUnknown(m)
 }
 
-func (m *QueryAgentsByCreatorResponse_Agent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalsByAskedAssetResponse_Proposal proto.InternalMessageInfo
+
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAgentsByCreatorResponse_Agent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
 }
 
-func (m *QueryAgentsByCreatorResponse_Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetPreActions() []*types1.Any {
+	if m != nil {
+		return m.PreActions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAgentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetPostActions() []*types1.Any {
+	if m != nil {
+		return m.PostActions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
 }
 
-func (m *QueryAgentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAgentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAgentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
 }
 
-func (m *QueryAgentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Agents) > 0 {
-		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAgentsResponse_Agent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentsResponse_Agent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
 }
 
-func (m *QueryAgentsResponse_Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
 }
 
-func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Agent) > 0 {
-		i -= len(m.Agent)
-		copy(dAtA[i:], m.Agent)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsByAskedAssetResponse_Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
+type QueryProposalsRequest struct {
+	// optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Proposal != nil {
-		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
+func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsRequest) ProtoMessage()    {}
+func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{18}
+}
+func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProposalResponse_Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
 }
-
-func (m *QueryProposalResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryProposalResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PostActions) > 0 {
-		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PreActions) > 0 {
-		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Agent) > 0 {
-		i -= len(m.Agent)
-		copy(dAtA[i:], m.Agent)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProposalsByProposerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProposalsByProposerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposalsResponse is the response type for the Query/Proposals RPC method.
+type QueryProposalsResponse struct {
+	// all the proposals
+	Proposals []*QueryProposalsResponse_Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProposalsByProposerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{19}
+}
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+}
+func (m *QueryProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsResponse) GetProposals() []*QueryProposalsResponse_Proposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryProposalsByProposerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProposalsByProposerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Proposal defines a proposal.
+type QueryProposalsResponse_Proposal struct {
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the messages which has been executed on the submission
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the messages which will be executed after the actions included in Msg/Exec
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// any arbitrary metadata attached to the proposal
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
 }
 
-func (m *QueryProposalsByProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryProposalsResponse_Proposal) Reset()         { *m = QueryProposalsResponse_Proposal{} }
+func (m *QueryProposalsResponse_Proposal) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse_Proposal) ProtoMessage()    {}
+func (*QueryProposalsResponse_Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{19, 0}
+}
+func (m *QueryProposalsResponse_Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsResponse_Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalsResponse_Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse_Proposal.Merge(m, src)
+}
+func (m *QueryProposalsResponse_Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsResponse_Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse_Proposal.DiscardUnknown(m)
 }
 
-func (m *QueryProposalsByProposerResponse_Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalsResponse_Proposal proto.InternalMessageInfo
+
+func (m *QueryProposalsResponse_Proposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryProposalsByProposerResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsResponse_Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
 }
 
-func (m *QueryProposalsByProposerResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PostActions) > 0 {
-		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PreActions) > 0 {
-		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryProposalsResponse_Proposal) GetPreActions() []*types1.Any {
+	if m != nil {
+		return m.PreActions
 	}
-	if len(m.Agent) > 0 {
-		i -= len(m.Agent)
-		copy(dAtA[i:], m.Agent)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryProposalsResponse_Proposal) GetPostActions() []*types1.Any {
+	if m != nil {
+		return m.PostActions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsResponse_Proposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsResponse_Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
 }
 
-func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProposalsResponse_Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsResponse_Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsResponse_Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
 }
 
-func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryProposalsResponse_Proposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryProposalsResponse_Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProposalsResponse_Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryProposalsResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalsResponse_Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
 }
 
-func (m *QueryProposalsResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PostActions) > 0 {
-		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PreActions) > 0 {
-		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *QueryProposalsResponse_Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *QueryProposalsResponse_Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
 	}
-	if len(m.Agent) > 0 {
-		i -= len(m.Agent)
-		copy(dAtA[i:], m.Agent)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QueryProposalsResponse_Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryProposalsResponse_Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QuerySimulateExecRequest is the request type for the Query/SimulateExec RPC
+// method.
+type QuerySimulateExecRequest struct {
+	// the address of the account executing the proposal
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// the addresses of the agents in charge
+	Agents []string `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
+	// the messages which will be executed on the execution
+	Actions []*types1.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// the quantities of the units to fill, in the same order of the agents
+	Quantities []uint64 `protobuf:"varint,4,rep,packed,name=quantities,proto3" json:"quantities,omitempty"`
+	// the value of the execution declared by the executor
+	DeclaredValue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=declared_value,json=declaredValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"declared_value"`
+}
+
+func (m *QuerySimulateExecRequest) Reset()         { *m = QuerySimulateExecRequest{} }
+func (m *QuerySimulateExecRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateExecRequest) ProtoMessage()    {}
+func (*QuerySimulateExecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{20}
+}
+func (m *QuerySimulateExecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateExecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateExecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QuerySimulateExecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateExecRequest.Merge(m, src)
+}
+func (m *QuerySimulateExecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateExecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateExecRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySimulateExecRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateExecRequest) GetExecutor() string {
+	if m != nil {
+		return m.Executor
 	}
-	var l int
-	_ = l
-	if m.MaxMetadataLength != 0 {
-		n += 1 + sovQuery(uint64(m.MaxMetadataLength))
+	return ""
+}
+
+func (m *QuerySimulateExecRequest) GetAgents() []string {
+	if m != nil {
+		return m.Agents
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAgentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateExecRequest) GetActions() []*types1.Any {
+	if m != nil {
+		return m.Actions
 	}
-	var l int
-	_ = l
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QuerySimulateExecRequest) GetQuantities() []uint64 {
+	if m != nil {
+		return m.Quantities
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateExecRequest) GetDeclaredValue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DeclaredValue
 	}
-	var l int
-	_ = l
-	if m.Agent != nil {
-		l = m.Agent.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QuerySimulateExecResponse is the response type for the Query/SimulateExec
+// RPC method.
+type QuerySimulateExecResponse struct {
+	// whether Msg/Exec would succeed
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// the gas consumed by Msg/Exec
+	// Note: it excludes the gas consumed by the transaction itself (e.g. the
+	// signature verification).
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// the events emitted by Msg/Exec
+	// Note: empty on the failure.
+	Events []*QuerySimulateExecResponse_Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// the error on the failure
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// the codespace of the error
+	Codespace string `protobuf:"bytes,5,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// the code of the error
+	Code uint32 `protobuf:"varint,6,opt,name=code,proto3" json:"code,omitempty"`
+	// the phase where the actions failed on their execution (i.e. actions, post_actions or remainders)
+	// Note: empty means the failure is not of the execution of the actions.
+	FailedPhase string `protobuf:"bytes,7,opt,name=failed_phase,json=failedPhase,proto3" json:"failed_phase,omitempty"`
+	// whether a specific action in failed_phase failed
+	// Note: false means the phase failed as a whole (e.g. by the exec gas).
+	ActionFailed bool `protobuf:"varint,8,opt,name=action_failed,json=actionFailed,proto3" json:"action_failed,omitempty"`
+	// the index of the first failing action in failed_phase
+	// Note: meaningful only if action_failed is true.
+	FailedActionIndex uint64 `protobuf:"varint,9,opt,name=failed_action_index,json=failedActionIndex,proto3" json:"failed_action_index,omitempty"`
+	// whether the post_actions of a specific agent failed
+	// Note: meaningful only if failed_phase is post_actions.
+	AgentFailed bool `protobuf:"varint,10,opt,name=agent_failed,json=agentFailed,proto3" json:"agent_failed,omitempty"`
+	// the index of the agent in the request whose post_actions failed
+	// Note: meaningful only if agent_failed is true.
+	FailedAgentIndex uint64 `protobuf:"varint,11,opt,name=failed_agent_index,json=failedAgentIndex,proto3" json:"failed_agent_index,omitempty"`
+}
+
+func (m *QuerySimulateExecResponse) Reset()         { *m = QuerySimulateExecResponse{} }
+func (m *QuerySimulateExecResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateExecResponse) ProtoMessage()    {}
+func (*QuerySimulateExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{21}
+}
+func (m *QuerySimulateExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySimulateExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateExecResponse.Merge(m, src)
+}
+func (m *QuerySimulateExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateExecResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAgentResponse_Agent) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySimulateExecResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateExecResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QuerySimulateExecResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QuerySimulateExecResponse) GetEvents() []*QuerySimulateExecResponse_Event {
+	if m != nil {
+		return m.Events
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAgentsByCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateExecResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QuerySimulateExecResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QuerySimulateExecResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAgentsByCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateExecResponse) GetFailedPhase() string {
+	if m != nil {
+		return m.FailedPhase
 	}
-	var l int
-	_ = l
-	if len(m.Agents) > 0 {
-		for _, e := range m.Agents {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return ""
 }
 
-func (m *QueryAgentsByCreatorResponse_Agent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateExecResponse) GetActionFailed() bool {
+	if m != nil {
+		return m.ActionFailed
 	}
-	return n
+	return false
 }
 
-func (m *QueryAgentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateExecResponse) GetFailedActionIndex() uint64 {
+	if m != nil {
+		return m.FailedActionIndex
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QuerySimulateExecResponse) GetAgentFailed() bool {
+	if m != nil {
+		return m.AgentFailed
 	}
-	return n
+	return false
 }
 
-func (m *QueryAgentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateExecResponse) GetFailedAgentIndex() uint64 {
+	if m != nil {
+		return m.FailedAgentIndex
 	}
-	var l int
-	_ = l
-	if len(m.Agents) > 0 {
-		for _, e := range m.Agents {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// Event defines an event emitted on the simulation.
+type QuerySimulateExecResponse_Event struct {
+	// the type of the event
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// the attributes of the event
+	Attributes []*QuerySimulateExecResponse_Event_Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *QuerySimulateExecResponse_Event) Reset()         { *m = QuerySimulateExecResponse_Event{} }
+func (m *QuerySimulateExecResponse_Event) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateExecResponse_Event) ProtoMessage()    {}
+func (*QuerySimulateExecResponse_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{21, 0}
+}
+func (m *QuerySimulateExecResponse_Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateExecResponse_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateExecResponse_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QuerySimulateExecResponse_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateExecResponse_Event.Merge(m, src)
+}
+func (m *QuerySimulateExecResponse_Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateExecResponse_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateExecResponse_Event.DiscardUnknown(m)
 }
 
-func (m *QueryAgentsResponse_Agent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySimulateExecResponse_Event proto.InternalMessageInfo
+
+func (m *QuerySimulateExecResponse_Event) GetEventType() string {
+	if m != nil {
+		return m.EventType
 	}
-	return n
+	return ""
 }
 
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateExecResponse_Event) GetAttributes() []*QuerySimulateExecResponse_Event_Attribute {
+	if m != nil {
+		return m.Attributes
 	}
-	return n
+	return nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// Attribute defines an attribute of an event.
+type QuerySimulateExecResponse_Event_Attribute struct {
+	// the key of the attribute
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// the value of the attribute
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *QueryProposalResponse_Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateExecResponse_Event_Attribute) Reset() {
+	*m = QuerySimulateExecResponse_Event_Attribute{}
+}
+func (m *QuerySimulateExecResponse_Event_Attribute) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QuerySimulateExecResponse_Event_Attribute) ProtoMessage() {}
+func (*QuerySimulateExecResponse_Event_Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{21, 0, 0}
+}
+func (m *QuerySimulateExecResponse_Event_Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateExecResponse_Event_Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateExecResponse_Event_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySimulateExecResponse_Event_Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateExecResponse_Event_Attribute.Merge(m, src)
+}
+func (m *QuerySimulateExecResponse_Event_Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateExecResponse_Event_Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateExecResponse_Event_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateExecResponse_Event_Attribute proto.InternalMessageInfo
+
+func (m *QuerySimulateExecResponse_Event_Attribute) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QuerySimulateExecResponse_Event_Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.PreActions) > 0 {
-		for _, e := range m.PreActions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QuerySimulateSubmitRequest is the request type for the Query/SimulateSubmit
+// RPC method.
+type QuerySimulateSubmitRequest struct {
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the messages which will be executed on the submission
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the messages which will be executed after the actions included in Msg/Exec
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// any arbitrary metadata attached to the proposal
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the total quantity of the units
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	MaxExecGas uint64 `protobuf:"varint,12,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+}
+
+func (m *QuerySimulateSubmitRequest) Reset()         { *m = QuerySimulateSubmitRequest{} }
+func (m *QuerySimulateSubmitRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSubmitRequest) ProtoMessage()    {}
+func (*QuerySimulateSubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{22}
+}
+func (m *QuerySimulateSubmitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSubmitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PostActions) > 0 {
-		for _, e := range m.PostActions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+}
+func (m *QuerySimulateSubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSubmitRequest.Merge(m, src)
+}
+func (m *QuerySimulateSubmitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSubmitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSubmitRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSubmitRequest) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QuerySimulateSubmitRequest) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return n
+	return ""
 }
 
-func (m *QueryProposalsByProposerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSubmitRequest) GetPreActions() []*types1.Any {
+	if m != nil {
+		return m.PreActions
 	}
-	var l int
-	_ = l
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QuerySimulateSubmitRequest) GetPostActions() []*types1.Any {
+	if m != nil {
+		return m.PostActions
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QuerySimulateSubmitRequest) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return ""
 }
 
-func (m *QueryProposalsByProposerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSubmitRequest) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
 	}
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return nil
+}
+
+func (m *QuerySimulateSubmitRequest) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QuerySimulateSubmitRequest) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
 	}
-	return n
+	return nil
 }
 
-func (m *QueryProposalsByProposerResponse_Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSubmitRequest) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	var l int
-	_ = l
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QuerySimulateSubmitRequest) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QuerySimulateSubmitRequest) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
 	}
-	if len(m.PreActions) > 0 {
-		for _, e := range m.PreActions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return 0
+}
+
+func (m *QuerySimulateSubmitRequest) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
 	}
-	if len(m.PostActions) > 0 {
-		for _, e := range m.PostActions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QuerySimulateSubmitResponse is the response type for the
+// Query/SimulateSubmit RPC method.
+type QuerySimulateSubmitResponse struct {
+	// whether Msg/SubmitProposal would succeed
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// the gas consumed by Msg/SubmitProposal
+	// Note: it excludes the gas consumed by the transaction itself (e.g. the
+	// signature verification).
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// the events emitted by Msg/SubmitProposal
+	// Note: empty on the failure.
+	Events []*QuerySimulateSubmitResponse_Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// the error on the failure
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// the codespace of the error
+	Codespace string `protobuf:"bytes,5,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// the code of the error
+	Code uint32 `protobuf:"varint,6,opt,name=code,proto3" json:"code,omitempty"`
+	// the phase where the actions failed on their execution (i.e. pre_actions)
+	// Note: empty means the failure is not of the execution of the actions.
+	FailedPhase string `protobuf:"bytes,7,opt,name=failed_phase,json=failedPhase,proto3" json:"failed_phase,omitempty"`
+	// whether a specific action in failed_phase failed
+	// Note: false means the phase failed as a whole (e.g. by the exec gas).
+	ActionFailed bool `protobuf:"varint,8,opt,name=action_failed,json=actionFailed,proto3" json:"action_failed,omitempty"`
+	// the index of the first failing action in failed_phase
+	// Note: meaningful only if action_failed is true.
+	FailedActionIndex uint64 `protobuf:"varint,9,opt,name=failed_action_index,json=failedActionIndex,proto3" json:"failed_action_index,omitempty"`
+}
+
+func (m *QuerySimulateSubmitResponse) Reset()         { *m = QuerySimulateSubmitResponse{} }
+func (m *QuerySimulateSubmitResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSubmitResponse) ProtoMessage()    {}
+func (*QuerySimulateSubmitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{23}
+}
+func (m *QuerySimulateSubmitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSubmitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySimulateSubmitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSubmitResponse.Merge(m, src)
+}
+func (m *QuerySimulateSubmitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSubmitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSubmitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSubmitResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSubmitResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return n
+	return false
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSubmitResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QuerySimulateSubmitResponse) GetEvents() []*QuerySimulateSubmitResponse_Event {
+	if m != nil {
+		return m.Events
 	}
-	return n
+	return nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSubmitResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QuerySimulateSubmitResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *QuerySimulateSubmitResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *QuerySimulateSubmitResponse) GetFailedPhase() string {
+	if m != nil {
+		return m.FailedPhase
+	}
+	return ""
+}
+
+func (m *QuerySimulateSubmitResponse) GetActionFailed() bool {
+	if m != nil {
+		return m.ActionFailed
+	}
+	return false
+}
+
+func (m *QuerySimulateSubmitResponse) GetFailedActionIndex() uint64 {
+	if m != nil {
+		return m.FailedActionIndex
+	}
+	return 0
+}
+
+// Event defines an event emitted on the simulation.
+type QuerySimulateSubmitResponse_Event struct {
+	// the type of the event
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// the attributes of the event
+	Attributes []*QuerySimulateSubmitResponse_Event_Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *QuerySimulateSubmitResponse_Event) Reset()         { *m = QuerySimulateSubmitResponse_Event{} }
+func (m *QuerySimulateSubmitResponse_Event) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSubmitResponse_Event) ProtoMessage()    {}
+func (*QuerySimulateSubmitResponse_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{23, 0}
+}
+func (m *QuerySimulateSubmitResponse_Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSubmitResponse_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSubmitResponse_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySimulateSubmitResponse_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSubmitResponse_Event.Merge(m, src)
+}
+func (m *QuerySimulateSubmitResponse_Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSubmitResponse_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSubmitResponse_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSubmitResponse_Event proto.InternalMessageInfo
+
+func (m *QuerySimulateSubmitResponse_Event) GetEventType() string {
+	if m != nil {
+		return m.EventType
 	}
-	return n
+	return ""
 }
 
-func (m *QueryProposalsResponse_Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSubmitResponse_Event) GetAttributes() []*QuerySimulateSubmitResponse_Event_Attribute {
+	if m != nil {
+		return m.Attributes
 	}
-	var l int
-	_ = l
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// Attribute defines an attribute of an event.
+type QuerySimulateSubmitResponse_Event_Attribute struct {
+	// the key of the attribute
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// the value of the attribute
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QuerySimulateSubmitResponse_Event_Attribute) Reset() {
+	*m = QuerySimulateSubmitResponse_Event_Attribute{}
+}
+func (m *QuerySimulateSubmitResponse_Event_Attribute) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QuerySimulateSubmitResponse_Event_Attribute) ProtoMessage() {}
+func (*QuerySimulateSubmitResponse_Event_Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a41d203399b1b7, []int{23, 0, 0}
+}
+func (m *QuerySimulateSubmitResponse_Event_Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSubmitResponse_Event_Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSubmitResponse_Event_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySimulateSubmitResponse_Event_Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSubmitResponse_Event_Attribute.Merge(m, src)
+}
+func (m *QuerySimulateSubmitResponse_Event_Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSubmitResponse_Event_Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSubmitResponse_Event_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSubmitResponse_Event_Attribute proto.InternalMessageInfo
+
+func (m *QuerySimulateSubmitResponse_Event_Attribute) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if len(m.PreActions) > 0 {
-		for _, e := range m.PreActions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QuerySimulateSubmitResponse_Event_Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "andromeda.escrow.v1alpha1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "andromeda.escrow.v1alpha1.QueryParamsResponse")
+	proto.RegisterType((*QueryAgentRequest)(nil), "andromeda.escrow.v1alpha1.QueryAgentRequest")
+	proto.RegisterType((*QueryAgentResponse)(nil), "andromeda.escrow.v1alpha1.QueryAgentResponse")
+	proto.RegisterType((*QueryAgentResponse_Agent)(nil), "andromeda.escrow.v1alpha1.QueryAgentResponse.Agent")
+	proto.RegisterType((*QueryAgentsByCreatorRequest)(nil), "andromeda.escrow.v1alpha1.QueryAgentsByCreatorRequest")
+	proto.RegisterType((*QueryAgentsByCreatorResponse)(nil), "andromeda.escrow.v1alpha1.QueryAgentsByCreatorResponse")
+	proto.RegisterType((*QueryAgentsByCreatorResponse_Agent)(nil), "andromeda.escrow.v1alpha1.QueryAgentsByCreatorResponse.Agent")
+	proto.RegisterType((*QueryAgentsRequest)(nil), "andromeda.escrow.v1alpha1.QueryAgentsRequest")
+	proto.RegisterType((*QueryAgentsResponse)(nil), "andromeda.escrow.v1alpha1.QueryAgentsResponse")
+	proto.RegisterType((*QueryAgentsResponse_Agent)(nil), "andromeda.escrow.v1alpha1.QueryAgentsResponse.Agent")
+	proto.RegisterType((*QueryProposalRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalRequest")
+	proto.RegisterType((*QueryProposalResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalResponse")
+	proto.RegisterType((*QueryProposalResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalResponse.Proposal")
+	proto.RegisterType((*QueryProposalsByProposerRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByProposerRequest")
+	proto.RegisterType((*QueryProposalsByProposerResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByProposerResponse")
+	proto.RegisterType((*QueryProposalsByProposerResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByProposerResponse.Proposal")
+	proto.RegisterType((*QueryProposalsByMessageTypeRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByMessageTypeRequest")
+	proto.RegisterType((*QueryProposalsByMessageTypeResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByMessageTypeResponse")
+	proto.RegisterType((*QueryProposalsByMessageTypeResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByMessageTypeResponse.Proposal")
+	proto.RegisterType((*QueryProposalsByOfferedAssetRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByOfferedAssetRequest")
+	proto.RegisterType((*QueryProposalsByOfferedAssetResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByOfferedAssetResponse")
+	proto.RegisterType((*QueryProposalsByOfferedAssetResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByOfferedAssetResponse.Proposal")
+	proto.RegisterType((*QueryProposalsByAskedAssetRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByAskedAssetRequest")
+	proto.RegisterType((*QueryProposalsByAskedAssetResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByAskedAssetResponse")
+	proto.RegisterType((*QueryProposalsByAskedAssetResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalsByAskedAssetResponse.Proposal")
+	proto.RegisterType((*QueryProposalsRequest)(nil), "andromeda.escrow.v1alpha1.QueryProposalsRequest")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "andromeda.escrow.v1alpha1.QueryProposalsResponse")
+	proto.RegisterType((*QueryProposalsResponse_Proposal)(nil), "andromeda.escrow.v1alpha1.QueryProposalsResponse.Proposal")
+	proto.RegisterType((*QuerySimulateExecRequest)(nil), "andromeda.escrow.v1alpha1.QuerySimulateExecRequest")
+	proto.RegisterType((*QuerySimulateExecResponse)(nil), "andromeda.escrow.v1alpha1.QuerySimulateExecResponse")
+	proto.RegisterType((*QuerySimulateExecResponse_Event)(nil), "andromeda.escrow.v1alpha1.QuerySimulateExecResponse.Event")
+	proto.RegisterType((*QuerySimulateExecResponse_Event_Attribute)(nil), "andromeda.escrow.v1alpha1.QuerySimulateExecResponse.Event.Attribute")
+	proto.RegisterType((*QuerySimulateSubmitRequest)(nil), "andromeda.escrow.v1alpha1.QuerySimulateSubmitRequest")
+	proto.RegisterType((*QuerySimulateSubmitResponse)(nil), "andromeda.escrow.v1alpha1.QuerySimulateSubmitResponse")
+	proto.RegisterType((*QuerySimulateSubmitResponse_Event)(nil), "andromeda.escrow.v1alpha1.QuerySimulateSubmitResponse.Event")
+	proto.RegisterType((*QuerySimulateSubmitResponse_Event_Attribute)(nil), "andromeda.escrow.v1alpha1.QuerySimulateSubmitResponse.Event.Attribute")
+}
+
+func init() {
+	proto.RegisterFile("andromeda/escrow/v1alpha1/query.proto", fileDescriptor_84a41d203399b1b7)
+}
+
+var fileDescriptor_84a41d203399b1b7 = []byte{
+	// 2409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xf6, 0x52, 0x94, 0x48, 0x3d, 0x52, 0xb2, 0x3d, 0x92, 0xd3, 0x15, 0xed, 0x48, 0xf2, 0xda,
+	0x71, 0x15, 0xd7, 0x22, 0x2d, 0xc9, 0x3f, 0x88, 0x6c, 0xa7, 0x20, 0x6d, 0x4b, 0x31, 0x90, 0xb4,
+	0xea, 0xda, 0x09, 0x82, 0xc2, 0xc0, 0x62, 0xc8, 0x1d, 0x51, 0x0b, 0x93, 0xbb, 0xf4, 0xce, 0xd2,
+	0x91, 0x62, 0xf8, 0xd2, 0x5b, 0x0f, 0x45, 0xd3, 0xf6, 0x50, 0x14, 0x68, 0x53, 0xb4, 0x40, 0x81,
+	0x36, 0x87, 0xf4, 0x52, 0x34, 0xd7, 0x16, 0x05, 0x8a, 0xa0, 0x27, 0xa7, 0x45, 0x80, 0xf6, 0xd2,
+	0x14, 0x76, 0x4f, 0xbd, 0x16, 0x3d, 0xf4, 0x56, 0xcc, 0xdf, 0x6a, 0x49, 0x51, 0xd4, 0x92, 0x12,
+	0x0a, 0x25, 0xe0, 0x49, 0x9c, 0x79, 0x6f, 0xde, 0xbc, 0x9f, 0x99, 0x6f, 0xde, 0xce, 0x3c, 0xc1,
+	0x4b, 0xd8, 0xb5, 0x7d, 0xaf, 0x4e, 0x6c, 0x5c, 0x20, 0xb4, 0xe2, 0x7b, 0xef, 0x14, 0x1e, 0x2d,
+	0xe0, 0x5a, 0x63, 0x03, 0x2f, 0x14, 0x1e, 0x36, 0x89, 0xbf, 0x95, 0x6f, 0xf8, 0x5e, 0xe0, 0xa1,
+	0xa9, 0x90, 0x2d, 0x2f, 0xd8, 0xf2, 0x8a, 0x2d, 0x77, 0xbe, 0xe2, 0xd1, 0xba, 0x47, 0x0b, 0x65,
+	0x4c, 0x89, 0x18, 0x53, 0x78, 0xb4, 0x50, 0x26, 0x01, 0x5e, 0x28, 0x34, 0x70, 0xd5, 0x71, 0x71,
+	0xe0, 0x78, 0xae, 0x10, 0x93, 0x9b, 0x8e, 0xf2, 0x2a, 0xae, 0x8a, 0xe7, 0x28, 0xfa, 0x94, 0xa0,
+	0x5b, 0xbc, 0x55, 0x10, 0x0d, 0x49, 0x9a, 0xac, 0x7a, 0x55, 0x4f, 0xf4, 0xb3, 0x5f, 0xb2, 0xf7,
+	0x54, 0xd5, 0xf3, 0xaa, 0x35, 0x52, 0xc0, 0x0d, 0xa7, 0x80, 0x5d, 0xd7, 0x0b, 0xf8, 0x6c, 0x6a,
+	0xcc, 0x94, 0xa4, 0xf2, 0x56, 0xb9, 0xb9, 0x5e, 0xc0, 0xae, 0x34, 0x28, 0x37, 0xd3, 0x4e, 0x0a,
+	0x9c, 0x3a, 0xa1, 0x01, 0xae, 0x37, 0x04, 0x83, 0x31, 0x09, 0xe8, 0x1b, 0xcc, 0x98, 0x35, 0xec,
+	0xe3, 0x3a, 0x35, 0xc9, 0xc3, 0x26, 0xa1, 0x81, 0xf1, 0x51, 0x0a, 0x26, 0x5a, 0xba, 0x69, 0xc3,
+	0x73, 0x29, 0x41, 0x79, 0x98, 0xa8, 0xe3, 0x4d, 0xab, 0x4e, 0x02, 0x6c, 0xe3, 0x00, 0x5b, 0x35,
+	0xe2, 0x56, 0x83, 0x0d, 0x5d, 0x9b, 0xd5, 0xe6, 0x92, 0xe6, 0xf1, 0x3a, 0xde, 0x7c, 0x43, 0x52,
+	0x5e, 0xe7, 0x04, 0x74, 0x03, 0xc6, 0xc8, 0x26, 0xa9, 0x58, 0xeb, 0x84, 0x58, 0xeb, 0x35, 0x1c,
+	0xe8, 0x89, 0x59, 0x6d, 0x2e, 0xb3, 0x38, 0x95, 0x97, 0x36, 0x33, 0x07, 0xe5, 0xa5, 0x83, 0xf2,
+	0x37, 0x3d, 0xc7, 0x35, 0x33, 0x8c, 0x7f, 0x85, 0x90, 0x95, 0x1a, 0x0e, 0xd0, 0x2c, 0x64, 0xc3,
+	0xe1, 0xe5, 0x06, 0xd5, 0x87, 0x66, 0xb5, 0xb9, 0x31, 0x13, 0x24, 0x4b, 0xa9, 0x41, 0xd1, 0x13,
+	0x98, 0xac, 0x3b, 0x2e, 0x73, 0x64, 0xc3, 0xa3, 0xb8, 0x66, 0xd9, 0xa4, 0xe1, 0x51, 0x27, 0xd0,
+	0x93, 0xb3, 0x43, 0x5d, 0xe7, 0x29, 0x5d, 0xfc, 0xf8, 0xef, 0x33, 0x47, 0x3e, 0xf8, 0x6c, 0x66,
+	0xae, 0xea, 0x04, 0x1b, 0xcd, 0x72, 0xbe, 0xe2, 0xd5, 0x65, 0x20, 0xe4, 0x9f, 0x79, 0x6a, 0x3f,
+	0x28, 0x04, 0x5b, 0x0d, 0x42, 0xf9, 0x00, 0x6a, 0xa2, 0xba, 0xe3, 0xae, 0xc9, 0x79, 0x6e, 0x89,
+	0x69, 0xd0, 0x22, 0x9c, 0x28, 0x37, 0x7d, 0xd7, 0x22, 0x9b, 0x0d, 0xc7, 0x27, 0xb6, 0x9a, 0x9e,
+	0xea, 0xc3, 0xb3, 0xda, 0x5c, 0xda, 0x9c, 0x60, 0xc4, 0xdb, 0x82, 0x26, 0x87, 0x50, 0x74, 0x0e,
+	0x8e, 0x32, 0x1f, 0x36, 0x7c, 0x62, 0xe1, 0x0a, 0x0f, 0xa3, 0x3e, 0xc2, 0xfd, 0x37, 0x56, 0xc7,
+	0x9b, 0x6b, 0x3e, 0x29, 0x8a, 0x4e, 0x34, 0x07, 0xc7, 0x38, 0x9f, 0x47, 0x83, 0x90, 0x31, 0xc5,
+	0x19, 0xc7, 0x19, 0xa3, 0x47, 0x03, 0xc5, 0x39, 0x03, 0x19, 0xc6, 0xa9, 0x98, 0xd2, 0x9c, 0x09,
+	0xea, 0x78, 0x53, 0x31, 0xcc, 0x8b, 0xb0, 0xe1, 0x2a, 0x71, 0x03, 0x6a, 0x35, 0x88, 0x6f, 0x31,
+	0x17, 0xea, 0xa3, 0x9c, 0x91, 0xcd, 0x52, 0xe4, 0x94, 0x35, 0xe2, 0xdf, 0xde, 0x24, 0x15, 0xa5,
+	0xa1, 0x90, 0x67, 0x51, 0xe7, 0x5d, 0xa2, 0x43, 0xa8, 0xa1, 0x90, 0x79, 0xd7, 0x79, 0x97, 0x30,
+	0xeb, 0x71, 0xad, 0xe6, 0xbd, 0x43, 0x6c, 0xab, 0x4e, 0x28, 0xc5, 0x55, 0x62, 0x71, 0x87, 0xe9,
+	0x99, 0xd9, 0xa1, 0xb9, 0x51, 0x73, 0x42, 0x12, 0xdf, 0x10, 0xb4, 0x7b, 0x8c, 0x84, 0x2e, 0xc2,
+	0xa4, 0x4d, 0x5c, 0x67, 0xc7, 0x90, 0x2c, 0x1f, 0x82, 0x04, 0xad, 0x65, 0xc4, 0x79, 0x60, 0x0b,
+	0xcb, 0x72, 0x09, 0x0d, 0x1c, 0xb7, 0xca, 0x5c, 0x1c, 0x6c, 0xe8, 0x63, 0x5c, 0x1f, 0xa6, 0xe6,
+	0xd7, 0x44, 0xff, 0x2d, 0xd6, 0x8d, 0x0c, 0x18, 0xab, 0x62, 0x61, 0x21, 0x37, 0x56, 0x1f, 0xe7,
+	0x7c, 0x99, 0x2a, 0x66, 0xc6, 0x71, 0x2b, 0xd1, 0x59, 0x18, 0x0f, 0x79, 0xb8, 0x2d, 0xfa, 0x51,
+	0xce, 0x94, 0x95, 0x4c, 0xbc, 0x8f, 0xb9, 0xac, 0x95, 0xcb, 0x2a, 0x6f, 0x05, 0x44, 0x3f, 0x26,
+	0x5c, 0x16, 0x65, 0x2d, 0x6d, 0x05, 0x04, 0x15, 0x60, 0x52, 0x04, 0xb5, 0xe9, 0x12, 0x31, 0xaa,
+	0x5c, 0xf3, 0x2a, 0x0f, 0xf4, 0xe3, 0xe1, 0xce, 0x58, 0xe3, 0xa4, 0x35, 0xe2, 0x97, 0x18, 0x01,
+	0x5d, 0x00, 0xc4, 0x06, 0xf8, 0x64, 0xbd, 0xe9, 0xda, 0x61, 0xe8, 0x50, 0x18, 0x11, 0x93, 0x13,
+	0xda, 0xd6, 0x02, 0x75, 0xea, 0xcd, 0x1a, 0x0e, 0x88, 0x55, 0xc5, 0x54, 0x9f, 0x08, 0xd7, 0xc2,
+	0x5d, 0xd9, 0xbd, 0x8a, 0xa9, 0x71, 0x13, 0x8e, 0xf3, 0x8d, 0xcb, 0x6d, 0x95, 0xdb, 0x19, 0xe5,
+	0x61, 0x58, 0xb8, 0x83, 0x6d, 0xd4, 0xd1, 0x92, 0xfe, 0xe7, 0xdf, 0xcc, 0x4f, 0xca, 0x9d, 0x51,
+	0xb4, 0x6d, 0x9f, 0x50, 0x7a, 0x37, 0xf0, 0x1d, 0xb7, 0x6a, 0x0a, 0x36, 0xe3, 0xa9, 0x06, 0x28,
+	0x2a, 0x45, 0xee, 0xfe, 0x3b, 0x51, 0x31, 0x99, 0xc5, 0xa5, 0xfc, 0xae, 0x68, 0x99, 0xdf, 0x39,
+	0x3a, 0x2f, 0x5a, 0x42, 0x42, 0xce, 0x83, 0x61, 0x11, 0x8d, 0x45, 0x48, 0x61, 0xa1, 0xc2, 0x9e,
+	0xca, 0x29, 0x46, 0x36, 0xa6, 0xe2, 0x13, 0x1c, 0x78, 0xbe, 0x9e, 0xd8, 0x6b, 0x8c, 0x64, 0x34,
+	0x7e, 0xa4, 0xc1, 0xc9, 0x6d, 0xa5, 0x68, 0x69, 0xeb, 0xa6, 0x20, 0x28, 0x17, 0x45, 0x64, 0x6a,
+	0x31, 0x65, 0xa2, 0x15, 0x80, 0x6d, 0xe8, 0x97, 0xd0, 0x76, 0xae, 0x05, 0x72, 0xc4, 0xd9, 0xa2,
+	0x80, 0x67, 0x0d, 0x57, 0x89, 0x9c, 0xcf, 0x8c, 0x8c, 0x34, 0x7e, 0x9d, 0x80, 0x53, 0x9d, 0x75,
+	0x93, 0x8e, 0x7f, 0x13, 0x46, 0xc4, 0xde, 0xd5, 0x35, 0x8e, 0x6b, 0x37, 0x62, 0x79, 0x7e, 0xa7,
+	0x20, 0x19, 0x03, 0x29, 0x0c, 0xad, 0x76, 0xd0, 0xff, 0xcb, 0x7b, 0xea, 0x2f, 0x44, 0x45, 0x0d,
+	0xf8, 0xff, 0x47, 0xf3, 0x7e, 0x74, 0x7d, 0xaa, 0x53, 0xab, 0x2d, 0x1e, 0x5a, 0xdf, 0xf1, 0xf8,
+	0x49, 0x02, 0x26, 0x5a, 0xc4, 0xcb, 0x30, 0xbc, 0xde, 0x16, 0x86, 0x4b, 0xf1, 0xc2, 0xf0, 0x85,
+	0xf3, 0xfe, 0x05, 0x98, 0x14, 0xc9, 0x81, 0x3c, 0x0d, 0x95, 0xff, 0x27, 0x5b, 0x60, 0x46, 0x81,
+	0xc9, 0x2f, 0x53, 0x70, 0xa2, 0x8d, 0x3d, 0x5c, 0xd6, 0x69, 0x75, 0x70, 0xcb, 0x68, 0xbd, 0xb2,
+	0x97, 0x47, 0xdb, 0x65, 0xe4, 0xc3, 0x8e, 0x50, 0x54, 0xee, 0xa3, 0x11, 0x48, 0xab, 0xee, 0x5e,
+	0xa1, 0x0f, 0x5d, 0x52, 0x3a, 0x91, 0xbd, 0x1d, 0x12, 0x72, 0xa2, 0xcb, 0x90, 0x89, 0x9e, 0xe7,
+	0x43, 0x7c, 0x79, 0x4c, 0xe6, 0x45, 0xf2, 0x95, 0x57, 0xc9, 0x57, 0xbe, 0xe8, 0x6e, 0x99, 0xd0,
+	0xd8, 0x3e, 0xe2, 0xaf, 0x42, 0xb6, 0xe5, 0x78, 0x4f, 0x76, 0x19, 0x97, 0x69, 0x44, 0x4e, 0xfc,
+	0x1c, 0xa4, 0x55, 0x0e, 0xc6, 0x53, 0x8d, 0x51, 0x33, 0x6c, 0xa3, 0x6b, 0x30, 0xde, 0x76, 0xaa,
+	0x8c, 0x74, 0x11, 0x3b, 0xe6, 0xb7, 0x1c, 0x34, 0x67, 0x58, 0xc2, 0xc6, 0xf2, 0x15, 0x6b, 0x83,
+	0x38, 0xd5, 0x8d, 0x40, 0x66, 0x1c, 0x59, 0xd1, 0xf9, 0x1a, 0xef, 0x43, 0x45, 0xc8, 0x48, 0x26,
+	0x96, 0x4d, 0xf2, 0x7c, 0x23, 0xb3, 0x98, 0xdb, 0x21, 0xfe, 0x9e, 0x4a, 0x35, 0x4b, 0xc9, 0xf7,
+	0x3e, 0x9b, 0xd1, 0x4c, 0x10, 0x83, 0x58, 0x37, 0x33, 0xe0, 0x61, 0x13, 0xbb, 0x81, 0x13, 0x6c,
+	0xc9, 0x34, 0x24, 0x6c, 0xa3, 0x2b, 0x30, 0xca, 0xd2, 0x93, 0x66, 0xe0, 0xf9, 0x54, 0x87, 0xd9,
+	0xa1, 0xae, 0x31, 0xd8, 0x66, 0x65, 0x89, 0x82, 0x6a, 0x58, 0x55, 0xdf, 0x6b, 0x36, 0x2c, 0xc7,
+	0xd6, 0x33, 0x22, 0x51, 0x50, 0x84, 0x55, 0xd6, 0x7f, 0xc7, 0x46, 0x04, 0x52, 0x2a, 0x55, 0xcc,
+	0x1e, 0x7c, 0xaa, 0xa8, 0x64, 0xb3, 0x04, 0x96, 0x9d, 0xdb, 0x3c, 0x89, 0x65, 0x67, 0xf6, 0x58,
+	0x98, 0x9a, 0xb1, 0x44, 0x6b, 0x15, 0x73, 0x87, 0xd3, 0x66, 0xb9, 0xee, 0x04, 0xca, 0xe1, 0x22,
+	0x63, 0xc9, 0x8a, 0xce, 0x6d, 0x87, 0x4b, 0x26, 0xee, 0xf0, 0xa3, 0x71, 0x1d, 0x2e, 0x06, 0x71,
+	0x87, 0x9f, 0x01, 0x19, 0x69, 0x6b, 0x1d, 0x3b, 0x35, 0x62, 0xf3, 0x4c, 0x26, 0x6d, 0x66, 0x45,
+	0xe7, 0x0a, 0xef, 0x33, 0x7e, 0xaa, 0xc1, 0x4c, 0xcb, 0x36, 0xa3, 0x25, 0xf9, 0x93, 0x84, 0x07,
+	0x65, 0x74, 0x83, 0x68, 0xb1, 0x37, 0xc8, 0x41, 0x1d, 0x95, 0xdf, 0x4e, 0xc3, 0xec, 0xee, 0x1a,
+	0x4a, 0x5c, 0x29, 0xc3, 0xa8, 0x02, 0x03, 0x05, 0xd5, 0xb7, 0xe2, 0x02, 0x4b, 0x07, 0x79, 0xdb,
+	0x18, 0xb3, 0x2d, 0xf6, 0xe0, 0xd0, 0x7b, 0x80, 0x56, 0x03, 0xb4, 0x1a, 0xa0, 0x55, 0x3f, 0x68,
+	0xf5, 0x0b, 0x0d, 0x8c, 0xf6, 0xbd, 0x1b, 0xf9, 0x72, 0x54, 0x80, 0x75, 0x1a, 0xb2, 0xd1, 0x4f,
+	0x4d, 0x99, 0x9c, 0x64, 0xea, 0xdb, 0x9c, 0x2c, 0x71, 0x69, 0x6c, 0x60, 0x4a, 0xc4, 0x1e, 0x32,
+	0x45, 0xa3, 0x0d, 0xb3, 0x86, 0xfa, 0xc6, 0xac, 0xef, 0xa6, 0xe1, 0x4c, 0x57, 0x3d, 0x25, 0x6c,
+	0x91, 0x9d, 0xb0, 0xb5, 0xda, 0x03, 0x6c, 0x75, 0x10, 0x39, 0x40, 0xae, 0x01, 0x72, 0x0d, 0x90,
+	0xeb, 0xf0, 0x21, 0xd7, 0xfb, 0xda, 0x4e, 0x44, 0xf8, 0xfa, 0xfa, 0x3a, 0xf1, 0x89, 0x5d, 0xa4,
+	0x94, 0x04, 0x91, 0x0f, 0x2a, 0x9b, 0xb8, 0x5e, 0x5d, 0x7d, 0x50, 0xf1, 0x06, 0x9a, 0x82, 0x74,
+	0xa5, 0x86, 0x29, 0x65, 0x6e, 0x17, 0x80, 0x95, 0xe2, 0xed, 0x3b, 0xf6, 0x81, 0x41, 0xd6, 0xf7,
+	0xd2, 0x70, 0xb6, 0xbb, 0x82, 0x12, 0xb3, 0xd6, 0x77, 0x62, 0xd6, 0x6b, 0x3d, 0x60, 0x56, 0x27,
+	0x99, 0x03, 0xd0, 0x1a, 0x80, 0xd6, 0x00, 0xb4, 0x0e, 0x1f, 0x68, 0xfd, 0x58, 0x83, 0xd3, 0xed,
+	0xfb, 0xb7, 0x48, 0x1f, 0x1c, 0x16, 0xc8, 0xfa, 0x4e, 0x1a, 0x8c, 0x6e, 0xea, 0x49, 0xc0, 0xb2,
+	0x77, 0x02, 0xd6, 0x4a, 0x0f, 0x80, 0xb5, 0x53, 0xe2, 0x00, 0xae, 0x06, 0x70, 0x35, 0x80, 0xab,
+	0xc3, 0x07, 0x57, 0x56, 0xdb, 0xad, 0xf3, 0x81, 0xbf, 0x12, 0xfc, 0x27, 0x05, 0x2f, 0xb4, 0xcf,
+	0x20, 0x41, 0xe6, 0xed, 0x9d, 0x20, 0xb3, 0x1c, 0x1b, 0x64, 0x06, 0xc0, 0x32, 0x00, 0x96, 0x01,
+	0xb0, 0x1c, 0x5e, 0x60, 0xf9, 0x63, 0x02, 0x74, 0xbe, 0x63, 0xd5, 0xb3, 0x3b, 0xd3, 0x32, 0x72,
+	0x3b, 0xae, 0xbc, 0xb8, 0xf7, 0xed, 0xb8, 0xe2, 0x44, 0x17, 0xc3, 0x87, 0xc5, 0xc4, 0x1e, 0x91,
+	0x94, 0x7c, 0x28, 0x0f, 0xa9, 0x38, 0xfb, 0x48, 0x31, 0xa1, 0x69, 0x00, 0xb9, 0x74, 0x1c, 0x22,
+	0xb6, 0x50, 0xd2, 0x8c, 0xf4, 0x20, 0x1f, 0xc6, 0x6d, 0x52, 0xa9, 0x61, 0x56, 0xc4, 0xf2, 0x08,
+	0xd7, 0x9a, 0x44, 0x1f, 0x3e, 0xf8, 0x88, 0x8f, 0xa9, 0x29, 0xde, 0x62, 0x33, 0x18, 0xff, 0x4d,
+	0xc2, 0x54, 0x07, 0x47, 0x4a, 0x0c, 0xd5, 0x21, 0x45, 0x9b, 0x95, 0x8a, 0x7a, 0xcc, 0x4c, 0x9b,
+	0xaa, 0xc9, 0x92, 0x49, 0x56, 0x9a, 0xd1, 0xa4, 0x44, 0x24, 0x93, 0x49, 0x33, 0x55, 0xc5, 0xf4,
+	0x4d, 0x4a, 0x6c, 0x64, 0xc2, 0x08, 0x79, 0xc4, 0x1d, 0x39, 0x14, 0x0f, 0x75, 0x3b, 0x4d, 0x9d,
+	0xbf, 0xfd, 0x88, 0xbf, 0xd3, 0x0a, 0x49, 0x2c, 0xa3, 0x25, 0xbe, 0xef, 0xf9, 0x7a, 0x52, 0x64,
+	0xb4, 0xbc, 0x81, 0x4e, 0xc1, 0x68, 0xc5, 0xb3, 0x09, 0x6d, 0xe0, 0x0a, 0x91, 0xe8, 0xb2, 0xdd,
+	0x81, 0x10, 0x24, 0x59, 0x83, 0x17, 0xf6, 0x8c, 0x99, 0xfc, 0x37, 0xbb, 0x87, 0x14, 0xab, 0xca,
+	0x12, 0x77, 0x8d, 0x29, 0x3e, 0x28, 0x23, 0xfa, 0xd6, 0x58, 0x17, 0x5b, 0x7f, 0xb2, 0xd8, 0x44,
+	0xae, 0xbf, 0xb4, 0x58, 0x7f, 0xa2, 0x53, 0xac, 0x3f, 0x56, 0x83, 0x25, 0xe5, 0x48, 0x5e, 0xc7,
+	0xb5, 0xc9, 0xa6, 0x04, 0x88, 0xe3, 0x82, 0x24, 0x90, 0xea, 0x0e, 0x23, 0xb0, 0x79, 0xf9, 0xa2,
+	0x51, 0x32, 0x81, 0xcb, 0xcc, 0xf0, 0x3e, 0x29, 0xf2, 0x02, 0x20, 0x25, 0x92, 0x73, 0x0a, 0x89,
+	0x02, 0x15, 0x8e, 0x49, 0x89, 0x8c, 0xc0, 0x05, 0xe6, 0x7e, 0xaf, 0xc1, 0x30, 0x77, 0x11, 0x7a,
+	0x11, 0x80, 0x3b, 0x29, 0x7a, 0xb1, 0x3a, 0xca, 0x7b, 0xf8, 0xb5, 0xaa, 0x0d, 0x80, 0x83, 0xc0,
+	0x77, 0xca, 0xcd, 0x80, 0x88, 0xa5, 0x1d, 0xe3, 0x21, 0x66, 0xf7, 0x88, 0xe4, 0x8b, 0x4a, 0x98,
+	0x19, 0x91, 0x9b, 0x5b, 0x82, 0xd1, 0x90, 0x80, 0x8e, 0xc1, 0xd0, 0x03, 0xb2, 0x25, 0x55, 0x61,
+	0x3f, 0x59, 0xf8, 0xc4, 0x82, 0x96, 0x77, 0xbb, 0xbc, 0x61, 0x7c, 0x9a, 0x84, 0x5c, 0xcb, 0x74,
+	0x77, 0x39, 0x0a, 0xec, 0xef, 0x91, 0x2b, 0x3c, 0x46, 0x13, 0xf1, 0x8e, 0xd1, 0xc1, 0x81, 0xf8,
+	0x39, 0x3b, 0x10, 0xdb, 0x4f, 0xaa, 0x6c, 0xfb, 0x49, 0x65, 0x7c, 0x98, 0x84, 0x93, 0x1d, 0xd7,
+	0xd5, 0x7e, 0x50, 0xed, 0x5e, 0x1b, 0xaa, 0x5d, 0x8f, 0xbb, 0x87, 0x5a, 0x27, 0xff, 0x62, 0xe1,
+	0x5a, 0xee, 0x0f, 0x71, 0x61, 0x68, 0xbd, 0x03, 0x0c, 0xad, 0xec, 0xc7, 0x85, 0x07, 0x08, 0x44,
+	0x8b, 0x3f, 0x3b, 0x0e, 0xc3, 0x7c, 0x42, 0xf4, 0x7d, 0x0d, 0x46, 0x44, 0xb9, 0x2d, 0x9a, 0xdf,
+	0xf3, 0x63, 0x21, 0x5a, 0xad, 0x9b, 0xcb, 0xc7, 0x65, 0x17, 0x46, 0x18, 0x2f, 0x7f, 0xeb, 0x2f,
+	0xff, 0xfc, 0x41, 0xe2, 0x0c, 0x3a, 0x5d, 0xd8, 0xbd, 0x2a, 0xba, 0x21, 0x34, 0xf9, 0xa1, 0xa6,
+	0x8a, 0x8b, 0x2e, 0xc4, 0xac, 0xf6, 0x13, 0x2a, 0xcd, 0xf7, 0x54, 0x1b, 0x68, 0x2c, 0x70, 0x8d,
+	0xbe, 0x82, 0x5e, 0xee, 0xa2, 0x91, 0xc8, 0x7c, 0x0a, 0x8f, 0xf9, 0xdf, 0x27, 0xe8, 0x77, 0x1a,
+	0x1c, 0x6d, 0x2b, 0x73, 0x43, 0x57, 0x7a, 0xae, 0x8b, 0x13, 0xda, 0x5e, 0xed, 0xb3, 0x9e, 0xce,
+	0xb8, 0xce, 0xf5, 0xbe, 0x82, 0x2e, 0x75, 0xd1, 0x5b, 0x56, 0x4d, 0xd1, 0xc2, 0x63, 0xf9, 0xeb,
+	0x89, 0x34, 0x85, 0x47, 0x5c, 0x48, 0x46, 0xf3, 0x71, 0x4b, 0xc9, 0x62, 0x46, 0xbc, 0xb5, 0xf2,
+	0x2c, 0x56, 0xc4, 0xa5, 0x52, 0xbf, 0xd2, 0x22, 0x1f, 0x86, 0x85, 0xf8, 0xf5, 0x58, 0x42, 0xb1,
+	0x8b, 0xbd, 0x16, 0x70, 0x19, 0xcb, 0x5c, 0xb5, 0x4b, 0x68, 0x31, 0x76, 0xe8, 0x0b, 0xea, 0x73,
+	0x18, 0x7d, 0xa2, 0xc1, 0x44, 0x87, 0xc2, 0x0d, 0xb4, 0xdc, 0x57, 0xb5, 0x87, 0xb0, 0xe0, 0xda,
+	0x3e, 0x2a, 0x45, 0x8c, 0x22, 0x37, 0xe6, 0x1a, 0x7a, 0xa5, 0xdb, 0xce, 0x92, 0x83, 0x68, 0xe1,
+	0xb1, 0xfa, 0xb9, 0x6d, 0x12, 0x45, 0x9f, 0x6a, 0xf0, 0x42, 0xe7, 0x57, 0x5d, 0x74, 0xa3, 0xdf,
+	0xd7, 0x60, 0x61, 0xd9, 0xab, 0xfb, 0x7b, 0x4c, 0x8e, 0xb5, 0xd8, 0x43, 0x3b, 0xac, 0xf2, 0x56,
+	0x4b, 0x85, 0x37, 0xfa, 0x9b, 0x06, 0x5f, 0xda, 0xe5, 0xe5, 0x07, 0xbd, 0xda, 0xf7, 0x93, 0x91,
+	0xb0, 0xec, 0xab, 0xfb, 0x7c, 0x72, 0x32, 0x6e, 0x70, 0xd3, 0xae, 0xa2, 0xcb, 0x71, 0x4d, 0xf3,
+	0x84, 0x14, 0x0b, 0x73, 0xfd, 0x3f, 0xd1, 0xe0, 0x44, 0xc7, 0x4b, 0x62, 0x74, 0xbd, 0xcf, 0xbb,
+	0x65, 0x61, 0xd7, 0x8d, 0x7d, 0xdd, 0x4c, 0x1b, 0xd7, 0xb8, 0x55, 0x97, 0xd1, 0x52, 0x5c, 0xab,
+	0x30, 0x7d, 0x10, 0xda, 0xf4, 0xbe, 0x06, 0xa3, 0xa1, 0x78, 0x74, 0xb1, 0x87, 0xeb, 0x2b, 0xa1,
+	0xfb, 0x42, 0xcf, 0x17, 0x5e, 0xc6, 0x05, 0xae, 0xef, 0x39, 0x74, 0x36, 0x8e, 0xbe, 0xe8, 0x43,
+	0x0d, 0xb2, 0xd1, 0x8f, 0x05, 0xb4, 0xd4, 0xdb, 0xa7, 0x85, 0x50, 0xf3, 0x52, 0x3f, 0xdf, 0x23,
+	0xc6, 0x12, 0xd7, 0x74, 0xde, 0x98, 0xeb, 0xa2, 0xa9, 0x2a, 0xd6, 0x2f, 0xb0, 0x74, 0x71, 0x59,
+	0x3b, 0x8f, 0x7e, 0xab, 0xc1, 0x78, 0x6b, 0x5a, 0x81, 0x2e, 0xf7, 0x9a, 0x86, 0x08, 0xa5, 0xaf,
+	0xf4, 0x97, 0xbd, 0x18, 0x97, 0xb9, 0xda, 0x05, 0xe3, 0x7c, 0x1c, 0xb5, 0xc5, 0xbd, 0xc8, 0xb2,
+	0x76, 0xbe, 0xf4, 0x6f, 0xed, 0xe3, 0x67, 0xd3, 0xda, 0xd3, 0x67, 0xd3, 0xda, 0x3f, 0x9e, 0x4d,
+	0x6b, 0xef, 0x3d, 0x9f, 0x3e, 0xf2, 0xf4, 0xf9, 0xf4, 0x91, 0xbf, 0x3e, 0x9f, 0x3e, 0x02, 0x2f,
+	0x56, 0xbc, 0xfa, 0xee, 0xca, 0x94, 0x40, 0x45, 0x3a, 0xf0, 0xd6, 0xb4, 0x6f, 0xce, 0xed, 0x3a,
+	0xeb, 0x35, 0xd1, 0x56, 0xcd, 0x9f, 0x27, 0x86, 0x8a, 0xb7, 0xdf, 0xfe, 0x20, 0x31, 0x55, 0x0c,
+	0x25, 0xdf, 0x16, 0x92, 0xdf, 0x92, 0x1c, 0x7f, 0x8a, 0xd0, 0xee, 0x0b, 0xda, 0x7d, 0x45, 0x7b,
+	0x96, 0x78, 0x69, 0x57, 0xda, 0xfd, 0xd5, 0xb5, 0x92, 0xfa, 0x1f, 0xa5, 0x7f, 0x25, 0x4e, 0x86,
+	0x7c, 0xcb, 0xcb, 0x82, 0x71, 0x79, 0x59, 0x71, 0x96, 0x47, 0xf8, 0x27, 0xc9, 0xd2, 0xff, 0x06,
+	0x00, 0xcf, 0x14, 0x6e, 0x02, 0x3b, 0x36, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Agent queries an agent.
+	Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error)
+	// AgentsByCreator queries all the agents by its creator.
+	AgentsByCreator(ctx context.Context, in *QueryAgentsByCreatorRequest, opts ...grpc.CallOption) (*QueryAgentsByCreatorResponse, error)
+	// Agents queries all the agents.
+	Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error)
+	// Proposal queries a proposal.
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// ProposalsByProposer queries all the proposals by its proposer.
+	ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error)
+	// ProposalsByMessageType queries all the proposals by the type of the
+	// messages in their actions.
+	ProposalsByMessageType(ctx context.Context, in *QueryProposalsByMessageTypeRequest, opts ...grpc.CallOption) (*QueryProposalsByMessageTypeResponse, error)
+	// ProposalsByOfferedAsset queries all the proposals offering an asset in
+	// their pre-actions.
+	ProposalsByOfferedAsset(ctx context.Context, in *QueryProposalsByOfferedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByOfferedAssetResponse, error)
+	// ProposalsByAskedAsset queries all the proposals asking an asset in their
+	// post-actions.
+	ProposalsByAskedAsset(ctx context.Context, in *QueryProposalsByAskedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByAskedAssetResponse, error)
+	// Proposals queries all the proposals.
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// SimulateExec simulates Msg/Exec without committing its result.
+	SimulateExec(ctx context.Context, in *QuerySimulateExecRequest, opts ...grpc.CallOption) (*QuerySimulateExecResponse, error)
+	// SimulateSubmit simulates Msg/SubmitProposal without committing its
+	// result.
+	SimulateSubmit(ctx context.Context, in *QuerySimulateSubmitRequest, opts ...grpc.CallOption) (*QuerySimulateSubmitResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error) {
+	out := new(QueryAgentResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Agent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AgentsByCreator(ctx context.Context, in *QueryAgentsByCreatorRequest, opts ...grpc.CallOption) (*QueryAgentsByCreatorResponse, error) {
+	out := new(QueryAgentsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/AgentsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error) {
+	out := new(QueryAgentsResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Agents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Proposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error) {
+	out := new(QueryProposalsByProposerResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/ProposalsByProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByMessageType(ctx context.Context, in *QueryProposalsByMessageTypeRequest, opts ...grpc.CallOption) (*QueryProposalsByMessageTypeResponse, error) {
+	out := new(QueryProposalsByMessageTypeResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/ProposalsByMessageType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByOfferedAsset(ctx context.Context, in *QueryProposalsByOfferedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByOfferedAssetResponse, error) {
+	out := new(QueryProposalsByOfferedAssetResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/ProposalsByOfferedAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByAskedAsset(ctx context.Context, in *QueryProposalsByAskedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByAskedAssetResponse, error) {
+	out := new(QueryProposalsByAskedAssetResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/ProposalsByAskedAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateExec(ctx context.Context, in *QuerySimulateExecRequest, opts ...grpc.CallOption) (*QuerySimulateExecResponse, error) {
+	out := new(QuerySimulateExecResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/SimulateExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSubmit(ctx context.Context, in *QuerySimulateSubmitRequest, opts ...grpc.CallOption) (*QuerySimulateSubmitResponse, error) {
+	out := new(QuerySimulateSubmitResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Query/SimulateSubmit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Agent queries an agent.
+	Agent(context.Context, *QueryAgentRequest) (*QueryAgentResponse, error)
+	// AgentsByCreator queries all the agents by its creator.
+	AgentsByCreator(context.Context, *QueryAgentsByCreatorRequest) (*QueryAgentsByCreatorResponse, error)
+	// Agents queries all the agents.
+	Agents(context.Context, *QueryAgentsRequest) (*QueryAgentsResponse, error)
+	// Proposal queries a proposal.
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// ProposalsByProposer queries all the proposals by its proposer.
+	ProposalsByProposer(context.Context, *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error)
+	// ProposalsByMessageType queries all the proposals by the type of the
+	// messages in their actions.
+	ProposalsByMessageType(context.Context, *QueryProposalsByMessageTypeRequest) (*QueryProposalsByMessageTypeResponse, error)
+	// ProposalsByOfferedAsset queries all the proposals offering an asset in
+	// their pre-actions.
+	ProposalsByOfferedAsset(context.Context, *QueryProposalsByOfferedAssetRequest) (*QueryProposalsByOfferedAssetResponse, error)
+	// ProposalsByAskedAsset queries all the proposals asking an asset in their
+	// post-actions.
+	ProposalsByAskedAsset(context.Context, *QueryProposalsByAskedAssetRequest) (*QueryProposalsByAskedAssetResponse, error)
+	// Proposals queries all the proposals.
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// SimulateExec simulates Msg/Exec without committing its result.
+	SimulateExec(context.Context, *QuerySimulateExecRequest) (*QuerySimulateExecResponse, error)
+	// SimulateSubmit simulates Msg/SubmitProposal without committing its
+	// result.
+	SimulateSubmit(context.Context, *QuerySimulateSubmitRequest) (*QuerySimulateSubmitResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Agent(ctx context.Context, req *QueryAgentRequest) (*QueryAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Agent not implemented")
+}
+func (*UnimplementedQueryServer) AgentsByCreator(ctx context.Context, req *QueryAgentsByCreatorRequest) (*QueryAgentsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) Agents(ctx context.Context, req *QueryAgentsRequest) (*QueryAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Agents not implemented")
+}
+func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByProposer(ctx context.Context, req *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByProposer not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByMessageType(ctx context.Context, req *QueryProposalsByMessageTypeRequest) (*QueryProposalsByMessageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByMessageType not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByOfferedAsset(ctx context.Context, req *QueryProposalsByOfferedAssetRequest) (*QueryProposalsByOfferedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByOfferedAsset not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByAskedAsset(ctx context.Context, req *QueryProposalsByAskedAssetRequest) (*QueryProposalsByAskedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByAskedAsset not implemented")
+}
+func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (*UnimplementedQueryServer) SimulateExec(ctx context.Context, req *QuerySimulateExecRequest) (*QuerySimulateExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateExec not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSubmit(ctx context.Context, req *QuerySimulateSubmitRequest) (*QuerySimulateSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSubmit not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Agent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Agent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/Agent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Agent(ctx, req.(*QueryAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AgentsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AgentsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/AgentsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgentsByCreator(ctx, req.(*QueryAgentsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Agents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Agents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/Agents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Agents(ctx, req.(*QueryAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/Proposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByProposerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/ProposalsByProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByProposer(ctx, req.(*QueryProposalsByProposerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByMessageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByMessageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByMessageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/ProposalsByMessageType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByMessageType(ctx, req.(*QueryProposalsByMessageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByOfferedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByOfferedAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByOfferedAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/ProposalsByOfferedAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByOfferedAsset(ctx, req.(*QueryProposalsByOfferedAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByAskedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByAskedAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByAskedAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/ProposalsByAskedAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByAskedAsset(ctx, req.(*QueryProposalsByAskedAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/Proposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/SimulateExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateExec(ctx, req.(*QuerySimulateExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Query/SimulateSubmit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSubmit(ctx, req.(*QuerySimulateSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "andromeda.escrow.v1alpha1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Agent",
+			Handler:    _Query_Agent_Handler,
+		},
+		{
+			MethodName: "AgentsByCreator",
+			Handler:    _Query_AgentsByCreator_Handler,
+		},
+		{
+			MethodName: "Agents",
+			Handler:    _Query_Agents_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "ProposalsByProposer",
+			Handler:    _Query_ProposalsByProposer_Handler,
+		},
+		{
+			MethodName: "ProposalsByMessageType",
+			Handler:    _Query_ProposalsByMessageType_Handler,
+		},
+		{
+			MethodName: "ProposalsByOfferedAsset",
+			Handler:    _Query_ProposalsByOfferedAsset_Handler,
+		},
+		{
+			MethodName: "ProposalsByAskedAsset",
+			Handler:    _Query_ProposalsByAskedAsset_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "SimulateExec",
+			Handler:    _Query_SimulateExec_Handler,
+		},
+		{
+			MethodName: "SimulateSubmit",
+			Handler:    _Query_SimulateSubmit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "andromeda/escrow/v1alpha1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSimulateGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSimulateGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxRefundActions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxRefundActions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPrunesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.GasPerActionByte != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasPerActionByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GasPerAction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasPerAction))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GasPerAgent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasPerAgent))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for iNdEx := len(m.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMessageTypes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DeniedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for iNdEx := len(m.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMessageTypes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxActionSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxActionSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxAgentsPerExec != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAgentsPerExec))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxActions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxActions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPostActions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPostActions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPreActions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPreActions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BurnExpiredDeposits {
+		i--
+		if m.BurnExpiredDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for iNdEx := len(m.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExecFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecFeeFlat != nil {
+		{
+			size, err := m.ExecFeeFlat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxMetadataLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMetadataLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Agent != nil {
+		{
+			size, err := m.Agent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentResponse_Agent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentResponse_Agent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentResponse_Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agents) > 0 {
+		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsByCreatorResponse_Agent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsByCreatorResponse_Agent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsByCreatorResponse_Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agents) > 0 {
+		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsResponse_Agent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsResponse_Agent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsResponse_Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalResponse_Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SubmitTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByProposerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByProposerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByProposerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByProposerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByProposerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SubmitTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByMessageTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByMessageTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByMessageTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		copy(dAtA[i:], m.MessageType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByMessageTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByMessageTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByMessageTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByMessageTypeResponse_Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByMessageTypeResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByMessageTypeResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SubmitTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintQuery(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByOfferedAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByOfferedAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByOfferedAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByOfferedAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByOfferedAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByOfferedAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SubmitTime != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintQuery(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintQuery(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByAskedAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByAskedAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByAskedAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByAskedAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByAskedAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByAskedAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByAskedAssetResponse_Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByAskedAssetResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByAskedAssetResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SubmitTime != nil {
+		n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintQuery(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintQuery(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse_Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SubmitTime != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintQuery(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintQuery(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateExecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateExecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateExecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeclaredValue) > 0 {
+		for iNdEx := len(m.DeclaredValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeclaredValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Quantities) > 0 {
+		dAtA31 := make([]byte, len(m.Quantities)*10)
+		var j30 int
+		for _, num := range m.Quantities {
+			for num >= 1<<7 {
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j30++
+			}
+			dAtA31[j30] = uint8(num)
+			j30++
+		}
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintQuery(dAtA, i, uint64(j30))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agents) > 0 {
+		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Agents[iNdEx])
+			copy(dAtA[i:], m.Agents[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Agents[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAgentIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedAgentIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AgentFailed {
+		i--
+		if m.AgentFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FailedActionIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedActionIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ActionFailed {
+		i--
+		if m.ActionFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FailedPhase) > 0 {
+		i -= len(m.FailedPhase)
+		copy(dAtA[i:], m.FailedPhase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FailedPhase)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateExecResponse_Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateExecResponse_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateExecResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateExecResponse_Event_Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateExecResponse_Event_Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateExecResponse_Event_Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExecGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n32, err32 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintQuery(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedActionIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedActionIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ActionFailed {
+		i--
+		if m.ActionFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FailedPhase) > 0 {
+		i -= len(m.FailedPhase)
+		copy(dAtA[i:], m.FailedPhase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FailedPhase)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSubmitResponse_Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSubmitResponse_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSubmitResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSubmitResponse_Event_Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSubmitResponse_Event_Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSubmitResponse_Event_Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMetadataLength != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMetadataLength))
+	}
+	if m.ExecFeeFlat != nil {
+		l = m.ExecFeeFlat.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExecFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.ExecFeeBps))
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for _, e := range m.MinProposalDeposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BurnExpiredDeposits {
+		n += 2
+	}
+	if m.MaxPreActions != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPreActions))
+	}
+	if m.MaxPostActions != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPostActions))
+	}
+	if m.MaxActions != 0 {
+		n += 1 + sovQuery(uint64(m.MaxActions))
+	}
+	if m.MaxAgentsPerExec != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAgentsPerExec))
+	}
+	if m.MaxActionSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxActionSize))
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for _, s := range m.AllowedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for _, s := range m.DeniedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovQuery(uint64(m.MaxNestingDepth))
+	}
+	if m.GasPerAgent != 0 {
+		n += 1 + sovQuery(uint64(m.GasPerAgent))
+	}
+	if m.GasPerAction != 0 {
+		n += 1 + sovQuery(uint64(m.GasPerAction))
+	}
+	if m.GasPerActionByte != 0 {
+		n += 2 + sovQuery(uint64(m.GasPerActionByte))
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		n += 2 + sovQuery(uint64(m.MaxPrunesPerBlock))
+	}
+	if m.MaxRefundActions != 0 {
+		n += 2 + sovQuery(uint64(m.MaxRefundActions))
+	}
+	if m.MaxSimulateGas != 0 {
+		n += 2 + sovQuery(uint64(m.MaxSimulateGas))
+	}
+	return n
+}
+
+func (m *QueryAgentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Agent != nil {
+		l = m.Agent.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentResponse_Agent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Agents) > 0 {
+		for _, e := range m.Agents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentsByCreatorResponse_Agent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Agents) > 0 {
+		for _, e := range m.Agents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentsResponse_Agent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse_Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQuery(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 3
+	}
+	return n
+}
+
+func (m *QueryProposalsByProposerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByProposerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByProposerResponse_Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQuery(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 3
+	}
+	return n
+}
+
+func (m *QueryProposalsByMessageTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByMessageTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByMessageTypeResponse_Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQuery(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 3
+	}
+	return n
+}
+
+func (m *QueryProposalsByOfferedAssetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByOfferedAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQuery(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 3
+	}
+	return n
+}
+
+func (m *QueryProposalsByAskedAssetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByAskedAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByAskedAssetResponse_Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQuery(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 3
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse_Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQuery(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 3
+	}
+	return n
+}
+
+func (m *QuerySimulateExecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Agents) > 0 {
+		for _, s := range m.Agents {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Quantities) > 0 {
+		l = 0
+		for _, e := range m.Quantities {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.DeclaredValue) > 0 {
+		for _, e := range m.DeclaredValue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	l = len(m.FailedPhase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActionFailed {
+		n += 2
+	}
+	if m.FailedActionIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FailedActionIndex))
+	}
+	if m.AgentFailed {
+		n += 2
+	}
+	if m.FailedAgentIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FailedAgentIndex))
+	}
+	return n
+}
+
+func (m *QuerySimulateExecResponse_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateExecResponse_Event_Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQuery(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorGroupId))
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxExecGas))
+	}
+	return n
+}
+
+func (m *QuerySimulateSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	l = len(m.FailedPhase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActionFailed {
+		n += 2
+	}
+	if m.FailedActionIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FailedActionIndex))
+	}
+	return n
+}
+
+func (m *QuerySimulateSubmitResponse_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateSubmitResponse_Event_Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+			}
+			m.MaxMetadataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecFeeFlat == nil {
+				m.ExecFeeFlat = &types.Coin{}
+			}
+			if err := m.ExecFeeFlat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
+			}
+			m.ExecFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinProposalDeposit = append(m.MinProposalDeposit, types.Coin{})
+			if err := m.MinProposalDeposit[len(m.MinProposalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnExpiredDeposits = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
+			}
+			m.MaxPreActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPreActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
+			}
+			m.MaxPostActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPostActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
+			}
+			m.MaxActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+			}
+			m.MaxAgentsPerExec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+			}
+			m.MaxActionSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActionSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessageTypes = append(m.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMessageTypes = append(m.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+			}
+			m.GasPerAgent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAgent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+			}
+			m.GasPerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+			}
+			m.GasPerActionByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerActionByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+			}
+			m.MaxPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+			}
+			m.MaxRefundActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
+			}
+			m.MaxSimulateGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSimulateGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Agent == nil {
+				m.Agent = &QueryAgentResponse_Agent{}
+			}
+			if err := m.Agent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentResponse_Agent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentsByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agents = append(m.Agents, &QueryAgentsByCreatorResponse_Agent{})
+			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsByCreatorResponse_Agent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agents = append(m.Agents, &QueryAgentsResponse_Agent{})
+			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsResponse_Agent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &QueryProposalResponse_Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByProposerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByProposerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByProposerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByProposerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByProposerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &QueryProposalsByProposerResponse_Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByMessageTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByMessageTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByMessageTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByMessageTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByMessageTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByMessageTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &QueryProposalsByMessageTypeResponse_Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByMessageTypeResponse_Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByOfferedAssetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByOfferedAssetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByOfferedAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByOfferedAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByOfferedAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByOfferedAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &QueryProposalsByOfferedAssetResponse_Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByOfferedAssetResponse_Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByAskedAssetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByAskedAssetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByAskedAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByAskedAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByAskedAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByAskedAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &QueryProposalsByAskedAssetResponse_Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByAskedAssetResponse_Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.PostActions) > 0 {
-		for _, e := range m.PostActions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2796,12 +13151,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &QueryProposalsResponse_Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2823,7 +13248,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse_Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2846,17 +13271,115 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.MaxMetadataLength = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2866,64 +13389,29 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMetadataLength |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2951,61 +13439,64 @@ func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3032,68 +13523,88 @@ func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Agent == nil {
-				m.Agent = &QueryAgentResponse_Agent{}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
 			}
-			if err := m.Agent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAgentResponse_Agent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3103,29 +13614,69 @@ func (m *QueryAgentResponse_Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3135,24 +13686,48 @@ func (m *QueryAgentResponse_Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3174,7 +13749,7 @@ func (m *QueryAgentResponse_Agent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentsByCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateExecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3197,15 +13772,15 @@ func (m *QueryAgentsByCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentsByCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateExecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3233,13 +13808,13 @@ func (m *QueryAgentsByCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3249,81 +13824,27 @@ func (m *QueryAgentsByCreatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Agents = append(m.Agents, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAgentsByCreatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentsByCreatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3350,14 +13871,90 @@ func (m *QueryAgentsByCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agents = append(m.Agents, &QueryAgentsByCreatorResponse_Agent{})
-			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Actions = append(m.Actions, &types1.Any{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Quantities = append(m.Quantities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Quantities) == 0 {
+					m.Quantities = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Quantities = append(m.Quantities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantities", wireType)
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclaredValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3384,10 +13981,8 @@ func (m *QueryAgentsByCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeclaredValue = append(m.DeclaredValue, types.Coin{})
+			if err := m.DeclaredValue[len(m.DeclaredValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3412,7 +14007,7 @@ func (m *QueryAgentsByCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentsByCreatorResponse_Agent) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3435,17 +14030,17 @@ func (m *QueryAgentsByCreatorResponse_Agent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3455,29 +14050,36 @@ func (m *QueryAgentsByCreatorResponse_Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3487,79 +14089,31 @@ func (m *QueryAgentsByCreatorResponse_Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Events = append(m.Events, &QuerySimulateExecResponse_Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3569,83 +14123,80 @@ func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPhase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3655,31 +14206,29 @@ func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agents = append(m.Agents, &QueryAgentsResponse_Agent{})
-			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailedPhase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionFailed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3689,28 +14238,70 @@ func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			m.ActionFailed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedActionIndex", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.FailedActionIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedActionIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentFailed", wireType)
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AgentFailed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAgentIndex", wireType)
+			}
+			m.FailedAgentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAgentIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3732,7 +14323,7 @@ func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentsResponse_Agent) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateExecResponse_Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3755,15 +14346,15 @@ func (m *QueryAgentsResponse_Agent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3791,13 +14382,13 @@ func (m *QueryAgentsResponse_Agent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.EventType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3807,23 +14398,25 @@ func (m *QueryAgentsResponse_Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, &QuerySimulateExecResponse_Event_Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3846,7 +14439,7 @@ func (m *QueryAgentsResponse_Agent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateExecResponse_Event_Attribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3869,15 +14462,15 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3905,63 +14498,13 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3971,27 +14514,23 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &QueryProposalResponse_Proposal{}
-			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4014,7 +14553,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSubmitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4037,15 +14576,15 @@ func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSubmitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4073,11 +14612,11 @@ func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4105,7 +14644,7 @@ func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Agent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -4136,7 +14675,7 @@ func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreActions = append(m.PreActions, &types.Any{})
+			m.PreActions = append(m.PreActions, &types1.Any{})
 			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4170,7 +14709,7 @@ func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostActions = append(m.PostActions, &types.Any{})
+			m.PostActions = append(m.PostActions, &types1.Any{})
 			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4207,61 +14746,11 @@ func (m *QueryProposalResponse_Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProposalsByProposerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsByProposerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsByProposerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4271,27 +14760,48 @@ func (m *QueryProposalsByProposerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4318,68 +14828,37 @@ func (m *QueryProposalsByProposerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProposalsByProposerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsByProposerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsByProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4389,31 +14868,29 @@ func (m *QueryProposalsByProposerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, &QueryProposalsByProposerResponse_Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
 			}
-			var msglen int
+			m.ExecutorGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4423,28 +14900,30 @@ func (m *QueryProposalsByProposerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4466,7 +14945,7 @@ func (m *QueryProposalsByProposerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSubmitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4489,17 +14968,56 @@ func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSubmitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4509,27 +15027,29 @@ func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.Events = append(m.Events, &QuerySimulateSubmitResponse_Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4557,13 +15077,13 @@ func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4573,31 +15093,29 @@ func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreActions = append(m.PreActions, &types.Any{})
-			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4607,29 +15125,14 @@ func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Code |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostActions = append(m.PostActions, &types.Any{})
-			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4657,63 +15160,13 @@ func (m *QueryProposalsByProposerResponse_Proposal) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.FailedPhase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionFailed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4723,28 +15176,31 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ActionFailed = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedActionIndex", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FailedActionIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedActionIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4766,7 +15222,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSubmitResponse_Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4789,17 +15245,17 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4809,29 +15265,27 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, &QueryProposalsResponse_Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4858,10 +15312,8 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attributes = append(m.Attributes, &QuerySimulateSubmitResponse_Event_Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4886,7 +15338,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse_Proposal) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSubmitResponse_Event_Attribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4909,15 +15361,15 @@ func (m *QueryProposalsResponse_Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4945,111 +15397,11 @@ func (m *QueryProposalsResponse_Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agent = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreActions = append(m.PreActions, &types.Any{})
-			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostActions = append(m.PostActions, &types.Any{})
-			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5077,7 +15429,7 @@ func (m *QueryProposalsResponse_Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7,22 +7,29 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +43,59 @@ type MsgUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// the maximum length allowed for metadata
 	MaxMetadataLength uint64 `protobuf:"varint,2,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *types.Coin `protobuf:"bytes,3,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,4,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_proposal_deposit"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,6,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,7,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,8,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,9,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,10,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,11,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,12,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,13,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,14,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,15,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,16,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,17,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,18,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,19,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,20,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
 }
 
 func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
@@ -85,6 +145,132 @@ func (m *MsgUpdateParams) GetMaxMetadataLength() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateParams) GetExecFeeFlat() *types.Coin {
+	if m != nil {
+		return m.ExecFeeFlat
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetExecFeeBps() uint32 {
+	if m != nil {
+		return m.ExecFeeBps
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMinProposalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinProposalDeposit
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetBurnExpiredDeposits() bool {
+	if m != nil {
+		return m.BurnExpiredDeposits
+	}
+	return false
+}
+
+func (m *MsgUpdateParams) GetMaxPreActions() uint64 {
+	if m != nil {
+		return m.MaxPreActions
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMaxPostActions() uint64 {
+	if m != nil {
+		return m.MaxPostActions
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMaxActions() uint64 {
+	if m != nil {
+		return m.MaxActions
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMaxAgentsPerExec() uint64 {
+	if m != nil {
+		return m.MaxAgentsPerExec
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMaxActionSize() uint64 {
+	if m != nil {
+		return m.MaxActionSize
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetAllowedMessageTypes() []string {
+	if m != nil {
+		return m.AllowedMessageTypes
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetDeniedMessageTypes() []string {
+	if m != nil {
+		return m.DeniedMessageTypes
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetMaxNestingDepth() uint64 {
+	if m != nil {
+		return m.MaxNestingDepth
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetGasPerAgent() uint64 {
+	if m != nil {
+		return m.GasPerAgent
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetGasPerAction() uint64 {
+	if m != nil {
+		return m.GasPerAction
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetGasPerActionByte() uint64 {
+	if m != nil {
+		return m.GasPerActionByte
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMaxPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMaxRefundActions() uint64 {
+	if m != nil {
+		return m.MaxRefundActions
+	}
+	return 0
+}
+
+func (m *MsgUpdateParams) GetMaxSimulateGas() uint64 {
+	if m != nil {
+		return m.MaxSimulateGas
+	}
+	return 0
+}
+
 // MsgUpdateParamsResponse is the Msg/UpdateParams response type.
 type MsgUpdateParamsResponse struct {
 }
@@ -223,12 +409,35 @@ type MsgSubmitProposal struct {
 	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
 	// the messages which will be executed on the submission
 	// Note: the signer of each message must be either the proposer or the agent.
-	PreActions []*types.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
 	// the messages which will be executed after the actions included in Msg/Exec
 	// Note: the signer of each message must be either the proposer or the agent.
-	PostActions []*types.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	// Note: the signer of each message must be the agent.
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the total quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,12,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -278,14 +487,14 @@ func (m *MsgSubmitProposal) GetAgent() string {
 	return ""
 }
 
-func (m *MsgSubmitProposal) GetPreActions() []*types.Any {
+func (m *MsgSubmitProposal) GetPreActions() []*types1.Any {
 	if m != nil {
 		return m.PreActions
 	}
 	return nil
 }
 
-func (m *MsgSubmitProposal) GetPostActions() []*types.Any {
+func (m *MsgSubmitProposal) GetPostActions() []*types1.Any {
 	if m != nil {
 		return m.PostActions
 	}
@@ -299,8 +508,59 @@ func (m *MsgSubmitProposal) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgSubmitProposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MsgSubmitProposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *MsgSubmitProposal) GetExecutors() []string {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
+}
+
+func (m *MsgSubmitProposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
+	}
+	return 0
+}
+
 // MsgSubmitProposalResponse is the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
+	// the responses of the pre-actions, in the same order of the pre-actions
+	PreActionResponses []*types1.Any `protobuf:"bytes,1,rep,name=pre_action_responses,json=preActionResponses,proto3" json:"pre_action_responses,omitempty"`
 }
 
 func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResponse{} }
@@ -336,6 +596,13 @@ func (m *MsgSubmitProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitProposalResponse proto.InternalMessageInfo
 
+func (m *MsgSubmitProposalResponse) GetPreActionResponses() []*types1.Any {
+	if m != nil {
+		return m.PreActionResponses
+	}
+	return nil
+}
+
 // MsgExec is the Msg/Exec request type.
 type MsgExec struct {
 	// the address of the account executing the proposal
@@ -345,7 +612,15 @@ type MsgExec struct {
 	// the messages which will be executed on the execution
 	// Note: the signer of each message must be either the executor or one of the
 	// agents.
-	Actions []*types.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	Actions []*types1.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// the quantities of the units to fill, in the same order of the agents
+	// Note: empty means filling all the remaining units of each proposal. Zero
+	// quantity of a fillable proposal also means filling all the remaining
+	// units.
+	Quantities []uint64 `protobuf:"varint,4,rep,packed,name=quantities,proto3" json:"quantities,omitempty"`
+	// the value of the execution declared by the executor, on which the fee in
+	// basis points is charged
+	DeclaredValue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=declared_value,json=declaredValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"declared_value"`
 }
 
 func (m *MsgExec) Reset()         { *m = MsgExec{} }
@@ -395,15 +670,34 @@ func (m *MsgExec) GetAgents() []string {
 	return nil
 }
 
-func (m *MsgExec) GetActions() []*types.Any {
+func (m *MsgExec) GetActions() []*types1.Any {
 	if m != nil {
 		return m.Actions
 	}
 	return nil
 }
 
+func (m *MsgExec) GetQuantities() []uint64 {
+	if m != nil {
+		return m.Quantities
+	}
+	return nil
+}
+
+func (m *MsgExec) GetDeclaredValue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DeclaredValue
+	}
+	return nil
+}
+
 // MsgExecResponse is the Msg/Exec response type.
 type MsgExecResponse struct {
+	// the responses of the actions, in the same order of the actions
+	ActionResponses []*types1.Any `protobuf:"bytes,1,rep,name=action_responses,json=actionResponses,proto3" json:"action_responses,omitempty"`
+	// the responses of the post-actions of each proposal, in the same order of
+	// the agents
+	PostActionResponses []*MsgExecResponse_PostActionResponses `protobuf:"bytes,2,rep,name=post_action_responses,json=postActionResponses,proto3" json:"post_action_responses,omitempty"`
 }
 
 func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
@@ -439,131 +733,501 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "andromeda.escrow.v1alpha1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "andromeda.escrow.v1alpha1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateAgent)(nil), "andromeda.escrow.v1alpha1.MsgCreateAgent")
-	proto.RegisterType((*MsgCreateAgentResponse)(nil), "andromeda.escrow.v1alpha1.MsgCreateAgentResponse")
-	proto.RegisterType((*MsgSubmitProposal)(nil), "andromeda.escrow.v1alpha1.MsgSubmitProposal")
-	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse")
-	proto.RegisterType((*MsgExec)(nil), "andromeda.escrow.v1alpha1.MsgExec")
-	proto.RegisterType((*MsgExecResponse)(nil), "andromeda.escrow.v1alpha1.MsgExecResponse")
+func (m *MsgExecResponse) GetActionResponses() []*types1.Any {
+	if m != nil {
+		return m.ActionResponses
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("andromeda/escrow/v1alpha1/tx.proto", fileDescriptor_78c48d1f0ffd37da)
+func (m *MsgExecResponse) GetPostActionResponses() []*MsgExecResponse_PostActionResponses {
+	if m != nil {
+		return m.PostActionResponses
+	}
+	return nil
 }
 
-var fileDescriptor_78c48d1f0ffd37da = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0x6b, 0xa7, 0x6d, 0xda, 0x49, 0x7f, 0xfd, 0xa9, 0xa6, 0xa2, 0x89, 0x2b, 0xa2, 0xc8,
-	0x12, 0x52, 0x88, 0xc0, 0x26, 0xa1, 0x80, 0x64, 0x4e, 0x09, 0xaa, 0xe0, 0x40, 0xa4, 0x28, 0x85,
-	0x0a, 0xa1, 0x4a, 0xd1, 0xd6, 0x59, 0xdc, 0x88, 0xd8, 0x6b, 0xed, 0x6e, 0x4a, 0x7a, 0x43, 0xdc,
-	0xb8, 0x71, 0xe0, 0x09, 0x38, 0x72, 0xea, 0x01, 0x89, 0x57, 0x40, 0x9c, 0x2a, 0x4e, 0x1c, 0x51,
-	0x7a, 0xa8, 0xc4, 0x89, 0x47, 0x40, 0xf6, 0x66, 0xb7, 0x6d, 0x50, 0xfe, 0x70, 0x4a, 0xc6, 0xf3,
-	0x99, 0xef, 0xcc, 0xce, 0xcc, 0x2e, 0x58, 0x28, 0x6c, 0x53, 0x12, 0xe0, 0x36, 0x72, 0x30, 0xf3,
-	0x28, 0x79, 0xed, 0x1c, 0x96, 0x51, 0x37, 0x3a, 0x40, 0x65, 0x87, 0xf7, 0xed, 0x88, 0x12, 0x4e,
-	0x8c, 0x9c, 0x62, 0x6c, 0xc1, 0xd8, 0x92, 0x31, 0x37, 0x3c, 0xc2, 0x02, 0xc2, 0x9c, 0x80, 0xf9,
-	0xce, 0x61, 0x39, 0xfe, 0x11, 0x31, 0x66, 0x4e, 0x38, 0x5a, 0x89, 0xe5, 0x08, 0x43, 0xba, 0x7c,
-	0x42, 0xfc, 0x2e, 0x76, 0x12, 0x6b, 0xbf, 0xf7, 0xd2, 0x41, 0xe1, 0x91, 0x70, 0x59, 0xef, 0x34,
-	0xf8, 0xbf, 0xce, 0xfc, 0x67, 0x51, 0x1b, 0x71, 0xdc, 0x40, 0x14, 0x05, 0xcc, 0xb8, 0x07, 0xcb,
-	0xa8, 0xc7, 0x0f, 0x08, 0xed, 0xf0, 0xa3, 0xac, 0x56, 0xd0, 0x8a, 0xcb, 0xb5, 0xec, 0xf7, 0xcf,
-	0xb7, 0xd6, 0x87, 0x9a, 0xd5, 0x76, 0x9b, 0x62, 0xc6, 0x76, 0x38, 0xed, 0x84, 0x7e, 0xf3, 0x1c,
-	0x35, 0x6c, 0xb8, 0x12, 0xa0, 0x7e, 0x2b, 0xc0, 0x1c, 0xb5, 0x11, 0x47, 0xad, 0x2e, 0x0e, 0x7d,
-	0x7e, 0x90, 0xd5, 0x0b, 0x5a, 0x71, 0xbe, 0xb9, 0x16, 0xa0, 0x7e, 0x7d, 0xe8, 0x79, 0x92, 0x38,
-	0xdc, 0xd5, 0xb7, 0x67, 0xc7, 0xa5, 0xf3, 0x78, 0x2b, 0x07, 0x1b, 0x23, 0xa5, 0x34, 0x31, 0x8b,
-	0x48, 0xc8, 0xb0, 0xd5, 0x84, 0xd5, 0x3a, 0xf3, 0x1f, 0x52, 0x8c, 0x38, 0xae, 0xfa, 0x38, 0xe4,
-	0x46, 0x05, 0xd2, 0x5e, 0x6c, 0x12, 0x3a, 0xb5, 0x44, 0x09, 0xba, 0x2b, 0x71, 0x42, 0x69, 0x59,
-	0x8f, 0xe1, 0xea, 0x65, 0x4d, 0x99, 0xcd, 0xb0, 0x61, 0x01, 0xc5, 0x1f, 0xa6, 0x2a, 0x0b, 0xcc,
-	0xfa, 0xa0, 0xc3, 0x5a, 0x9d, 0xf9, 0x3b, 0xbd, 0xfd, 0xa0, 0xc3, 0x1b, 0x94, 0x44, 0x84, 0xa1,
-	0xae, 0xb1, 0x05, 0x4b, 0x51, 0xf2, 0x1f, 0x4f, 0x2f, 0x51, 0x91, 0xe7, 0xb9, 0xf5, 0x99, 0x72,
-	0x1b, 0x77, 0x21, 0x13, 0x51, 0xdc, 0x42, 0x1e, 0xef, 0x90, 0x90, 0x65, 0x53, 0x85, 0x54, 0x31,
-	0x53, 0x59, 0xb7, 0xc5, 0xc4, 0x6d, 0x39, 0x71, 0xbb, 0x1a, 0x1e, 0x35, 0x21, 0xa2, 0xb8, 0x2a,
-	0x38, 0xe3, 0x3e, 0xac, 0x44, 0x84, 0x71, 0x15, 0x37, 0x3f, 0x21, 0x2e, 0x13, 0x93, 0x32, 0xd0,
-	0x84, 0x25, 0x39, 0xe0, 0xec, 0x42, 0x5c, 0x62, 0x53, 0xd9, 0xee, 0x7f, 0x71, 0x7f, 0xd5, 0x51,
-	0xac, 0x4d, 0xc8, 0xfd, 0xd5, 0x15, 0x35, 0xd1, 0x63, 0x0d, 0xd2, 0x75, 0xe6, 0x6f, 0xf7, 0xb1,
-	0x17, 0x77, 0x0a, 0xf7, 0xb1, 0xd7, 0x9b, 0x65, 0x98, 0x8a, 0x34, 0x6e, 0xc3, 0x62, 0xd2, 0x02,
-	0x96, 0xd5, 0x0b, 0xa9, 0x89, 0x31, 0x43, 0xce, 0xb0, 0x21, 0x3d, 0x4b, 0x9f, 0x24, 0x34, 0x3c,
-	0x8f, 0x4c, 0x68, 0xad, 0x25, 0x57, 0x25, 0xae, 0x58, 0x9e, 0xa2, 0xf2, 0x25, 0x05, 0xa9, 0x3a,
-	0xf3, 0x8d, 0x10, 0x56, 0x2e, 0x5d, 0xa1, 0x92, 0x3d, 0xf6, 0x06, 0xdb, 0x23, 0x3b, 0x6e, 0x56,
-	0x66, 0x67, 0xd5, 0x86, 0xbe, 0x82, 0xcc, 0xc5, 0xcb, 0x70, 0x63, 0xb2, 0xc4, 0x05, 0xd4, 0x2c,
-	0xcf, 0x8c, 0xaa, 0x64, 0x1c, 0x56, 0x47, 0x56, 0xfb, 0xe6, 0x64, 0x91, 0xcb, 0xb4, 0xb9, 0xf5,
-	0x2f, 0xb4, 0xca, 0xba, 0x0b, 0xf3, 0xc9, 0x72, 0x58, 0x93, 0xa3, 0x63, 0xc6, 0x2c, 0x4d, 0x67,
-	0xa4, 0xae, 0xb9, 0xf0, 0xe6, 0xec, 0xb8, 0xa4, 0xd5, 0x7e, 0x6b, 0x5f, 0x07, 0x79, 0xed, 0x64,
-	0x90, 0xd7, 0x7e, 0x0e, 0xf2, 0xda, 0xfb, 0xd3, 0xfc, 0xdc, 0xc9, 0x69, 0x7e, 0xee, 0xc7, 0x69,
-	0x7e, 0x0e, 0xae, 0x79, 0x24, 0x18, 0x2f, 0x58, 0x4b, 0x3f, 0xed, 0x37, 0xe2, 0x75, 0x69, 0x68,
-	0x2f, 0x8a, 0x63, 0xdf, 0xf2, 0x07, 0xc2, 0x96, 0xe6, 0x47, 0x3d, 0x55, 0xdd, 0x7e, 0xfe, 0x49,
-	0xcf, 0x55, 0x95, 0xec, 0xb6, 0x90, 0xdd, 0x1d, 0x12, 0xdf, 0x2e, 0xf8, 0xf6, 0x84, 0x6f, 0x4f,
-	0xfa, 0x06, 0xfa, 0xf5, 0xb1, 0xbe, 0xbd, 0x47, 0x8d, 0x9a, 0x7c, 0x57, 0x7f, 0xe9, 0x9b, 0x8a,
-	0x73, 0x5d, 0x01, 0xba, 0xae, 0x24, 0xf7, 0x17, 0x93, 0x2d, 0xbf, 0xf3, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0xa0, 0xfc, 0xba, 0xe0, 0x82, 0x06, 0x00, 0x00,
+// PostActionResponses is the responses of the post-actions of a proposal.
+type MsgExecResponse_PostActionResponses struct {
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the responses of the post-actions, in the same order of the post-actions
+	Responses []*types1.Any `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgExecResponse_PostActionResponses) Reset()         { *m = MsgExecResponse_PostActionResponses{} }
+func (m *MsgExecResponse_PostActionResponses) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResponse_PostActionResponses) ProtoMessage()    {}
+func (*MsgExecResponse_PostActionResponses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c48d1f0ffd37da, []int{7, 0}
+}
+func (m *MsgExecResponse_PostActionResponses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecResponse_PostActionResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecResponse_PostActionResponses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecResponse_PostActionResponses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResponse_PostActionResponses.Merge(m, src)
+}
+func (m *MsgExecResponse_PostActionResponses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecResponse_PostActionResponses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResponse_PostActionResponses.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgExecResponse_PostActionResponses proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams updates the module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateAgent creates an escrow agent for a proposal.
-	CreateAgent(ctx context.Context, in *MsgCreateAgent, opts ...grpc.CallOption) (*MsgCreateAgentResponse, error)
-	// SubmitProposal submits a proposal.
-	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
-	// Exec executes a proposal.
-	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+func (m *MsgExecResponse_PostActionResponses) GetAgent() string {
+	if m != nil {
+		return m.Agent
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgExecResponse_PostActionResponses) GetResponses() []*types1.Any {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgCancelProposal is the Msg/CancelProposal request type.
+type MsgCancelProposal struct {
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c48d1f0ffd37da, []int{8}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateAgent(ctx context.Context, in *MsgCreateAgent, opts ...grpc.CallOption) (*MsgCreateAgentResponse, error) {
-	out := new(MsgCreateAgentResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/CreateAgent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
-	out := new(MsgSubmitProposalResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/SubmitProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelProposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
-	out := new(MsgExecResponse)
-	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/Exec", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgCancelProposalResponse is the Msg/CancelProposal response type.
+type MsgCancelProposalResponse struct {
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c48d1f0ffd37da, []int{9}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
+// MsgUpdateProposal is the Msg/UpdateProposal request type.
+type MsgUpdateProposal struct {
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the additional messages which will be executed on the update
+	// Note: the signer of each message must be either the proposer or the agent.
+	PreActions []*types1.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the messages which will replace the post_actions of the proposal
+	// Note: the signer of each message must be either the proposer or the agent.
+	// Note: empty means no change.
+	PostActions []*types1.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// the metadata which will replace the metadata of the proposal
+	// Note: empty means no change.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will replace the refund_actions of the proposal
+	// Note: the signer of each message must be the agent.
+	// Note: empty means no change.
+	RefundActions []*types1.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+}
+
+func (m *MsgUpdateProposal) Reset()         { *m = MsgUpdateProposal{} }
+func (m *MsgUpdateProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProposal) ProtoMessage()    {}
+func (*MsgUpdateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c48d1f0ffd37da, []int{10}
+}
+func (m *MsgUpdateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProposal.Merge(m, src)
+}
+func (m *MsgUpdateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProposal proto.InternalMessageInfo
+
+func (m *MsgUpdateProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgUpdateProposal) GetAgent() string {
+	if m != nil {
+		return m.Agent
+	}
+	return ""
+}
+
+func (m *MsgUpdateProposal) GetPreActions() []*types1.Any {
+	if m != nil {
+		return m.PreActions
+	}
+	return nil
+}
+
+func (m *MsgUpdateProposal) GetPostActions() []*types1.Any {
+	if m != nil {
+		return m.PostActions
+	}
+	return nil
+}
+
+func (m *MsgUpdateProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgUpdateProposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
+}
+
+// MsgUpdateProposalResponse is the Msg/UpdateProposal response type.
+type MsgUpdateProposalResponse struct {
+}
+
+func (m *MsgUpdateProposalResponse) Reset()         { *m = MsgUpdateProposalResponse{} }
+func (m *MsgUpdateProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProposalResponse) ProtoMessage()    {}
+func (*MsgUpdateProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78c48d1f0ffd37da, []int{11}
+}
+func (m *MsgUpdateProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProposalResponse.Merge(m, src)
+}
+func (m *MsgUpdateProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProposalResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "andromeda.escrow.v1alpha1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "andromeda.escrow.v1alpha1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateAgent)(nil), "andromeda.escrow.v1alpha1.MsgCreateAgent")
+	proto.RegisterType((*MsgCreateAgentResponse)(nil), "andromeda.escrow.v1alpha1.MsgCreateAgentResponse")
+	proto.RegisterType((*MsgSubmitProposal)(nil), "andromeda.escrow.v1alpha1.MsgSubmitProposal")
+	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse")
+	proto.RegisterType((*MsgExec)(nil), "andromeda.escrow.v1alpha1.MsgExec")
+	proto.RegisterType((*MsgExecResponse)(nil), "andromeda.escrow.v1alpha1.MsgExecResponse")
+	proto.RegisterType((*MsgExecResponse_PostActionResponses)(nil), "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses")
+	proto.RegisterType((*MsgCancelProposal)(nil), "andromeda.escrow.v1alpha1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "andromeda.escrow.v1alpha1.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgUpdateProposal)(nil), "andromeda.escrow.v1alpha1.MsgUpdateProposal")
+	proto.RegisterType((*MsgUpdateProposalResponse)(nil), "andromeda.escrow.v1alpha1.MsgUpdateProposalResponse")
+}
+
+func init() {
+	proto.RegisterFile("andromeda/escrow/v1alpha1/tx.proto", fileDescriptor_78c48d1f0ffd37da)
+}
+
+var fileDescriptor_78c48d1f0ffd37da = []byte{
+	// 1426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0x3a, 0xdf, 0x8f, 0x3f, 0xd2, 0x4c, 0xdc, 0xb7, 0x1b, 0x57, 0xaf, 0x63, 0xf9, 0xfd,
+	0x90, 0x89, 0xda, 0x75, 0x63, 0x02, 0x48, 0xa9, 0x00, 0xd9, 0x6d, 0xda, 0x22, 0x61, 0x64, 0x6d,
+	0x4a, 0x85, 0x50, 0xa5, 0xd5, 0xd8, 0x3b, 0x59, 0xaf, 0xea, 0xfd, 0x60, 0x67, 0x9c, 0xda, 0x95,
+	0x90, 0x10, 0x27, 0x8e, 0xfd, 0x1b, 0xe0, 0xd6, 0x53, 0x0f, 0xfc, 0x03, 0x48, 0x1c, 0x2a, 0x4e,
+	0x85, 0x13, 0x12, 0x12, 0x45, 0xe9, 0xa1, 0x12, 0x27, 0xee, 0x5c, 0xd0, 0xcc, 0xec, 0xae, 0x3f,
+	0x92, 0xd8, 0x0e, 0x12, 0x9c, 0x38, 0x25, 0xf3, 0xfc, 0x7e, 0xf3, 0x3c, 0x33, 0xcf, 0xc7, 0x6f,
+	0xbc, 0x50, 0xc4, 0xae, 0x19, 0x78, 0x0e, 0x31, 0x71, 0x99, 0xd0, 0x56, 0xe0, 0x3d, 0x2c, 0x1f,
+	0xed, 0xe0, 0x8e, 0xdf, 0xc6, 0x3b, 0x65, 0xd6, 0xd3, 0xfc, 0xc0, 0x63, 0x1e, 0xda, 0x8c, 0x39,
+	0x9a, 0xe4, 0x68, 0x11, 0x27, 0x77, 0xa9, 0xe5, 0x51, 0xc7, 0xa3, 0x65, 0x87, 0x5a, 0xe5, 0xa3,
+	0x1d, 0xfe, 0x47, 0xee, 0xc9, 0xe5, 0x43, 0xa0, 0x89, 0x29, 0x29, 0x1f, 0xed, 0x34, 0x09, 0xc3,
+	0x3b, 0xe5, 0x96, 0x67, 0xbb, 0x21, 0xbe, 0x29, 0x71, 0x43, 0xac, 0xca, 0x72, 0x11, 0x42, 0x59,
+	0xcb, 0xb3, 0x3c, 0x69, 0xe7, 0xff, 0x45, 0x1b, 0x2c, 0xcf, 0xb3, 0x3a, 0xa4, 0x2c, 0x56, 0xcd,
+	0xee, 0x61, 0x19, 0xbb, 0xfd, 0x10, 0xda, 0x1a, 0x87, 0x98, 0xed, 0x10, 0xca, 0xb0, 0xe3, 0x4b,
+	0x42, 0xf1, 0xf7, 0x65, 0x58, 0xab, 0x53, 0xeb, 0x43, 0xdf, 0xc4, 0x8c, 0x34, 0x70, 0x80, 0x1d,
+	0x8a, 0xde, 0x84, 0x55, 0xdc, 0x65, 0x6d, 0x2f, 0xb0, 0x59, 0x5f, 0x55, 0x0a, 0x4a, 0x69, 0xb5,
+	0xa6, 0xfe, 0xf0, 0xf5, 0xd5, 0x6c, 0x78, 0x94, 0xaa, 0x69, 0x06, 0x84, 0xd2, 0x03, 0x16, 0xd8,
+	0xae, 0xa5, 0x0f, 0xa8, 0x48, 0x83, 0x0d, 0x07, 0xf7, 0x0c, 0x87, 0x30, 0x6c, 0x62, 0x86, 0x8d,
+	0x0e, 0x71, 0x2d, 0xd6, 0x56, 0x13, 0x05, 0xa5, 0xb4, 0xa0, 0xaf, 0x3b, 0xb8, 0x57, 0x0f, 0x91,
+	0xf7, 0x05, 0x80, 0xde, 0x86, 0x34, 0xe9, 0x91, 0x96, 0x71, 0x48, 0x88, 0x71, 0xd8, 0xc1, 0x4c,
+	0x9d, 0x2f, 0x28, 0xa5, 0x64, 0x65, 0x53, 0x0b, 0x03, 0xf1, 0x04, 0x69, 0x61, 0x82, 0xb4, 0x1b,
+	0x9e, 0xed, 0xea, 0x49, 0xce, 0xbf, 0x45, 0xc8, 0xad, 0x0e, 0x66, 0xa8, 0x00, 0xa9, 0x78, 0x7b,
+	0xd3, 0xa7, 0xea, 0x42, 0x41, 0x29, 0xa5, 0x75, 0x08, 0x29, 0x35, 0x9f, 0xa2, 0x4f, 0x21, 0xeb,
+	0xd8, 0x2e, 0x4f, 0xa4, 0xef, 0x51, 0xdc, 0x31, 0x4c, 0xe2, 0x7b, 0xd4, 0x66, 0xea, 0x62, 0x61,
+	0x7e, 0x62, 0x9c, 0xda, 0xb5, 0x67, 0x3f, 0x6f, 0xcd, 0x3d, 0x79, 0xb1, 0x55, 0xb2, 0x6c, 0xd6,
+	0xee, 0x36, 0xb5, 0x96, 0xe7, 0x84, 0x85, 0x08, 0xff, 0x5c, 0xa5, 0xe6, 0x83, 0x32, 0xeb, 0xfb,
+	0x84, 0x8a, 0x0d, 0x54, 0x47, 0x8e, 0xed, 0x36, 0xc2, 0x38, 0x37, 0x65, 0x18, 0x54, 0x81, 0x8b,
+	0xcd, 0x6e, 0xe0, 0x1a, 0xa4, 0xe7, 0xdb, 0x01, 0x31, 0xa3, 0xf0, 0x54, 0x5d, 0x2a, 0x28, 0xa5,
+	0x15, 0x7d, 0x83, 0x83, 0xfb, 0x12, 0x0b, 0xb7, 0x50, 0xf4, 0x7f, 0x58, 0xe3, 0x39, 0xf4, 0x03,
+	0x62, 0xe0, 0x16, 0xb3, 0x3d, 0x97, 0xaa, 0xcb, 0x22, 0x7f, 0x69, 0x07, 0xf7, 0x1a, 0x01, 0xa9,
+	0x4a, 0x23, 0x2a, 0xc1, 0x05, 0xc1, 0xf3, 0x28, 0x8b, 0x89, 0x2b, 0x82, 0x98, 0xe1, 0x44, 0x8f,
+	0xb2, 0x88, 0xb9, 0x05, 0x49, 0xce, 0x8c, 0x48, 0xab, 0x82, 0x04, 0x0e, 0xee, 0x45, 0x84, 0xab,
+	0xb2, 0x6c, 0xd8, 0x22, 0x2e, 0xa3, 0x86, 0x4f, 0x02, 0x83, 0xa7, 0x50, 0x05, 0x41, 0xe4, 0x51,
+	0xaa, 0x02, 0x69, 0x90, 0x60, 0xbf, 0x47, 0x5a, 0xd1, 0x09, 0xa5, 0x3f, 0x83, 0xda, 0x8f, 0x88,
+	0x9a, 0x8c, 0x4f, 0x28, 0x7d, 0x1e, 0xd8, 0x8f, 0x08, 0xbf, 0x3d, 0xee, 0x74, 0xbc, 0x87, 0xc4,
+	0x34, 0x1c, 0x42, 0x29, 0xb6, 0x88, 0x21, 0x12, 0xa6, 0xa6, 0x0a, 0xf3, 0xa5, 0x55, 0x7d, 0x23,
+	0x04, 0xeb, 0x12, 0xbb, 0xcb, 0x21, 0x74, 0x0d, 0xb2, 0x26, 0x71, 0xed, 0x13, 0x5b, 0xd2, 0x62,
+	0x0b, 0x92, 0xd8, 0xc8, 0x8e, 0x6d, 0xe0, 0x8d, 0x65, 0xb8, 0x84, 0x32, 0xdb, 0xb5, 0x78, 0x8a,
+	0x59, 0x5b, 0xcd, 0x88, 0xf3, 0xf0, 0x63, 0x7e, 0x20, 0xed, 0x37, 0xb9, 0x19, 0x15, 0x21, 0x6d,
+	0x61, 0x79, 0x43, 0x71, 0x59, 0x75, 0x4d, 0xf0, 0x92, 0x16, 0xe6, 0x97, 0x13, 0xb7, 0x44, 0xff,
+	0x85, 0x4c, 0xcc, 0x11, 0x77, 0x51, 0x2f, 0x08, 0x52, 0x2a, 0x24, 0x09, 0x1b, 0x4f, 0xd9, 0x28,
+	0xcb, 0x68, 0xf6, 0x19, 0x51, 0xd7, 0x65, 0xca, 0x86, 0xa9, 0xb5, 0x3e, 0x23, 0xa8, 0x0c, 0x59,
+	0x59, 0xd4, 0xae, 0x4b, 0xe4, 0xae, 0x66, 0xc7, 0x6b, 0x3d, 0x50, 0x51, 0x3c, 0x19, 0x0d, 0x01,
+	0x35, 0x48, 0x50, 0xe3, 0x00, 0xba, 0x02, 0x88, 0x6f, 0x08, 0xc8, 0x61, 0xd7, 0x35, 0xe3, 0xd2,
+	0x6d, 0xc4, 0x15, 0xd1, 0x05, 0x30, 0xd6, 0x0b, 0xd4, 0x76, 0xba, 0x1d, 0xcc, 0x88, 0x61, 0x61,
+	0xaa, 0x66, 0xe3, 0x5e, 0x38, 0x08, 0xcd, 0xb7, 0x31, 0xdd, 0xcb, 0x7c, 0xfe, 0xea, 0xe9, 0xf6,
+	0x60, 0x62, 0x8b, 0x9b, 0x70, 0x69, 0x6c, 0xf8, 0x75, 0x42, 0x7d, 0xcf, 0xa5, 0xa4, 0xa8, 0x43,
+	0xa6, 0x4e, 0xad, 0x1b, 0x01, 0xc1, 0x8c, 0xc8, 0xd4, 0x54, 0x60, 0xb9, 0xc5, 0x97, 0x5e, 0x30,
+	0x55, 0x14, 0x22, 0xe2, 0x5e, 0x8a, 0x07, 0x8c, 0x56, 0xc5, 0x3b, 0xf0, 0xaf, 0x51, 0x9f, 0x51,
+	0x34, 0xa4, 0xc1, 0xa2, 0x2c, 0xc9, 0x34, 0xcf, 0x92, 0x56, 0xfc, 0x69, 0x01, 0xd6, 0xeb, 0xd4,
+	0x3a, 0xe8, 0x36, 0x1d, 0x9b, 0x45, 0x73, 0x87, 0x76, 0x61, 0x45, 0xce, 0x3a, 0x99, 0x7e, 0xc4,
+	0x98, 0x39, 0x88, 0x9d, 0x98, 0x29, 0x36, 0x7a, 0x03, 0x92, 0xc3, 0xe3, 0x39, 0x2f, 0xc4, 0x24,
+	0xab, 0x49, 0xa5, 0xd5, 0x22, 0xa5, 0xd5, 0xaa, 0x6e, 0x5f, 0x07, 0x7f, 0x30, 0xb1, 0x6f, 0x41,
+	0x6a, 0x64, 0x5a, 0x17, 0x26, 0xec, 0x4b, 0xfa, 0x43, 0x03, 0x9c, 0x83, 0x95, 0x48, 0x52, 0xd5,
+	0x45, 0x7e, 0x44, 0x3d, 0x5e, 0xa3, 0xeb, 0x90, 0x19, 0x6b, 0x92, 0xa5, 0x09, 0x6e, 0xd3, 0xc1,
+	0x48, 0xdf, 0xfc, 0x87, 0xeb, 0x2f, 0x97, 0x1f, 0xa3, 0x4d, 0x6c, 0xab, 0xcd, 0x42, 0xa5, 0x49,
+	0x49, 0xe3, 0x1d, 0x61, 0x43, 0x55, 0x48, 0x86, 0x24, 0xfe, 0x74, 0x08, 0x8d, 0x49, 0x56, 0x72,
+	0x27, 0xdc, 0xdf, 0x8d, 0xde, 0x95, 0xda, 0xc2, 0xe3, 0x17, 0x5b, 0x8a, 0x0e, 0x72, 0x13, 0x37,
+	0xf3, 0x0b, 0x7c, 0xd2, 0xc5, 0x2e, 0xe3, 0xcf, 0x89, 0x94, 0x9f, 0x78, 0xcd, 0xdf, 0x1a, 0xae,
+	0x36, 0x5d, 0xe6, 0x05, 0x54, 0x85, 0xc2, 0xfc, 0xc4, 0x02, 0x0c, 0xa8, 0x7c, 0xee, 0xa3, 0x85,
+	0x61, 0x05, 0x5e, 0xd7, 0x37, 0x6c, 0x33, 0xd4, 0xa1, 0xb5, 0x08, 0xb8, 0xcd, 0xed, 0xef, 0x99,
+	0xfc, 0xa1, 0xe0, 0xf3, 0xc1, 0xcd, 0x62, 0x36, 0x52, 0xb1, 0x04, 0x72, 0x41, 0xe3, 0x73, 0x91,
+	0xe6, 0x6d, 0x1a, 0x77, 0x44, 0xb1, 0x05, 0x9b, 0x27, 0x9a, 0x2b, 0x6e, 0xd5, 0x5b, 0x90, 0x1d,
+	0x94, 0xdf, 0x08, 0x42, 0x33, 0x55, 0x95, 0x09, 0x89, 0x47, 0x71, 0x1f, 0x44, 0x6e, 0x68, 0xf1,
+	0xdb, 0x04, 0x2c, 0xd7, 0xa9, 0x25, 0x34, 0x75, 0x17, 0x56, 0xa2, 0x43, 0x4f, 0x6f, 0xdc, 0x88,
+	0x89, 0xae, 0xc1, 0x92, 0x14, 0x6d, 0x35, 0x31, 0x25, 0x71, 0x21, 0x0f, 0x69, 0xb0, 0x3c, 0x4b,
+	0xdb, 0x46, 0x24, 0x94, 0x07, 0x08, 0x2b, 0x65, 0x13, 0xd9, 0xb1, 0x0b, 0xfa, 0x90, 0x05, 0x05,
+	0x90, 0x31, 0x49, 0xab, 0x83, 0xf9, 0xeb, 0x76, 0x84, 0x3b, 0x5d, 0xf2, 0x57, 0x3c, 0xad, 0xe9,
+	0x28, 0xc4, 0x3d, 0x1e, 0x21, 0xac, 0x55, 0x94, 0x84, 0xe2, 0x37, 0x09, 0x58, 0x0b, 0xd3, 0x18,
+	0x97, 0xe8, 0x5d, 0xb8, 0x70, 0xae, 0xf2, 0xac, 0xe1, 0xd1, 0xda, 0xa0, 0x00, 0x2e, 0x0e, 0xcd,
+	0xea, 0x90, 0x97, 0x84, 0xf0, 0xf2, 0x8e, 0x76, 0xe6, 0xcf, 0x3e, 0x6d, 0xec, 0x2c, 0xda, 0xe0,
+	0x29, 0x8e, 0xdd, 0xeb, 0x1b, 0xfe, 0x49, 0x63, 0xae, 0x0f, 0x1b, 0xa7, 0x70, 0xcf, 0xab, 0x8c,
+	0xa8, 0x02, 0xab, 0xe3, 0xc7, 0x3d, 0xfd, 0xd2, 0x03, 0x5a, 0xf1, 0x0b, 0x45, 0xa8, 0xe9, 0x0d,
+	0xec, 0xb6, 0x48, 0xe7, 0xef, 0x55, 0xd3, 0xf1, 0xd1, 0xbb, 0x0c, 0x9b, 0x27, 0x4e, 0x12, 0xbf,
+	0x49, 0xdf, 0x27, 0x60, 0x7d, 0xf0, 0x5e, 0xfd, 0xa3, 0xfa, 0x7f, 0x46, 0xf5, 0x4f, 0x4f, 0xf8,
+	0x68, 0x4a, 0xa3, 0x84, 0x57, 0xbe, 0x5a, 0x84, 0xf9, 0x3a, 0xb5, 0x90, 0x0b, 0xa9, 0x91, 0x2f,
+	0x84, 0xed, 0xc9, 0x03, 0x30, 0xcc, 0xcd, 0x55, 0x66, 0xe7, 0xc6, 0x03, 0xfc, 0x00, 0x92, 0xc3,
+	0xbf, 0x3c, 0x5e, 0x9b, 0xec, 0x62, 0x88, 0x9a, 0xdb, 0x99, 0x99, 0x1a, 0x07, 0x63, 0x90, 0x19,
+	0xfb, 0x1d, 0x71, 0x65, 0xb2, 0x93, 0x51, 0x76, 0x6e, 0xf7, 0x3c, 0xec, 0x38, 0xea, 0x3d, 0x58,
+	0x10, 0xd2, 0x5f, 0x9c, 0xae, 0x25, 0xb9, 0xed, 0xd9, 0xf5, 0x86, 0xdf, 0x66, 0x6c, 0x8e, 0xa7,
+	0xdc, 0x66, 0x94, 0x9d, 0xdb, 0x3d, 0x0f, 0x7b, 0x38, 0xea, 0xd8, 0x54, 0x5e, 0x99, 0xa9, 0xec,
+	0x33, 0x46, 0x3d, 0xbd, 0x3d, 0x73, 0x8b, 0x9f, 0xbd, 0x7a, 0xba, 0xad, 0xd4, 0x7e, 0x53, 0x9e,
+	0x1d, 0xe7, 0x95, 0xe7, 0xc7, 0x79, 0xe5, 0x97, 0xe3, 0xbc, 0xf2, 0xf8, 0x65, 0x7e, 0xee, 0xf9,
+	0xcb, 0xfc, 0xdc, 0x8f, 0x2f, 0xf3, 0x73, 0xf0, 0xef, 0x96, 0xe7, 0x9c, 0xed, 0xba, 0xb6, 0x7c,
+	0xb7, 0xd7, 0xe0, 0xa3, 0xd2, 0x50, 0x3e, 0x2e, 0x9d, 0xf9, 0xb5, 0x7f, 0x5d, 0xae, 0xa3, 0xe5,
+	0x97, 0x89, 0xf9, 0xea, 0xfe, 0x47, 0x4f, 0x12, 0x9b, 0xd5, 0xd8, 0xed, 0xbe, 0x74, 0x7b, 0x2f,
+	0x64, 0x7c, 0x37, 0x84, 0xdd, 0x97, 0xd8, 0xfd, 0x08, 0x3b, 0x4e, 0xfc, 0xef, 0x4c, 0xec, 0xfe,
+	0xed, 0x46, 0x2d, 0xfa, 0x44, 0xfe, 0x35, 0x71, 0x39, 0xe6, 0xed, 0xed, 0x49, 0xe2, 0xde, 0x5e,
+	0xc4, 0x6c, 0x2e, 0x89, 0x09, 0x7f, 0xfd, 0x8f, 0x01, 0x00, 0x3b, 0x12, 0x90, 0x9b, 0xa4, 0x10,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams updates the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateAgent creates an escrow agent for a proposal.
+	CreateAgent(ctx context.Context, in *MsgCreateAgent, opts ...grpc.CallOption) (*MsgCreateAgentResponse, error)
+	// SubmitProposal submits a proposal.
+	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
+	// Exec executes a proposal.
+	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// CancelProposal cancels a proposal.
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// UpdateProposal updates a proposal.
+	UpdateProposal(ctx context.Context, in *MsgUpdateProposal, opts ...grpc.CallOption) (*MsgUpdateProposalResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAgent(ctx context.Context, in *MsgCreateAgent, opts ...grpc.CallOption) (*MsgCreateAgentResponse, error) {
+	out := new(MsgCreateAgentResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/CreateAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
+	out := new(MsgSubmitProposalResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/SubmitProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
+	out := new(MsgExecResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProposal(ctx context.Context, in *MsgUpdateProposal, opts ...grpc.CallOption) (*MsgUpdateProposalResponse, error) {
+	out := new(MsgUpdateProposalResponse)
+	err := c.cc.Invoke(ctx, "/andromeda.escrow.v1alpha1.Msg/UpdateProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 // MsgServer is the server API for Msg service.
@@ -576,6 +1240,10 @@ type MsgServer interface {
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// CancelProposal cancels a proposal.
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// UpdateProposal updates a proposal.
+	UpdateProposal(context.Context, *MsgUpdateProposal) (*MsgUpdateProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -594,6 +1262,12 @@ func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmi
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProposal(ctx context.Context, req *MsgUpdateProposal) (*MsgUpdateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -671,6 +1345,42 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromeda.escrow.v1alpha1.Msg/UpdateProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProposal(ctx, req.(*MsgUpdateProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "andromeda.escrow.v1alpha1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -691,6 +1401,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
+		{
+			MethodName: "UpdateProposal",
+			Handler:    _Msg_UpdateProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "andromeda/escrow/v1alpha1/tx.proto",
@@ -716,32 +1434,161 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MaxMetadataLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxMetadataLength))
+	if m.MaxSimulateGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSimulateGas))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.MaxRefundActions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRefundActions))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.MaxPrunesPerBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPrunesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	if m.GasPerActionByte != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasPerActionByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.GasPerAction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasPerAction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GasPerAgent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasPerAgent))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for iNdEx := len(m.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMessageTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeniedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for iNdEx := len(m.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMessageTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxActionSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxActionSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxAgentsPerExec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxAgentsPerExec))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxActions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxActions))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxPostActions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPostActions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPreActions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPreActions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BurnExpiredDeposits {
+		i--
+		if m.BurnExpiredDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for iNdEx := len(m.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExecFeeBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExecFeeFlat != nil {
+		{
+			size, err := m.ExecFeeFlat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxMetadataLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxMetadataLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -834,6 +1681,59 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExecGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpireTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -906,6 +1806,20 @@ func (m *MsgSubmitProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.PreActionResponses) > 0 {
+		for iNdEx := len(m.PreActionResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActionResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -929,6 +1843,38 @@ func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeclaredValue) > 0 {
+		for iNdEx := len(m.DeclaredValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeclaredValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Quantities) > 0 {
+		dAtA4 := make([]byte, len(m.Quantities)*10)
+		var j3 int
+		for _, num := range m.Quantities {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Actions) > 0 {
 		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -982,72 +1928,377 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PostActionResponses) > 0 {
+		for iNdEx := len(m.PostActionResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActionResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActionResponses) > 0 {
+		for iNdEx := len(m.ActionResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgExecResponse_PostActionResponses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgExecResponse_PostActionResponses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecResponse_PostActionResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.MaxMetadataLength != 0 {
-		n += 1 + sovTx(uint64(m.MaxMetadataLength))
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Agent)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProposal) Size() (n int) {
+func (m *MsgUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostActions) > 0 {
+		for iNdEx := len(m.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreActions) > 0 {
+		for iNdEx := len(m.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Agent) > 0 {
+		i -= len(m.Agent)
+		copy(dAtA[i:], m.Agent)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxMetadataLength != 0 {
+		n += 1 + sovTx(uint64(m.MaxMetadataLength))
+	}
+	if m.ExecFeeFlat != nil {
+		l = m.ExecFeeFlat.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecFeeBps != 0 {
+		n += 1 + sovTx(uint64(m.ExecFeeBps))
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for _, e := range m.MinProposalDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BurnExpiredDeposits {
+		n += 2
+	}
+	if m.MaxPreActions != 0 {
+		n += 1 + sovTx(uint64(m.MaxPreActions))
+	}
+	if m.MaxPostActions != 0 {
+		n += 1 + sovTx(uint64(m.MaxPostActions))
+	}
+	if m.MaxActions != 0 {
+		n += 1 + sovTx(uint64(m.MaxActions))
+	}
+	if m.MaxAgentsPerExec != 0 {
+		n += 1 + sovTx(uint64(m.MaxAgentsPerExec))
+	}
+	if m.MaxActionSize != 0 {
+		n += 1 + sovTx(uint64(m.MaxActionSize))
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for _, s := range m.AllowedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for _, s := range m.DeniedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovTx(uint64(m.MaxNestingDepth))
+	}
+	if m.GasPerAgent != 0 {
+		n += 1 + sovTx(uint64(m.GasPerAgent))
+	}
+	if m.GasPerAction != 0 {
+		n += 2 + sovTx(uint64(m.GasPerAction))
+	}
+	if m.GasPerActionByte != 0 {
+		n += 2 + sovTx(uint64(m.GasPerActionByte))
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		n += 2 + sovTx(uint64(m.MaxPrunesPerBlock))
+	}
+	if m.MaxRefundActions != 0 {
+		n += 2 + sovTx(uint64(m.MaxRefundActions))
+	}
+	if m.MaxSimulateGas != 0 {
+		n += 2 + sovTx(uint64(m.MaxSimulateGas))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1077,6 +2328,34 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, s := range m.Executors {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovTx(uint64(m.ExecutorGroupId))
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovTx(uint64(m.MaxExecGas))
+	}
 	return n
 }
 
@@ -1086,6 +2365,12 @@ func (m *MsgSubmitProposalResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.PreActionResponses) > 0 {
+		for _, e := range m.PreActionResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1111,25 +2396,1251 @@ func (m *MsgExec) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.Quantities) > 0 {
+		l = 0
+		for _, e := range m.Quantities {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.DeclaredValue) > 0 {
+		for _, e := range m.DeclaredValue {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActionResponses) > 0 {
+		for _, e := range m.ActionResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PostActionResponses) > 0 {
+		for _, e := range m.PostActionResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecResponse_PostActionResponses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Agent)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PreActions) > 0 {
+		for _, e := range m.PreActions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PostActions) > 0 {
+		for _, e := range m.PostActions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+			}
+			m.MaxMetadataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecFeeFlat == nil {
+				m.ExecFeeFlat = &types.Coin{}
+			}
+			if err := m.ExecFeeFlat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
+			}
+			m.ExecFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinProposalDeposit = append(m.MinProposalDeposit, types.Coin{})
+			if err := m.MinProposalDeposit[len(m.MinProposalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnExpiredDeposits = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
+			}
+			m.MaxPreActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPreActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
+			}
+			m.MaxPostActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPostActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
+			}
+			m.MaxActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+			}
+			m.MaxAgentsPerExec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+			}
+			m.MaxActionSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActionSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessageTypes = append(m.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMessageTypes = append(m.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+			}
+			m.GasPerAgent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAgent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+			}
+			m.GasPerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+			}
+			m.GasPerActionByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerActionByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+			}
+			m.MaxPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+			}
+			m.MaxRefundActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
+			}
+			m.MaxSimulateGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSimulateGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgExecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1152,17 +3663,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActionResponses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1172,43 +3683,26 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
-			}
-			m.MaxMetadataLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxMetadataLength |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.PreActionResponses = append(m.PreActionResponses, &types1.Any{})
+			if err := m.PreActionResponses[len(m.PreActionResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1230,7 +3724,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,12 +3747,220 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agents = append(m.Agents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, &types1.Any{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Quantities = append(m.Quantities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Quantities) == 0 {
+					m.Quantities = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Quantities = append(m.Quantities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantities", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclaredValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeclaredValue = append(m.DeclaredValue, types.Coin{})
+			if err := m.DeclaredValue[len(m.DeclaredValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1280,7 +3982,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAgent) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1303,17 +4005,17 @@ func (m *MsgCreateAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionResponses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1323,23 +4025,59 @@ func (m *MsgCreateAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionResponses = append(m.ActionResponses, &types1.Any{})
+			if err := m.ActionResponses[len(m.ActionResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActionResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PostActionResponses = append(m.PostActionResponses, &MsgExecResponse_PostActionResponses{})
+			if err := m.PostActionResponses[len(m.PostActionResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1362,7 +4100,7 @@ func (m *MsgCreateAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAgentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse_PostActionResponses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,10 +4123,10 @@ func (m *MsgCreateAgentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAgentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostActionResponses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostActionResponses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1423,6 +4161,40 @@ func (m *MsgCreateAgentResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Agent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &types1.Any{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1444,7 +4216,7 @@ func (m *MsgCreateAgentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1467,10 +4239,10 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1537,106 +4309,6 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Agent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreActions = append(m.PreActions, &types.Any{})
-			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostActions = append(m.PostActions, &types.Any{})
-			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1658,7 +4330,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1681,10 +4353,10 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1708,7 +4380,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,15 +4403,15 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1767,11 +4439,11 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executor = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1799,11 +4471,11 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agents = append(m.Agents, string(dAtA[iNdEx:postIndex]))
+			m.Agent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1830,8 +4502,108 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = append(m.Actions, &types.Any{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreActions = append(m.PreActions, &types1.Any{})
+			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostActions = append(m.PostActions, &types1.Any{})
+			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1856,7 +4628,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1879,10 +4651,10 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,17 +5,24 @@ package escrowv1alpha1
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +34,59 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// the maximum length allowed for metadata
 	MaxMetadataLength uint64 `protobuf:"varint,1,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *types.Coin `protobuf:"bytes,2,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,3,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_proposal_deposit"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,5,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,6,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,7,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,8,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,9,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,10,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,11,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,12,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,13,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,14,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,15,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,16,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,17,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,18,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,19,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +129,132 @@ func (m *Params) GetMaxMetadataLength() uint64 {
 	return 0
 }
 
+func (m *Params) GetExecFeeFlat() *types.Coin {
+	if m != nil {
+		return m.ExecFeeFlat
+	}
+	return nil
+}
+
+func (m *Params) GetExecFeeBps() uint32 {
+	if m != nil {
+		return m.ExecFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetMinProposalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinProposalDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetBurnExpiredDeposits() bool {
+	if m != nil {
+		return m.BurnExpiredDeposits
+	}
+	return false
+}
+
+func (m *Params) GetMaxPreActions() uint64 {
+	if m != nil {
+		return m.MaxPreActions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPostActions() uint64 {
+	if m != nil {
+		return m.MaxPostActions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActions() uint64 {
+	if m != nil {
+		return m.MaxActions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAgentsPerExec() uint64 {
+	if m != nil {
+		return m.MaxAgentsPerExec
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActionSize() uint64 {
+	if m != nil {
+		return m.MaxActionSize
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedMessageTypes() []string {
+	if m != nil {
+		return m.AllowedMessageTypes
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedMessageTypes() []string {
+	if m != nil {
+		return m.DeniedMessageTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxNestingDepth() uint64 {
+	if m != nil {
+		return m.MaxNestingDepth
+	}
+	return 0
+}
+
+func (m *Params) GetGasPerAgent() uint64 {
+	if m != nil {
+		return m.GasPerAgent
+	}
+	return 0
+}
+
+func (m *Params) GetGasPerAction() uint64 {
+	if m != nil {
+		return m.GasPerAction
+	}
+	return 0
+}
+
+func (m *Params) GetGasPerActionByte() uint64 {
+	if m != nil {
+		return m.GasPerActionByte
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRefundActions() uint64 {
+	if m != nil {
+		return m.MaxRefundActions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSimulateGas() uint64 {
+	if m != nil {
+		return m.MaxSimulateGas
+	}
+	return 0
+}
+
 // Agent defines an account taking charge of a proposal.
 type Agent struct {
 	// the address of the creator
@@ -120,11 +306,43 @@ type Proposal struct {
 	// the address of the proposer
 	Proposer []byte `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// the messages which has been executed on the submission
-	PreActions []*types.Any `protobuf:"bytes,2,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	PreActions []*types1.Any `protobuf:"bytes,2,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
 	// the messages which will be executed after the actions included in Msg/Exec
-	PostActions []*types.Any `protobuf:"bytes,3,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	PostActions []*types1.Any `protobuf:"bytes,3,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*types1.Any `protobuf:"bytes,5,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,6,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *time.Time `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors [][]byte `protobuf:"bytes,9,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,10,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,12,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,13,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *time.Time `protobuf:"bytes,14,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,15,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -167,14 +385,14 @@ func (m *Proposal) GetProposer() []byte {
 	return nil
 }
 
-func (m *Proposal) GetPreActions() []*types.Any {
+func (m *Proposal) GetPreActions() []*types1.Any {
 	if m != nil {
 		return m.PreActions
 	}
 	return nil
 }
 
-func (m *Proposal) GetPostActions() []*types.Any {
+func (m *Proposal) GetPostActions() []*types1.Any {
 	if m != nil {
 		return m.PostActions
 	}
@@ -188,6 +406,83 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetRefundActions() []*types1.Any {
+	if m != nil {
+		return m.RefundActions
+	}
+	return nil
+}
+
+func (m *Proposal) GetExpireHeight() uint64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Proposal) GetExecutors() [][]byte {
+	if m != nil {
+		return m.Executors
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecutorGroupId() uint64 {
+	if m != nil {
+		return m.ExecutorGroupId
+	}
+	return 0
+}
+
+func (m *Proposal) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Proposal) GetMaxExecGas() uint64 {
+	if m != nil {
+		return m.MaxExecGas
+	}
+	return 0
+}
+
+func (m *Proposal) GetSubmitHeight() uint64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "andromeda.escrow.v1alpha1.Params")
 	proto.RegisterType((*Agent)(nil), "andromeda.escrow.v1alpha1.Agent")
@@ -199,31 +494,71 @@ func init() {
 }
 
 var fileDescriptor_20554566221d01d5 = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x18, 0x85, 0x3b, 0x69, 0x6f, 0x6f, 0xef, 0xb4, 0x9b, 0x1b, 0x5d, 0xa4, 0x15, 0x43, 0x2d, 0x14,
-	0xb2, 0x9a, 0x50, 0x45, 0x94, 0xb8, 0x4a, 0xa1, 0xb8, 0x51, 0x08, 0x41, 0x44, 0xa4, 0x50, 0xa6,
-	0xe9, 0x98, 0x0a, 0x49, 0x66, 0x98, 0x8c, 0xda, 0xbe, 0x85, 0xcf, 0xe0, 0xd2, 0xa5, 0x4f, 0x21,
-	0xae, 0xba, 0x74, 0x29, 0xe9, 0xce, 0xad, 0x2f, 0x20, 0xcd, 0x74, 0x82, 0x9b, 0xba, 0xcb, 0xe1,
-	0x7c, 0xe7, 0xcf, 0x3f, 0xff, 0x81, 0x5d, 0x9c, 0x4c, 0x38, 0x8d, 0xc9, 0x04, 0xdb, 0x24, 0x0d,
-	0x38, 0x7d, 0xb0, 0xef, 0x7b, 0x38, 0x62, 0x53, 0xdc, 0xb3, 0xc5, 0x9c, 0x91, 0x14, 0x31, 0x4e,
-	0x05, 0xd5, 0x9b, 0x05, 0x86, 0x24, 0x86, 0x14, 0xd6, 0x6a, 0x86, 0x94, 0x86, 0x11, 0xb1, 0x73,
-	0x70, 0x7c, 0x77, 0x63, 0xe3, 0x64, 0x2e, 0x53, 0x9d, 0x63, 0x58, 0xf5, 0x30, 0xc7, 0x71, 0xaa,
-	0x23, 0xb8, 0x15, 0xe3, 0xd9, 0x28, 0x26, 0x02, 0x4f, 0xb0, 0xc0, 0xa3, 0x88, 0x24, 0xa1, 0x98,
-	0x1a, 0xa0, 0x0d, 0xac, 0x8a, 0xff, 0x3f, 0xc6, 0xb3, 0xf3, 0xb5, 0x73, 0x96, 0x1b, 0x9d, 0x3d,
-	0xf8, 0xc7, 0x0d, 0x49, 0x22, 0x74, 0x03, 0xfe, 0x0d, 0x38, 0xc1, 0x82, 0xf2, 0x1c, 0x6e, 0xf8,
-	0x4a, 0x76, 0x5e, 0x00, 0xac, 0x79, 0x9c, 0x32, 0x9a, 0xe2, 0x48, 0x6f, 0xc1, 0x1a, 0xcb, 0xbf,
-	0x89, 0xe2, 0x0a, 0xad, 0x1f, 0xc2, 0x3a, 0xe3, 0x64, 0x84, 0x03, 0x71, 0x4b, 0x93, 0xd4, 0xd0,
-	0xda, 0x65, 0xab, 0xbe, 0xbf, 0x8d, 0xe4, 0xda, 0x48, 0xad, 0x8d, 0xdc, 0x64, 0xee, 0x43, 0xc6,
-	0x89, 0x2b, 0x39, 0xfd, 0x08, 0x36, 0x18, 0x4d, 0x45, 0x91, 0x2b, 0xff, 0x92, 0xab, 0xaf, 0x48,
-	0x15, 0x6c, 0xc1, 0x9a, 0x7a, 0xa7, 0x51, 0x69, 0x03, 0xeb, 0x9f, 0x5f, 0xe8, 0xfe, 0x17, 0x78,
-	0xcd, 0x4c, 0xb0, 0xc8, 0x4c, 0xf0, 0x91, 0x99, 0xe0, 0x71, 0x69, 0x96, 0x16, 0x4b, 0xb3, 0xf4,
-	0xbe, 0x34, 0x4b, 0x70, 0x37, 0xa0, 0x31, 0xda, 0x78, 0xe6, 0x3e, 0xbc, 0x58, 0xd5, 0xe1, 0xad,
-	0xfe, 0xea, 0x81, 0x6b, 0x6b, 0x63, 0x6d, 0x27, 0x52, 0x2b, 0xf9, 0xa4, 0x95, 0xdd, 0xc1, 0xd5,
-	0xb3, 0xd6, 0x74, 0x8b, 0xc9, 0x03, 0x39, 0xf9, 0x72, 0x4d, 0xbc, 0xfd, 0xf0, 0x86, 0xd2, 0x1b,
-	0x2a, 0x2f, 0xd3, 0xba, 0x1b, 0xbd, 0xe1, 0xa9, 0xd7, 0x57, 0xad, 0x7d, 0x6a, 0x3b, 0x05, 0xe7,
-	0x38, 0x12, 0x74, 0x1c, 0x45, 0x8e, 0xab, 0xf9, 0xb1, 0x0e, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xf8, 0x02, 0xde, 0x54, 0x6d, 0x02, 0x00, 0x00,
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0x3b, 0x96, 0x57, 0x92, 0x9d, 0xac, 0x5d, 0x80, 0x76, 0x5b, 0x59, 0x75, 0x9a,
+	0x42, 0x28, 0x1a, 0x32, 0x76, 0x51, 0x14, 0x70, 0xd0, 0x83, 0xd4, 0xd8, 0x6e, 0x81, 0xa6, 0x10,
+	0x98, 0xa0, 0x28, 0x0a, 0x03, 0x8b, 0x25, 0x39, 0xa2, 0x88, 0x90, 0x5c, 0x96, 0xbb, 0x4c, 0xa4,
+	0x00, 0x7d, 0x87, 0x3c, 0x43, 0x8f, 0x7e, 0x92, 0xa0, 0xa7, 0x00, 0xbd, 0xf4, 0xd4, 0x14, 0xf6,
+	0xad, 0xd7, 0xbe, 0x40, 0xb1, 0xbb, 0x5c, 0xfa, 0x27, 0xb0, 0xd1, 0x43, 0x4f, 0xd2, 0xcc, 0xf7,
+	0xcd, 0xec, 0xce, 0xb7, 0x33, 0x43, 0x74, 0x8f, 0x66, 0x61, 0xc1, 0x52, 0x08, 0xa9, 0x0b, 0x3c,
+	0x28, 0xd8, 0x0b, 0xf7, 0xf9, 0x2e, 0x4d, 0xf2, 0x29, 0xdd, 0x75, 0xc5, 0x3c, 0x07, 0xee, 0xe4,
+	0x05, 0x13, 0x0c, 0x6f, 0xd6, 0x34, 0x47, 0xd3, 0x1c, 0x43, 0xdb, 0xea, 0x05, 0x8c, 0xa7, 0x8c,
+	0xbb, 0x3e, 0xe5, 0xe0, 0x3e, 0xdf, 0xf5, 0x41, 0xd0, 0x5d, 0x37, 0x60, 0x71, 0xa6, 0x43, 0xb7,
+	0x36, 0x22, 0x16, 0x31, 0xf5, 0xd7, 0x95, 0xff, 0x2a, 0xef, 0x66, 0xc4, 0x58, 0x94, 0x80, 0xab,
+	0x2c, 0xbf, 0x9c, 0xb8, 0x34, 0x9b, 0x57, 0xd0, 0xf6, 0x55, 0x48, 0xc4, 0x29, 0x70, 0x41, 0xd3,
+	0x5c, 0x13, 0x76, 0x4e, 0x96, 0xd1, 0xad, 0x31, 0x2d, 0x68, 0xca, 0xb1, 0x83, 0xd6, 0x53, 0x3a,
+	0x23, 0x29, 0x08, 0x1a, 0x52, 0x41, 0x49, 0x02, 0x59, 0x24, 0xa6, 0xb6, 0xd5, 0xb7, 0x06, 0x8b,
+	0xde, 0x9d, 0x94, 0xce, 0x1e, 0x57, 0xc8, 0x77, 0x0a, 0xc0, 0x5f, 0xa1, 0x2e, 0xcc, 0x20, 0x20,
+	0x13, 0x00, 0x32, 0x49, 0xa8, 0xb0, 0x1b, 0x7d, 0x6b, 0xd0, 0xde, 0xdb, 0x74, 0x74, 0x11, 0x8e,
+	0x2c, 0xc2, 0xa9, 0x8a, 0x70, 0xbe, 0x66, 0x71, 0xe6, 0xb5, 0x25, 0xff, 0x10, 0xe0, 0x30, 0xa1,
+	0x02, 0xf7, 0x51, 0xa7, 0x0e, 0xf7, 0x73, 0x6e, 0x37, 0xfb, 0xd6, 0xa0, 0xeb, 0xa1, 0x8a, 0x32,
+	0xca, 0x39, 0xfe, 0x05, 0x6d, 0xa4, 0x71, 0x46, 0xf2, 0x82, 0xe5, 0x8c, 0xd3, 0x84, 0x84, 0x90,
+	0x33, 0x1e, 0x0b, 0x7b, 0xb1, 0xdf, 0xbc, 0xf1, 0x9c, 0xd1, 0x83, 0xd7, 0x7f, 0x6e, 0x2f, 0x9c,
+	0xbc, 0xdd, 0x1e, 0x44, 0xb1, 0x98, 0x96, 0xbe, 0x13, 0xb0, 0xd4, 0xad, 0x94, 0xd5, 0x3f, 0xf7,
+	0x79, 0xf8, 0xac, 0x7a, 0x13, 0x19, 0xc0, 0x3d, 0x9c, 0xc6, 0xd9, 0xb8, 0x3a, 0xe7, 0x91, 0x3e,
+	0x06, 0xef, 0xa1, 0xf7, 0xfc, 0xb2, 0xc8, 0x08, 0xcc, 0xf2, 0xb8, 0x80, 0xd0, 0x1c, 0xcf, 0xed,
+	0xa5, 0xbe, 0x35, 0x68, 0x79, 0xeb, 0x12, 0x3c, 0xd0, 0x58, 0x15, 0xc2, 0xf1, 0x27, 0x68, 0x4d,
+	0x6a, 0x98, 0x17, 0x40, 0x68, 0x20, 0x62, 0x96, 0x71, 0xfb, 0x96, 0xd2, 0xaf, 0x9b, 0xd2, 0xd9,
+	0xb8, 0x80, 0xa1, 0x76, 0xe2, 0x01, 0xba, 0xad, 0x78, 0x8c, 0x8b, 0x9a, 0xb8, 0xac, 0x88, 0xab,
+	0x92, 0xc8, 0xb8, 0x30, 0xcc, 0x6d, 0xd4, 0x96, 0x4c, 0x43, 0x6a, 0x29, 0x12, 0x4a, 0xe9, 0xcc,
+	0x10, 0xee, 0xeb, 0x67, 0xa3, 0x11, 0x64, 0x82, 0x93, 0x1c, 0x0a, 0x22, 0x25, 0xb4, 0x57, 0x14,
+	0x51, 0x9e, 0x32, 0x54, 0xc8, 0x18, 0x8a, 0x83, 0x19, 0x04, 0xe6, 0x86, 0x3a, 0x1f, 0xe1, 0xf1,
+	0x4b, 0xb0, 0x51, 0x7d, 0x43, 0x9d, 0xf3, 0x49, 0xfc, 0x12, 0x64, 0xf5, 0x34, 0x49, 0xd8, 0x0b,
+	0x08, 0x49, 0x0a, 0x9c, 0xd3, 0x08, 0x88, 0x12, 0xcc, 0x6e, 0xf7, 0x9b, 0x83, 0x15, 0x6f, 0xbd,
+	0x02, 0x1f, 0x6b, 0xec, 0xa9, 0x84, 0xf0, 0x03, 0xb4, 0x11, 0x42, 0x16, 0xbf, 0x13, 0xd2, 0x51,
+	0x21, 0x58, 0x63, 0x97, 0x22, 0x3e, 0x45, 0xb2, 0xb1, 0x48, 0x06, 0x5c, 0xc4, 0x59, 0x24, 0x25,
+	0x16, 0x53, 0xbb, 0xab, 0xee, 0x23, 0xaf, 0xf9, 0xbd, 0xf6, 0x3f, 0x92, 0x6e, 0xbc, 0x83, 0xba,
+	0x11, 0xd5, 0x15, 0xaa, 0x62, 0xed, 0x55, 0xc5, 0x6b, 0x47, 0x54, 0x16, 0xa7, 0xaa, 0xc4, 0x1f,
+	0xa3, 0xd5, 0x9a, 0xa3, 0x6a, 0xb1, 0xd7, 0x14, 0xa9, 0x53, 0x91, 0x94, 0x4f, 0x4a, 0x76, 0x99,
+	0x45, 0xfc, 0xb9, 0x00, 0xfb, 0xb6, 0x96, 0xec, 0x22, 0x75, 0x34, 0x17, 0x80, 0x5d, 0xb4, 0xa1,
+	0x1f, 0xb5, 0xcc, 0x40, 0x47, 0xf9, 0x09, 0x0b, 0x9e, 0xd9, 0x77, 0xea, 0xc9, 0x18, 0x2b, 0x68,
+	0x0c, 0xc5, 0x48, 0x02, 0xf8, 0x33, 0x84, 0x65, 0x40, 0x01, 0x93, 0x32, 0x0b, 0xeb, 0xa7, 0xc3,
+	0xf5, 0x8b, 0x78, 0x0a, 0xb8, 0xd2, 0x0b, 0x3c, 0x4e, 0xcb, 0x84, 0x0a, 0x20, 0x11, 0xe5, 0xf6,
+	0x7a, 0xdd, 0x0b, 0x4f, 0x2a, 0xf7, 0x11, 0xe5, 0x3b, 0x1f, 0xa1, 0x25, 0x5d, 0xa6, 0x8d, 0x96,
+	0x83, 0x02, 0xa8, 0x60, 0x85, 0x1a, 0xcf, 0x8e, 0x67, 0xcc, 0x9d, 0xdf, 0x97, 0x50, 0xcb, 0x34,
+	0x32, 0xde, 0x42, 0x2d, 0x3d, 0x3c, 0x60, 0x78, 0xb5, 0x8d, 0xbf, 0x40, 0xed, 0x8b, 0x5d, 0xda,
+	0x50, 0x33, 0xb5, 0xe1, 0xe8, 0x7d, 0xe1, 0x98, 0x7d, 0xe1, 0x0c, 0xb3, 0xb9, 0x87, 0xf2, 0xf3,
+	0xc6, 0xfd, 0x12, 0x75, 0x2e, 0x35, 0x6d, 0xf3, 0x86, 0xb8, 0x76, 0x7e, 0xa1, 0x8f, 0xb7, 0x50,
+	0xcb, 0x6c, 0x16, 0x7b, 0xb1, 0x6f, 0x0d, 0x56, 0xbc, 0xda, 0xc6, 0x0f, 0xd1, 0xea, 0x15, 0xad,
+	0x96, 0x6e, 0x48, 0xdb, 0x2d, 0x2e, 0xc9, 0x77, 0x57, 0xae, 0x21, 0x39, 0x85, 0x64, 0x0a, 0x71,
+	0x34, 0x15, 0xd5, 0xc0, 0x75, 0xb4, 0xf3, 0x1b, 0xe5, 0xc3, 0x43, 0xd4, 0xae, 0x48, 0x72, 0x01,
+	0xaa, 0x51, 0x6b, 0xef, 0x6d, 0xbd, 0x93, 0xfe, 0xa9, 0xd9, 0x8e, 0xa3, 0xc5, 0x57, 0x6f, 0xb7,
+	0x2d, 0x0f, 0xe9, 0x20, 0xe9, 0x96, 0x05, 0xfc, 0x5c, 0xd2, 0x4c, 0xc4, 0x62, 0x5e, 0x4d, 0x61,
+	0x6d, 0xe3, 0x0f, 0xd0, 0x8a, 0x1c, 0xba, 0x52, 0xb0, 0x82, 0xdb, 0x2b, 0xfd, 0xe6, 0xa0, 0xe3,
+	0x9d, 0x3b, 0x64, 0x93, 0x1b, 0x83, 0x44, 0x05, 0x2b, 0x73, 0x12, 0x87, 0xd5, 0xd0, 0xad, 0x19,
+	0xe0, 0x48, 0xfa, 0xbf, 0x0d, 0x31, 0xa0, 0x65, 0xb3, 0xe6, 0xda, 0xff, 0xff, 0x9a, 0x33, 0xb9,
+	0xe5, 0xf2, 0x95, 0x3d, 0xa7, 0x16, 0xb0, 0xec, 0xb7, 0x4e, 0xbd, 0x56, 0xe4, 0x92, 0x38, 0xa2,
+	0x4a, 0x56, 0x5e, 0xfa, 0x69, 0x2c, 0x8c, 0xac, 0x7a, 0x2a, 0x3b, 0xda, 0x79, 0x2e, 0x6b, 0x45,
+	0x52, 0xb2, 0xae, 0xfe, 0x57, 0x59, 0x75, 0x90, 0x92, 0xf5, 0x2e, 0xaa, 0xde, 0x93, 0x4c, 0x68,
+	0x9c, 0x40, 0xa8, 0x06, 0xb6, 0xe5, 0x75, 0xb4, 0xf3, 0x50, 0xf9, 0x46, 0xff, 0x58, 0xaf, 0x4f,
+	0x7b, 0xd6, 0x9b, 0xd3, 0x9e, 0xf5, 0xd7, 0x69, 0xcf, 0x7a, 0x75, 0xd6, 0x5b, 0x78, 0x73, 0xd6,
+	0x5b, 0xf8, 0xe3, 0xac, 0xb7, 0x80, 0x3e, 0x0c, 0x58, 0xea, 0x5c, 0xfb, 0x45, 0x1d, 0x21, 0xb5,
+	0x67, 0xc6, 0xf2, 0x26, 0x63, 0xeb, 0xa7, 0xc1, 0xb5, 0x5f, 0xe8, 0x87, 0xda, 0x36, 0xe6, 0xaf,
+	0x8d, 0xe6, 0xf0, 0xe0, 0xc7, 0x93, 0xc6, 0xe6, 0xb0, 0xce, 0x7c, 0xa0, 0x33, 0xff, 0x50, 0x31,
+	0x7e, 0xbb, 0x80, 0x1d, 0x6b, 0xec, 0xd8, 0x60, 0xa7, 0x8d, 0x7b, 0xd7, 0x62, 0xc7, 0x47, 0xe3,
+	0x91, 0xf9, 0x90, 0xfe, 0xdd, 0x78, 0xbf, 0xe6, 0xed, 0xef, 0x6b, 0xe2, 0xfe, 0xbe, 0x61, 0xfa,
+	0xb7, 0x94, 0x80, 0x9f, 0xff, 0x3b, 0x00, 0x42, 0xca, 0x51, 0x7d, 0x58, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -246,6 +581,133 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSimulateGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSimulateGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxRefundActions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxRefundActions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPrunesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.GasPerActionByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasPerActionByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GasPerAction != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasPerAction))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GasPerAgent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasPerAgent))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxNestingDepth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxNestingDepth))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for iNdEx := len(m.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMessageTypes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DeniedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for iNdEx := len(m.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessageTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMessageTypes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedMessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxActionSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxActionSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxAgentsPerExec != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAgentsPerExec))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxActions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxActions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPostActions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPostActions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPreActions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPreActions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BurnExpiredDeposits {
+		i--
+		if m.BurnExpiredDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for iNdEx := len(m.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinProposalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExecFeeBps != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecFeeFlat != nil {
+		{
+			size, err := m.ExecFeeFlat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MaxMetadataLength != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMetadataLength))
 		i--
@@ -304,6 +766,98 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SubmitTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxExecGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxExecGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorGroupId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Executors[iNdEx])
+			copy(dAtA[i:], m.Executors[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Executors[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpireTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RefundActions) > 0 {
+		for iNdEx := len(m.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -369,23 +923,87 @@ func (m *Params) Size() (n int) {
 	if m.MaxMetadataLength != 0 {
 		n += 1 + sovTypes(uint64(m.MaxMetadataLength))
 	}
-	return n
-}
-
-func (m *Agent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
+	if m.ExecFeeFlat != nil {
+		l = m.ExecFeeFlat.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	return n
-}
-
-func (m *Proposal) Size() (n int) {
+	if m.ExecFeeBps != 0 {
+		n += 1 + sovTypes(uint64(m.ExecFeeBps))
+	}
+	if len(m.MinProposalDeposit) > 0 {
+		for _, e := range m.MinProposalDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.BurnExpiredDeposits {
+		n += 2
+	}
+	if m.MaxPreActions != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPreActions))
+	}
+	if m.MaxPostActions != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPostActions))
+	}
+	if m.MaxActions != 0 {
+		n += 1 + sovTypes(uint64(m.MaxActions))
+	}
+	if m.MaxAgentsPerExec != 0 {
+		n += 1 + sovTypes(uint64(m.MaxAgentsPerExec))
+	}
+	if m.MaxActionSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxActionSize))
+	}
+	if len(m.AllowedMessageTypes) > 0 {
+		for _, s := range m.AllowedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedMessageTypes) > 0 {
+		for _, s := range m.DeniedMessageTypes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxNestingDepth != 0 {
+		n += 1 + sovTypes(uint64(m.MaxNestingDepth))
+	}
+	if m.GasPerAgent != 0 {
+		n += 1 + sovTypes(uint64(m.GasPerAgent))
+	}
+	if m.GasPerAction != 0 {
+		n += 1 + sovTypes(uint64(m.GasPerAction))
+	}
+	if m.GasPerActionByte != 0 {
+		n += 2 + sovTypes(uint64(m.GasPerActionByte))
+	}
+	if m.MaxPrunesPerBlock != 0 {
+		n += 2 + sovTypes(uint64(m.MaxPrunesPerBlock))
+	}
+	if m.MaxRefundActions != 0 {
+		n += 2 + sovTypes(uint64(m.MaxRefundActions))
+	}
+	if m.MaxSimulateGas != 0 {
+		n += 2 + sovTypes(uint64(m.MaxSimulateGas))
+	}
+	return n
+}
+
+func (m *Agent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -411,6 +1029,50 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.RefundActions) > 0 {
+		for _, e := range m.RefundActions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpireHeight))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypes(uint64(m.Quantity))
+	}
+	if len(m.Executors) > 0 {
+		for _, b := range m.Executors {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExecutorGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutorGroupId))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxExecGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxExecGas))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SubmitHeight))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 2
+	}
 	return n
 }
 
@@ -439,21 +1101,422 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+			}
+			m.MaxMetadataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecFeeFlat == nil {
+				m.ExecFeeFlat = &types.Coin{}
+			}
+			if err := m.ExecFeeFlat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
+			}
+			m.ExecFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinProposalDeposit = append(m.MinProposalDeposit, types.Coin{})
+			if err := m.MinProposalDeposit[len(m.MinProposalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnExpiredDeposits = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
+			}
+			m.MaxPreActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPreActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
+			}
+			m.MaxPostActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPostActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
+			}
+			m.MaxActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+			}
+			m.MaxAgentsPerExec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+			}
+			m.MaxActionSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActionSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessageTypes = append(m.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMessageTypes = append(m.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+			}
+			m.MaxNestingDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestingDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+			}
+			m.GasPerAgent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAgent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+			}
+			m.GasPerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerAction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+			}
+			m.GasPerActionByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerActionByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+			}
+			m.MaxPrunesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+			}
+			m.MaxRefundActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
 			}
-			m.MaxMetadataLength = 0
+			m.MaxSimulateGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -463,7 +1526,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMetadataLength |= uint64(b&0x7F) << shift
+				m.MaxSimulateGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -665,7 +1728,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreActions = append(m.PreActions, &types.Any{})
+			m.PreActions = append(m.PreActions, &types1.Any{})
 			if err := m.PreActions[len(m.PreActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -699,7 +1762,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostActions = append(m.PostActions, &types.Any{})
+			m.PostActions = append(m.PostActions, &types1.Any{})
 			if err := m.PostActions[len(m.PostActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -736,6 +1799,293 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundActions = append(m.RefundActions, &types1.Any{})
+			if err := m.RefundActions[len(m.RefundActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executors = append(m.Executors, make([]byte, postIndex-iNdEx))
+			copy(m.Executors[len(m.Executors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+			}
+			m.ExecutorGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+			}
+			m.MaxExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
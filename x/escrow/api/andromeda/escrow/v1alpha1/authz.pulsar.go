@@ -0,0 +1,1239 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package escrowv1alpha1
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_EscrowAuthorization_2_list)(nil)
+
+type _EscrowAuthorization_2_list struct {
+	list *[]string
+}
+
+func (x *_EscrowAuthorization_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EscrowAuthorization_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EscrowAuthorization_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EscrowAuthorization_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EscrowAuthorization_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EscrowAuthorization at list field Agents as it is not of Message kind"))
+}
+
+func (x *_EscrowAuthorization_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EscrowAuthorization_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EscrowAuthorization_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EscrowAuthorization_3_list)(nil)
+
+type _EscrowAuthorization_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EscrowAuthorization_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EscrowAuthorization_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EscrowAuthorization_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EscrowAuthorization_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EscrowAuthorization_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EscrowAuthorization_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EscrowAuthorization_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EscrowAuthorization_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EscrowAuthorization_4_list)(nil)
+
+type _EscrowAuthorization_4_list struct {
+	list *[]string
+}
+
+func (x *_EscrowAuthorization_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EscrowAuthorization_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EscrowAuthorization_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EscrowAuthorization_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EscrowAuthorization_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EscrowAuthorization at list field AllowedActionTypes as it is not of Message kind"))
+}
+
+func (x *_EscrowAuthorization_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EscrowAuthorization_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EscrowAuthorization_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EscrowAuthorization_6_list)(nil)
+
+type _EscrowAuthorization_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EscrowAuthorization_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EscrowAuthorization_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EscrowAuthorization_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EscrowAuthorization_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EscrowAuthorization_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EscrowAuthorization_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EscrowAuthorization_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EscrowAuthorization_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EscrowAuthorization                      protoreflect.MessageDescriptor
+	fd_EscrowAuthorization_msg_type_url         protoreflect.FieldDescriptor
+	fd_EscrowAuthorization_agents               protoreflect.FieldDescriptor
+	fd_EscrowAuthorization_spend_limit          protoreflect.FieldDescriptor
+	fd_EscrowAuthorization_allowed_action_types protoreflect.FieldDescriptor
+	fd_EscrowAuthorization_uses_left            protoreflect.FieldDescriptor
+	fd_EscrowAuthorization_charge_per_use       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_authz_proto_init()
+	md_EscrowAuthorization = File_andromeda_escrow_v1alpha1_authz_proto.Messages().ByName("EscrowAuthorization")
+	fd_EscrowAuthorization_msg_type_url = md_EscrowAuthorization.Fields().ByName("msg_type_url")
+	fd_EscrowAuthorization_agents = md_EscrowAuthorization.Fields().ByName("agents")
+	fd_EscrowAuthorization_spend_limit = md_EscrowAuthorization.Fields().ByName("spend_limit")
+	fd_EscrowAuthorization_allowed_action_types = md_EscrowAuthorization.Fields().ByName("allowed_action_types")
+	fd_EscrowAuthorization_uses_left = md_EscrowAuthorization.Fields().ByName("uses_left")
+	fd_EscrowAuthorization_charge_per_use = md_EscrowAuthorization.Fields().ByName("charge_per_use")
+}
+
+var _ protoreflect.Message = (*fastReflection_EscrowAuthorization)(nil)
+
+type fastReflection_EscrowAuthorization EscrowAuthorization
+
+func (x *EscrowAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EscrowAuthorization)(x)
+}
+
+func (x *EscrowAuthorization) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_authz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EscrowAuthorization_messageType fastReflection_EscrowAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_EscrowAuthorization_messageType{}
+
+type fastReflection_EscrowAuthorization_messageType struct{}
+
+func (x fastReflection_EscrowAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EscrowAuthorization)(nil)
+}
+func (x fastReflection_EscrowAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_EscrowAuthorization)
+}
+func (x fastReflection_EscrowAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EscrowAuthorization
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EscrowAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_EscrowAuthorization
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EscrowAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_EscrowAuthorization_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EscrowAuthorization) New() protoreflect.Message {
+	return new(fastReflection_EscrowAuthorization)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EscrowAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*EscrowAuthorization)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EscrowAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_EscrowAuthorization_msg_type_url, value) {
+			return
+		}
+	}
+	if len(x.Agents) != 0 {
+		value := protoreflect.ValueOfList(&_EscrowAuthorization_2_list{list: &x.Agents})
+		if !f(fd_EscrowAuthorization_agents, value) {
+			return
+		}
+	}
+	if len(x.SpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_EscrowAuthorization_3_list{list: &x.SpendLimit})
+		if !f(fd_EscrowAuthorization_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.AllowedActionTypes) != 0 {
+		value := protoreflect.ValueOfList(&_EscrowAuthorization_4_list{list: &x.AllowedActionTypes})
+		if !f(fd_EscrowAuthorization_allowed_action_types, value) {
+			return
+		}
+	}
+	if x.UsesLeft != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UsesLeft)
+		if !f(fd_EscrowAuthorization_uses_left, value) {
+			return
+		}
+	}
+	if len(x.ChargePerUse) != 0 {
+		value := protoreflect.ValueOfList(&_EscrowAuthorization_6_list{list: &x.ChargePerUse})
+		if !f(fd_EscrowAuthorization_charge_per_use, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EscrowAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.agents":
+		return len(x.Agents) != 0
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.spend_limit":
+		return len(x.SpendLimit) != 0
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.allowed_action_types":
+		return len(x.AllowedActionTypes) != 0
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.uses_left":
+		return x.UsesLeft != uint64(0)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.charge_per_use":
+		return len(x.ChargePerUse) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EscrowAuthorization"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EscrowAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EscrowAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.agents":
+		x.Agents = nil
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.spend_limit":
+		x.SpendLimit = nil
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.allowed_action_types":
+		x.AllowedActionTypes = nil
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.uses_left":
+		x.UsesLeft = uint64(0)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.charge_per_use":
+		x.ChargePerUse = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EscrowAuthorization"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EscrowAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EscrowAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.agents":
+		if len(x.Agents) == 0 {
+			return protoreflect.ValueOfList(&_EscrowAuthorization_2_list{})
+		}
+		listValue := &_EscrowAuthorization_2_list{list: &x.Agents}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.spend_limit":
+		if len(x.SpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_EscrowAuthorization_3_list{})
+		}
+		listValue := &_EscrowAuthorization_3_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.allowed_action_types":
+		if len(x.AllowedActionTypes) == 0 {
+			return protoreflect.ValueOfList(&_EscrowAuthorization_4_list{})
+		}
+		listValue := &_EscrowAuthorization_4_list{list: &x.AllowedActionTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.uses_left":
+		value := x.UsesLeft
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.charge_per_use":
+		if len(x.ChargePerUse) == 0 {
+			return protoreflect.ValueOfList(&_EscrowAuthorization_6_list{})
+		}
+		listValue := &_EscrowAuthorization_6_list{list: &x.ChargePerUse}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EscrowAuthorization"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EscrowAuthorization does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EscrowAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.agents":
+		lv := value.List()
+		clv := lv.(*_EscrowAuthorization_2_list)
+		x.Agents = *clv.list
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.spend_limit":
+		lv := value.List()
+		clv := lv.(*_EscrowAuthorization_3_list)
+		x.SpendLimit = *clv.list
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.allowed_action_types":
+		lv := value.List()
+		clv := lv.(*_EscrowAuthorization_4_list)
+		x.AllowedActionTypes = *clv.list
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.uses_left":
+		x.UsesLeft = value.Uint()
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.charge_per_use":
+		lv := value.List()
+		clv := lv.(*_EscrowAuthorization_6_list)
+		x.ChargePerUse = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EscrowAuthorization"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EscrowAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EscrowAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.agents":
+		if x.Agents == nil {
+			x.Agents = []string{}
+		}
+		value := &_EscrowAuthorization_2_list{list: &x.Agents}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_EscrowAuthorization_3_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.allowed_action_types":
+		if x.AllowedActionTypes == nil {
+			x.AllowedActionTypes = []string{}
+		}
+		value := &_EscrowAuthorization_4_list{list: &x.AllowedActionTypes}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.charge_per_use":
+		if x.ChargePerUse == nil {
+			x.ChargePerUse = []*v1beta1.Coin{}
+		}
+		value := &_EscrowAuthorization_6_list{list: &x.ChargePerUse}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message andromeda.escrow.v1alpha1.EscrowAuthorization is not mutable"))
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.uses_left":
+		panic(fmt.Errorf("field uses_left of message andromeda.escrow.v1alpha1.EscrowAuthorization is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EscrowAuthorization"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EscrowAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EscrowAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.agents":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EscrowAuthorization_2_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EscrowAuthorization_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.allowed_action_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EscrowAuthorization_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.uses_left":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EscrowAuthorization.charge_per_use":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EscrowAuthorization_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EscrowAuthorization"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EscrowAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EscrowAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EscrowAuthorization", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EscrowAuthorization) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EscrowAuthorization) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EscrowAuthorization) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EscrowAuthorization) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EscrowAuthorization)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Agents) > 0 {
+			for _, s := range x.Agents {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for _, e := range x.SpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedActionTypes) > 0 {
+			for _, s := range x.AllowedActionTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.UsesLeft != 0 {
+			n += 1 + runtime.Sov(uint64(x.UsesLeft))
+		}
+		if len(x.ChargePerUse) > 0 {
+			for _, e := range x.ChargePerUse {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EscrowAuthorization)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChargePerUse) > 0 {
+			for iNdEx := len(x.ChargePerUse) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChargePerUse[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.UsesLeft != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UsesLeft))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.AllowedActionTypes) > 0 {
+			for iNdEx := len(x.AllowedActionTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedActionTypes[iNdEx])
+				copy(dAtA[i:], x.AllowedActionTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedActionTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agents) > 0 {
+			for iNdEx := len(x.Agents) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Agents[iNdEx])
+				copy(dAtA[i:], x.Agents[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agents[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EscrowAuthorization)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EscrowAuthorization: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EscrowAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agents = append(x.Agents, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedActionTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedActionTypes = append(x.AllowedActionTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsesLeft", wireType)
+				}
+				x.UsesLeft = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UsesLeft |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChargePerUse", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChargePerUse = append(x.ChargePerUse, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChargePerUse[len(x.ChargePerUse)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: andromeda/escrow/v1alpha1/authz.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EscrowAuthorization allows the grantee to send Msg/SubmitProposal or
+// Msg/Exec on behalf of the granter through x/authz, within the limits.
+type EscrowAuthorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the type url of the authorized message, either of Msg/SubmitProposal and
+	// Msg/Exec
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// the addresses of the agents the grantee may submit proposals with, or
+	// execute the proposals of
+	// Note: empty means no restriction by agents.
+	Agents []string `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
+	// the remaining value the actions may send from the granter through x/bank
+	// Note: the post_actions of a fillable proposal are counted per unit, times
+	// its quantity.
+	SpendLimit []*v1beta1.Coin `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means no actions allowed. The messages of this module are
+	// never allowed, because their own actions would escape the limits.
+	AllowedActionTypes []string `protobuf:"bytes,4,rep,name=allowed_action_types,json=allowedActionTypes,proto3" json:"allowed_action_types,omitempty"`
+	// the remaining number of the uses
+	// Note: zero means no limit by uses.
+	UsesLeft uint64 `protobuf:"varint,5,opt,name=uses_left,json=usesLeft,proto3" json:"uses_left,omitempty"`
+	// the deposit of Msg/SubmitProposal or the fee of Msg/Exec charged to the
+	// granter, counted against spend_limit per use
+	// Note: it should cover min_proposal_deposit or the exec fee of the params,
+	// which the authorization does not read.
+	ChargePerUse []*v1beta1.Coin `protobuf:"bytes,6,rep,name=charge_per_use,json=chargePerUse,proto3" json:"charge_per_use,omitempty"`
+}
+
+func (x *EscrowAuthorization) Reset() {
+	*x = EscrowAuthorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_authz_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EscrowAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscrowAuthorization) ProtoMessage() {}
+
+// Deprecated: Use EscrowAuthorization.ProtoReflect.Descriptor instead.
+func (*EscrowAuthorization) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EscrowAuthorization) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *EscrowAuthorization) GetAgents() []string {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *EscrowAuthorization) GetSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return nil
+}
+
+func (x *EscrowAuthorization) GetAllowedActionTypes() []string {
+	if x != nil {
+		return x.AllowedActionTypes
+	}
+	return nil
+}
+
+func (x *EscrowAuthorization) GetUsesLeft() uint64 {
+	if x != nil {
+		return x.UsesLeft
+	}
+	return 0
+}
+
+func (x *EscrowAuthorization) GetChargePerUse() []*v1beta1.Coin {
+	if x != nil {
+		return x.ChargePerUse
+	}
+	return nil
+}
+
+var File_andromeda_escrow_v1alpha1_authz_proto protoreflect.FileDescriptor
+
+var file_andromeda_escrow_v1alpha1_authz_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2f, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65,
+	0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x03, 0x0a, 0x13, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6d,
+	0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x30, 0x0a,
+	0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x6c, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a,
+	0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x75, 0x73, 0x65, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x71, 0x0a, 0x0e,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x3a,
+	0x26, 0xca, 0xb4, 0x2d, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xdf, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x7a,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x6e,
+	0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d,
+	0x65, 0x64, 0x61, 0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x41, 0x6e,
+	0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x3a, 0x3a, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x3a,
+	0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_andromeda_escrow_v1alpha1_authz_proto_rawDescOnce sync.Once
+	file_andromeda_escrow_v1alpha1_authz_proto_rawDescData = file_andromeda_escrow_v1alpha1_authz_proto_rawDesc
+)
+
+func file_andromeda_escrow_v1alpha1_authz_proto_rawDescGZIP() []byte {
+	file_andromeda_escrow_v1alpha1_authz_proto_rawDescOnce.Do(func() {
+		file_andromeda_escrow_v1alpha1_authz_proto_rawDescData = protoimpl.X.CompressGZIP(file_andromeda_escrow_v1alpha1_authz_proto_rawDescData)
+	})
+	return file_andromeda_escrow_v1alpha1_authz_proto_rawDescData
+}
+
+var file_andromeda_escrow_v1alpha1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_andromeda_escrow_v1alpha1_authz_proto_goTypes = []interface{}{
+	(*EscrowAuthorization)(nil), // 0: andromeda.escrow.v1alpha1.EscrowAuthorization
+	(*v1beta1.Coin)(nil),        // 1: cosmos.base.v1beta1.Coin
+}
+var file_andromeda_escrow_v1alpha1_authz_proto_depIdxs = []int32{
+	1, // 0: andromeda.escrow.v1alpha1.EscrowAuthorization.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	1, // 1: andromeda.escrow.v1alpha1.EscrowAuthorization.charge_per_use:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_andromeda_escrow_v1alpha1_authz_proto_init() }
+func file_andromeda_escrow_v1alpha1_authz_proto_init() {
+	if File_andromeda_escrow_v1alpha1_authz_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_andromeda_escrow_v1alpha1_authz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EscrowAuthorization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_andromeda_escrow_v1alpha1_authz_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_andromeda_escrow_v1alpha1_authz_proto_goTypes,
+		DependencyIndexes: file_andromeda_escrow_v1alpha1_authz_proto_depIdxs,
+		MessageInfos:      file_andromeda_escrow_v1alpha1_authz_proto_msgTypes,
+	}.Build()
+	File_andromeda_escrow_v1alpha1_authz_proto = out.File
+	file_andromeda_escrow_v1alpha1_authz_proto_rawDesc = nil
+	file_andromeda_escrow_v1alpha1_authz_proto_goTypes = nil
+	file_andromeda_escrow_v1alpha1_authz_proto_depIdxs = nil
+}
@@ -2,22 +2,186 @@
 package escrowv1alpha1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_EventUpdateParams_5_list)(nil)
+
+type _EventUpdateParams_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventUpdateParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventUpdateParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateParams_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventUpdateParams_12_list)(nil)
+
+type _EventUpdateParams_12_list struct {
+	list *[]string
+}
+
+func (x *_EventUpdateParams_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateParams_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventUpdateParams_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateParams_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateParams_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventUpdateParams at list field AllowedMessageTypes as it is not of Message kind"))
+}
+
+func (x *_EventUpdateParams_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateParams_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventUpdateParams_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventUpdateParams_13_list)(nil)
+
+type _EventUpdateParams_13_list struct {
+	list *[]string
+}
+
+func (x *_EventUpdateParams_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateParams_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventUpdateParams_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateParams_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateParams_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventUpdateParams at list field DeniedMessageTypes as it is not of Message kind"))
+}
+
+func (x *_EventUpdateParams_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateParams_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventUpdateParams_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_EventUpdateParams                     protoreflect.MessageDescriptor
-	fd_EventUpdateParams_authority           protoreflect.FieldDescriptor
-	fd_EventUpdateParams_max_metadata_length protoreflect.FieldDescriptor
+	md_EventUpdateParams                       protoreflect.MessageDescriptor
+	fd_EventUpdateParams_authority             protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_metadata_length   protoreflect.FieldDescriptor
+	fd_EventUpdateParams_exec_fee_flat         protoreflect.FieldDescriptor
+	fd_EventUpdateParams_exec_fee_bps          protoreflect.FieldDescriptor
+	fd_EventUpdateParams_min_proposal_deposit  protoreflect.FieldDescriptor
+	fd_EventUpdateParams_burn_expired_deposits protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_pre_actions       protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_post_actions      protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_actions           protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_agents_per_exec   protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_action_size       protoreflect.FieldDescriptor
+	fd_EventUpdateParams_allowed_message_types protoreflect.FieldDescriptor
+	fd_EventUpdateParams_denied_message_types  protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_nesting_depth     protoreflect.FieldDescriptor
+	fd_EventUpdateParams_gas_per_agent         protoreflect.FieldDescriptor
+	fd_EventUpdateParams_gas_per_action        protoreflect.FieldDescriptor
+	fd_EventUpdateParams_gas_per_action_byte   protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_prunes_per_block  protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_refund_actions    protoreflect.FieldDescriptor
+	fd_EventUpdateParams_max_simulate_gas      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +189,24 @@ func init() {
 	md_EventUpdateParams = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventUpdateParams")
 	fd_EventUpdateParams_authority = md_EventUpdateParams.Fields().ByName("authority")
 	fd_EventUpdateParams_max_metadata_length = md_EventUpdateParams.Fields().ByName("max_metadata_length")
+	fd_EventUpdateParams_exec_fee_flat = md_EventUpdateParams.Fields().ByName("exec_fee_flat")
+	fd_EventUpdateParams_exec_fee_bps = md_EventUpdateParams.Fields().ByName("exec_fee_bps")
+	fd_EventUpdateParams_min_proposal_deposit = md_EventUpdateParams.Fields().ByName("min_proposal_deposit")
+	fd_EventUpdateParams_burn_expired_deposits = md_EventUpdateParams.Fields().ByName("burn_expired_deposits")
+	fd_EventUpdateParams_max_pre_actions = md_EventUpdateParams.Fields().ByName("max_pre_actions")
+	fd_EventUpdateParams_max_post_actions = md_EventUpdateParams.Fields().ByName("max_post_actions")
+	fd_EventUpdateParams_max_actions = md_EventUpdateParams.Fields().ByName("max_actions")
+	fd_EventUpdateParams_max_agents_per_exec = md_EventUpdateParams.Fields().ByName("max_agents_per_exec")
+	fd_EventUpdateParams_max_action_size = md_EventUpdateParams.Fields().ByName("max_action_size")
+	fd_EventUpdateParams_allowed_message_types = md_EventUpdateParams.Fields().ByName("allowed_message_types")
+	fd_EventUpdateParams_denied_message_types = md_EventUpdateParams.Fields().ByName("denied_message_types")
+	fd_EventUpdateParams_max_nesting_depth = md_EventUpdateParams.Fields().ByName("max_nesting_depth")
+	fd_EventUpdateParams_gas_per_agent = md_EventUpdateParams.Fields().ByName("gas_per_agent")
+	fd_EventUpdateParams_gas_per_action = md_EventUpdateParams.Fields().ByName("gas_per_action")
+	fd_EventUpdateParams_gas_per_action_byte = md_EventUpdateParams.Fields().ByName("gas_per_action_byte")
+	fd_EventUpdateParams_max_prunes_per_block = md_EventUpdateParams.Fields().ByName("max_prunes_per_block")
+	fd_EventUpdateParams_max_refund_actions = md_EventUpdateParams.Fields().ByName("max_refund_actions")
+	fd_EventUpdateParams_max_simulate_gas = md_EventUpdateParams.Fields().ByName("max_simulate_gas")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventUpdateParams)(nil)
@@ -104,6 +286,114 @@ func (x *fastReflection_EventUpdateParams) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.ExecFeeFlat != nil {
+		value := protoreflect.ValueOfMessage(x.ExecFeeFlat.ProtoReflect())
+		if !f(fd_EventUpdateParams_exec_fee_flat, value) {
+			return
+		}
+	}
+	if x.ExecFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExecFeeBps)
+		if !f(fd_EventUpdateParams_exec_fee_bps, value) {
+			return
+		}
+	}
+	if len(x.MinProposalDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateParams_5_list{list: &x.MinProposalDeposit})
+		if !f(fd_EventUpdateParams_min_proposal_deposit, value) {
+			return
+		}
+	}
+	if x.BurnExpiredDeposits != false {
+		value := protoreflect.ValueOfBool(x.BurnExpiredDeposits)
+		if !f(fd_EventUpdateParams_burn_expired_deposits, value) {
+			return
+		}
+	}
+	if x.MaxPreActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPreActions)
+		if !f(fd_EventUpdateParams_max_pre_actions, value) {
+			return
+		}
+	}
+	if x.MaxPostActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPostActions)
+		if !f(fd_EventUpdateParams_max_post_actions, value) {
+			return
+		}
+	}
+	if x.MaxActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActions)
+		if !f(fd_EventUpdateParams_max_actions, value) {
+			return
+		}
+	}
+	if x.MaxAgentsPerExec != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAgentsPerExec)
+		if !f(fd_EventUpdateParams_max_agents_per_exec, value) {
+			return
+		}
+	}
+	if x.MaxActionSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActionSize)
+		if !f(fd_EventUpdateParams_max_action_size, value) {
+			return
+		}
+	}
+	if len(x.AllowedMessageTypes) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateParams_12_list{list: &x.AllowedMessageTypes})
+		if !f(fd_EventUpdateParams_allowed_message_types, value) {
+			return
+		}
+	}
+	if len(x.DeniedMessageTypes) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateParams_13_list{list: &x.DeniedMessageTypes})
+		if !f(fd_EventUpdateParams_denied_message_types, value) {
+			return
+		}
+	}
+	if x.MaxNestingDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxNestingDepth)
+		if !f(fd_EventUpdateParams_max_nesting_depth, value) {
+			return
+		}
+	}
+	if x.GasPerAgent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerAgent)
+		if !f(fd_EventUpdateParams_gas_per_agent, value) {
+			return
+		}
+	}
+	if x.GasPerAction != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerAction)
+		if !f(fd_EventUpdateParams_gas_per_action, value) {
+			return
+		}
+	}
+	if x.GasPerActionByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerActionByte)
+		if !f(fd_EventUpdateParams_gas_per_action_byte, value) {
+			return
+		}
+	}
+	if x.MaxPrunesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunesPerBlock)
+		if !f(fd_EventUpdateParams_max_prunes_per_block, value) {
+			return
+		}
+	}
+	if x.MaxRefundActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRefundActions)
+		if !f(fd_EventUpdateParams_max_refund_actions, value) {
+			return
+		}
+	}
+	if x.MaxSimulateGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSimulateGas)
+		if !f(fd_EventUpdateParams_max_simulate_gas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -123,6 +413,42 @@ func (x *fastReflection_EventUpdateParams) Has(fd protoreflect.FieldDescriptor)
 		return x.Authority != ""
 	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_metadata_length":
 		return x.MaxMetadataLength != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_flat":
+		return x.ExecFeeFlat != nil
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_bps":
+		return x.ExecFeeBps != uint32(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.min_proposal_deposit":
+		return len(x.MinProposalDeposit) != 0
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.burn_expired_deposits":
+		return x.BurnExpiredDeposits != false
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_pre_actions":
+		return x.MaxPreActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_post_actions":
+		return x.MaxPostActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_actions":
+		return x.MaxActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_agents_per_exec":
+		return x.MaxAgentsPerExec != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_action_size":
+		return x.MaxActionSize != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.allowed_message_types":
+		return len(x.AllowedMessageTypes) != 0
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.denied_message_types":
+		return len(x.DeniedMessageTypes) != 0
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_nesting_depth":
+		return x.MaxNestingDepth != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_agent":
+		return x.GasPerAgent != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action":
+		return x.GasPerAction != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action_byte":
+		return x.GasPerActionByte != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_prunes_per_block":
+		return x.MaxPrunesPerBlock != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_refund_actions":
+		return x.MaxRefundActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_simulate_gas":
+		return x.MaxSimulateGas != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateParams"))
@@ -143,6 +469,42 @@ func (x *fastReflection_EventUpdateParams) Clear(fd protoreflect.FieldDescriptor
 		x.Authority = ""
 	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_metadata_length":
 		x.MaxMetadataLength = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_flat":
+		x.ExecFeeFlat = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_bps":
+		x.ExecFeeBps = uint32(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.min_proposal_deposit":
+		x.MinProposalDeposit = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.burn_expired_deposits":
+		x.BurnExpiredDeposits = false
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_pre_actions":
+		x.MaxPreActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_post_actions":
+		x.MaxPostActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_actions":
+		x.MaxActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_agents_per_exec":
+		x.MaxAgentsPerExec = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_action_size":
+		x.MaxActionSize = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.allowed_message_types":
+		x.AllowedMessageTypes = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.denied_message_types":
+		x.DeniedMessageTypes = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_nesting_depth":
+		x.MaxNestingDepth = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_agent":
+		x.GasPerAgent = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action":
+		x.GasPerAction = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action_byte":
+		x.GasPerActionByte = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_prunes_per_block":
+		x.MaxPrunesPerBlock = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_refund_actions":
+		x.MaxRefundActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_simulate_gas":
+		x.MaxSimulateGas = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateParams"))
@@ -165,6 +527,69 @@ func (x *fastReflection_EventUpdateParams) Get(descriptor protoreflect.FieldDesc
 	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_metadata_length":
 		value := x.MaxMetadataLength
 		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_flat":
+		value := x.ExecFeeFlat
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_bps":
+		value := x.ExecFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.min_proposal_deposit":
+		if len(x.MinProposalDeposit) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateParams_5_list{})
+		}
+		listValue := &_EventUpdateParams_5_list{list: &x.MinProposalDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.burn_expired_deposits":
+		value := x.BurnExpiredDeposits
+		return protoreflect.ValueOfBool(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_pre_actions":
+		value := x.MaxPreActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_post_actions":
+		value := x.MaxPostActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_actions":
+		value := x.MaxActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_agents_per_exec":
+		value := x.MaxAgentsPerExec
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_action_size":
+		value := x.MaxActionSize
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.allowed_message_types":
+		if len(x.AllowedMessageTypes) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateParams_12_list{})
+		}
+		listValue := &_EventUpdateParams_12_list{list: &x.AllowedMessageTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.denied_message_types":
+		if len(x.DeniedMessageTypes) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateParams_13_list{})
+		}
+		listValue := &_EventUpdateParams_13_list{list: &x.DeniedMessageTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_nesting_depth":
+		value := x.MaxNestingDepth
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_agent":
+		value := x.GasPerAgent
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action":
+		value := x.GasPerAction
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action_byte":
+		value := x.GasPerActionByte
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_prunes_per_block":
+		value := x.MaxPrunesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_refund_actions":
+		value := x.MaxRefundActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_simulate_gas":
+		value := x.MaxSimulateGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateParams"))
@@ -189,6 +614,48 @@ func (x *fastReflection_EventUpdateParams) Set(fd protoreflect.FieldDescriptor,
 		x.Authority = value.Interface().(string)
 	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_metadata_length":
 		x.MaxMetadataLength = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_flat":
+		x.ExecFeeFlat = value.Message().Interface().(*v1beta1.Coin)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_bps":
+		x.ExecFeeBps = uint32(value.Uint())
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.min_proposal_deposit":
+		lv := value.List()
+		clv := lv.(*_EventUpdateParams_5_list)
+		x.MinProposalDeposit = *clv.list
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.burn_expired_deposits":
+		x.BurnExpiredDeposits = value.Bool()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_pre_actions":
+		x.MaxPreActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_post_actions":
+		x.MaxPostActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_actions":
+		x.MaxActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_agents_per_exec":
+		x.MaxAgentsPerExec = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_action_size":
+		x.MaxActionSize = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.allowed_message_types":
+		lv := value.List()
+		clv := lv.(*_EventUpdateParams_12_list)
+		x.AllowedMessageTypes = *clv.list
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.denied_message_types":
+		lv := value.List()
+		clv := lv.(*_EventUpdateParams_13_list)
+		x.DeniedMessageTypes = *clv.list
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_nesting_depth":
+		x.MaxNestingDepth = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_agent":
+		x.GasPerAgent = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action":
+		x.GasPerAction = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action_byte":
+		x.GasPerActionByte = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_prunes_per_block":
+		x.MaxPrunesPerBlock = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_refund_actions":
+		x.MaxRefundActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_simulate_gas":
+		x.MaxSimulateGas = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateParams"))
@@ -209,10 +676,61 @@ func (x *fastReflection_EventUpdateParams) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_EventUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_flat":
+		if x.ExecFeeFlat == nil {
+			x.ExecFeeFlat = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ExecFeeFlat.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.min_proposal_deposit":
+		if x.MinProposalDeposit == nil {
+			x.MinProposalDeposit = []*v1beta1.Coin{}
+		}
+		value := &_EventUpdateParams_5_list{list: &x.MinProposalDeposit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.allowed_message_types":
+		if x.AllowedMessageTypes == nil {
+			x.AllowedMessageTypes = []string{}
+		}
+		value := &_EventUpdateParams_12_list{list: &x.AllowedMessageTypes}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.denied_message_types":
+		if x.DeniedMessageTypes == nil {
+			x.DeniedMessageTypes = []string{}
+		}
+		value := &_EventUpdateParams_13_list{list: &x.DeniedMessageTypes}
+		return protoreflect.ValueOfList(value)
 	case "andromeda.escrow.v1alpha1.EventUpdateParams.authority":
 		panic(fmt.Errorf("field authority of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
 	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_metadata_length":
 		panic(fmt.Errorf("field max_metadata_length of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_bps":
+		panic(fmt.Errorf("field exec_fee_bps of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.burn_expired_deposits":
+		panic(fmt.Errorf("field burn_expired_deposits of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_pre_actions":
+		panic(fmt.Errorf("field max_pre_actions of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_post_actions":
+		panic(fmt.Errorf("field max_post_actions of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_actions":
+		panic(fmt.Errorf("field max_actions of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_agents_per_exec":
+		panic(fmt.Errorf("field max_agents_per_exec of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_action_size":
+		panic(fmt.Errorf("field max_action_size of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_nesting_depth":
+		panic(fmt.Errorf("field max_nesting_depth of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_agent":
+		panic(fmt.Errorf("field gas_per_agent of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action":
+		panic(fmt.Errorf("field gas_per_action of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action_byte":
+		panic(fmt.Errorf("field gas_per_action_byte of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_prunes_per_block":
+		panic(fmt.Errorf("field max_prunes_per_block of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_refund_actions":
+		panic(fmt.Errorf("field max_refund_actions of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_simulate_gas":
+		panic(fmt.Errorf("field max_simulate_gas of message andromeda.escrow.v1alpha1.EventUpdateParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateParams"))
@@ -230,6 +748,46 @@ func (x *fastReflection_EventUpdateParams) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfString("")
 	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_metadata_length":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_flat":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.min_proposal_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventUpdateParams_5_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.burn_expired_deposits":
+		return protoreflect.ValueOfBool(false)
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_pre_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_post_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_agents_per_exec":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_action_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.allowed_message_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventUpdateParams_12_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.denied_message_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventUpdateParams_13_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_nesting_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_agent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.gas_per_action_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_prunes_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_refund_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventUpdateParams.max_simulate_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateParams"))
@@ -306,6 +864,70 @@ func (x *fastReflection_EventUpdateParams) ProtoMethods() *protoiface.Methods {
 		if x.MaxMetadataLength != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxMetadataLength))
 		}
+		if x.ExecFeeFlat != nil {
+			l = options.Size(x.ExecFeeFlat)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecFeeBps))
+		}
+		if len(x.MinProposalDeposit) > 0 {
+			for _, e := range x.MinProposalDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BurnExpiredDeposits {
+			n += 2
+		}
+		if x.MaxPreActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPreActions))
+		}
+		if x.MaxPostActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPostActions))
+		}
+		if x.MaxActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActions))
+		}
+		if x.MaxAgentsPerExec != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAgentsPerExec))
+		}
+		if x.MaxActionSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActionSize))
+		}
+		if len(x.AllowedMessageTypes) > 0 {
+			for _, s := range x.AllowedMessageTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedMessageTypes) > 0 {
+			for _, s := range x.DeniedMessageTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxNestingDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNestingDepth))
+		}
+		if x.GasPerAgent != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerAgent))
+		}
+		if x.GasPerAction != 0 {
+			n += 2 + runtime.Sov(uint64(x.GasPerAction))
+		}
+		if x.GasPerActionByte != 0 {
+			n += 2 + runtime.Sov(uint64(x.GasPerActionByte))
+		}
+		if x.MaxPrunesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPrunesPerBlock))
+		}
+		if x.MaxRefundActions != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRefundActions))
+		}
+		if x.MaxSimulateGas != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxSimulateGas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -335,33 +957,166 @@ func (x *fastReflection_EventUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxMetadataLength != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataLength))
+		if x.MaxSimulateGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSimulateGas))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if x.MaxRefundActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRefundActions))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.MaxPrunesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunesPerBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateParams)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+		if x.GasPerActionByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerActionByte))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.GasPerAction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerAction))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.GasPerAgent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerAgent))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxNestingDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNestingDepth))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.DeniedMessageTypes) > 0 {
+			for iNdEx := len(x.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedMessageTypes[iNdEx])
+				copy(dAtA[i:], x.DeniedMessageTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedMessageTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.AllowedMessageTypes) > 0 {
+			for iNdEx := len(x.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedMessageTypes[iNdEx])
+				copy(dAtA[i:], x.AllowedMessageTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedMessageTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.MaxActionSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActionSize))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxAgentsPerExec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAgentsPerExec))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActions))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxPostActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPostActions))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxPreActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPreActions))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BurnExpiredDeposits {
+			i--
+			if x.BurnExpiredDeposits {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.MinProposalDeposit) > 0 {
+			for iNdEx := len(x.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinProposalDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.ExecFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecFeeBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ExecFeeFlat != nil {
+			encoded, err := options.Marshal(x.ExecFeeFlat)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxMetadataLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataLength))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Flags:             input.Flags,
 			}, nil
 		}
@@ -447,128 +1202,5725 @@ func (x *fastReflection_EventUpdateParams) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecFeeFlat == nil {
+					x.ExecFeeFlat = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecFeeFlat); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
+				}
+				x.ExecFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MinProposalDeposit = append(x.MinProposalDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinProposalDeposit[len(x.MinProposalDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_EventCreateAgent         protoreflect.MessageDescriptor
-	fd_EventCreateAgent_agent   protoreflect.FieldDescriptor
-	fd_EventCreateAgent_creator protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_andromeda_escrow_v1alpha1_event_proto_init()
-	md_EventCreateAgent = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventCreateAgent")
-	fd_EventCreateAgent_agent = md_EventCreateAgent.Fields().ByName("agent")
-	fd_EventCreateAgent_creator = md_EventCreateAgent.Fields().ByName("creator")
-}
-
-var _ protoreflect.Message = (*fastReflection_EventCreateAgent)(nil)
-
-type fastReflection_EventCreateAgent EventCreateAgent
-
-func (x *EventCreateAgent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventCreateAgent)(x)
-}
-
-func (x *EventCreateAgent) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_EventCreateAgent_messageType fastReflection_EventCreateAgent_messageType
-var _ protoreflect.MessageType = fastReflection_EventCreateAgent_messageType{}
-
-type fastReflection_EventCreateAgent_messageType struct{}
-
-func (x fastReflection_EventCreateAgent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventCreateAgent)(nil)
-}
-func (x fastReflection_EventCreateAgent_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventCreateAgent)
-}
-func (x fastReflection_EventCreateAgent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCreateAgent
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_EventCreateAgent) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCreateAgent
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventCreateAgent) Type() protoreflect.MessageType {
-	return _fastReflection_EventCreateAgent_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventCreateAgent) New() protoreflect.Message {
-	return new(fastReflection_EventCreateAgent)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventCreateAgent) Interface() protoreflect.ProtoMessage {
-	return (*EventCreateAgent)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_EventCreateAgent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Agent != "" {
-		value := protoreflect.ValueOfString(x.Agent)
-		if !f(fd_EventCreateAgent_agent, value) {
-			return
-		}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnExpiredDeposits = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
+				}
+				x.MaxPreActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPreActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
+				}
+				x.MaxPostActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPostActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
+				}
+				x.MaxActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+				}
+				x.MaxAgentsPerExec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+				}
+				x.MaxActionSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActionSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedMessageTypes = append(x.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedMessageTypes = append(x.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+				}
+				x.MaxNestingDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNestingDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+				}
+				x.GasPerAgent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerAgent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+				}
+				x.GasPerAction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerAction |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+				}
+				x.GasPerActionByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerActionByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+				}
+				x.MaxPrunesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+				}
+				x.MaxRefundActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRefundActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
+				}
+				x.MaxSimulateGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSimulateGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventCreateAgent         protoreflect.MessageDescriptor
+	fd_EventCreateAgent_agent   protoreflect.FieldDescriptor
+	fd_EventCreateAgent_creator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_event_proto_init()
+	md_EventCreateAgent = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventCreateAgent")
+	fd_EventCreateAgent_agent = md_EventCreateAgent.Fields().ByName("agent")
+	fd_EventCreateAgent_creator = md_EventCreateAgent.Fields().ByName("creator")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventCreateAgent)(nil)
+
+type fastReflection_EventCreateAgent EventCreateAgent
+
+func (x *EventCreateAgent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCreateAgent)(x)
+}
+
+func (x *EventCreateAgent) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventCreateAgent_messageType fastReflection_EventCreateAgent_messageType
+var _ protoreflect.MessageType = fastReflection_EventCreateAgent_messageType{}
+
+type fastReflection_EventCreateAgent_messageType struct{}
+
+func (x fastReflection_EventCreateAgent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCreateAgent)(nil)
+}
+func (x fastReflection_EventCreateAgent_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCreateAgent)
+}
+func (x fastReflection_EventCreateAgent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCreateAgent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventCreateAgent) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCreateAgent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventCreateAgent) Type() protoreflect.MessageType {
+	return _fastReflection_EventCreateAgent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventCreateAgent) New() protoreflect.Message {
+	return new(fastReflection_EventCreateAgent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventCreateAgent) Interface() protoreflect.ProtoMessage {
+	return (*EventCreateAgent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventCreateAgent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_EventCreateAgent_agent, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EventCreateAgent_creator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventCreateAgent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
+		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
+		return x.Creator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateAgent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
+		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
+		x.Creator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventCreateAgent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateAgent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
+		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
+		x.Creator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateAgent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventCreateAgent is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
+		panic(fmt.Errorf("field creator of message andromeda.escrow.v1alpha1.EventCreateAgent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventCreateAgent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventCreateAgent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventCreateAgent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventCreateAgent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateAgent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventCreateAgent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventCreateAgent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventCreateAgent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventCreateAgent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAgent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventSubmitProposal_3_list)(nil)
+
+type _EventSubmitProposal_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventSubmitProposal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventSubmitProposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventSubmitProposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventSubmitProposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventSubmitProposal_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventSubmitProposal_4_list)(nil)
+
+type _EventSubmitProposal_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventSubmitProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventSubmitProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventSubmitProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventSubmitProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventSubmitProposal_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventSubmitProposal_6_list)(nil)
+
+type _EventSubmitProposal_6_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventSubmitProposal_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventSubmitProposal_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventSubmitProposal_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventSubmitProposal_6_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventSubmitProposal_6_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventSubmitProposal_10_list)(nil)
+
+type _EventSubmitProposal_10_list struct {
+	list *[]string
+}
+
+func (x *_EventSubmitProposal_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventSubmitProposal_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventSubmitProposal_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventSubmitProposal_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventSubmitProposal_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventSubmitProposal at list field Executors as it is not of Message kind"))
+}
+
+func (x *_EventSubmitProposal_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventSubmitProposal_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventSubmitProposal_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventSubmitProposal_12_list)(nil)
+
+type _EventSubmitProposal_12_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventSubmitProposal_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventSubmitProposal_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventSubmitProposal_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventSubmitProposal_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventSubmitProposal_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventSubmitProposal_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventSubmitProposal                   protoreflect.MessageDescriptor
+	fd_EventSubmitProposal_proposer          protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_agent             protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_pre_actions       protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_post_actions      protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_metadata          protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_refund_actions    protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_expire_height     protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_expire_time       protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_quantity          protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_executors         protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_executor_group_id protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_deposit           protoreflect.FieldDescriptor
+	fd_EventSubmitProposal_max_exec_gas      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_event_proto_init()
+	md_EventSubmitProposal = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventSubmitProposal")
+	fd_EventSubmitProposal_proposer = md_EventSubmitProposal.Fields().ByName("proposer")
+	fd_EventSubmitProposal_agent = md_EventSubmitProposal.Fields().ByName("agent")
+	fd_EventSubmitProposal_pre_actions = md_EventSubmitProposal.Fields().ByName("pre_actions")
+	fd_EventSubmitProposal_post_actions = md_EventSubmitProposal.Fields().ByName("post_actions")
+	fd_EventSubmitProposal_metadata = md_EventSubmitProposal.Fields().ByName("metadata")
+	fd_EventSubmitProposal_refund_actions = md_EventSubmitProposal.Fields().ByName("refund_actions")
+	fd_EventSubmitProposal_expire_height = md_EventSubmitProposal.Fields().ByName("expire_height")
+	fd_EventSubmitProposal_expire_time = md_EventSubmitProposal.Fields().ByName("expire_time")
+	fd_EventSubmitProposal_quantity = md_EventSubmitProposal.Fields().ByName("quantity")
+	fd_EventSubmitProposal_executors = md_EventSubmitProposal.Fields().ByName("executors")
+	fd_EventSubmitProposal_executor_group_id = md_EventSubmitProposal.Fields().ByName("executor_group_id")
+	fd_EventSubmitProposal_deposit = md_EventSubmitProposal.Fields().ByName("deposit")
+	fd_EventSubmitProposal_max_exec_gas = md_EventSubmitProposal.Fields().ByName("max_exec_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSubmitProposal)(nil)
+
+type fastReflection_EventSubmitProposal EventSubmitProposal
+
+func (x *EventSubmitProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSubmitProposal)(x)
+}
+
+func (x *EventSubmitProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSubmitProposal_messageType fastReflection_EventSubmitProposal_messageType
+var _ protoreflect.MessageType = fastReflection_EventSubmitProposal_messageType{}
+
+type fastReflection_EventSubmitProposal_messageType struct{}
+
+func (x fastReflection_EventSubmitProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSubmitProposal)(nil)
+}
+func (x fastReflection_EventSubmitProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSubmitProposal)
+}
+func (x fastReflection_EventSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSubmitProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSubmitProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSubmitProposal) Type() protoreflect.MessageType {
+	return _fastReflection_EventSubmitProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSubmitProposal) New() protoreflect.Message {
+	return new(fastReflection_EventSubmitProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSubmitProposal) Interface() protoreflect.ProtoMessage {
+	return (*EventSubmitProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_EventSubmitProposal_proposer, value) {
+			return
+		}
+	}
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_EventSubmitProposal_agent, value) {
+			return
+		}
+	}
+	if len(x.PreActions) != 0 {
+		value := protoreflect.ValueOfList(&_EventSubmitProposal_3_list{list: &x.PreActions})
+		if !f(fd_EventSubmitProposal_pre_actions, value) {
+			return
+		}
+	}
+	if len(x.PostActions) != 0 {
+		value := protoreflect.ValueOfList(&_EventSubmitProposal_4_list{list: &x.PostActions})
+		if !f(fd_EventSubmitProposal_post_actions, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_EventSubmitProposal_metadata, value) {
+			return
+		}
+	}
+	if len(x.RefundActions) != 0 {
+		value := protoreflect.ValueOfList(&_EventSubmitProposal_6_list{list: &x.RefundActions})
+		if !f(fd_EventSubmitProposal_refund_actions, value) {
+			return
+		}
+	}
+	if x.ExpireHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpireHeight)
+		if !f(fd_EventSubmitProposal_expire_height, value) {
+			return
+		}
+	}
+	if x.ExpireTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpireTime.ProtoReflect())
+		if !f(fd_EventSubmitProposal_expire_time, value) {
+			return
+		}
+	}
+	if x.Quantity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Quantity)
+		if !f(fd_EventSubmitProposal_quantity, value) {
+			return
+		}
+	}
+	if len(x.Executors) != 0 {
+		value := protoreflect.ValueOfList(&_EventSubmitProposal_10_list{list: &x.Executors})
+		if !f(fd_EventSubmitProposal_executors, value) {
+			return
+		}
+	}
+	if x.ExecutorGroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutorGroupId)
+		if !f(fd_EventSubmitProposal_executor_group_id, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_EventSubmitProposal_12_list{list: &x.Deposit})
+		if !f(fd_EventSubmitProposal_deposit, value) {
+			return
+		}
+	}
+	if x.MaxExecGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxExecGas)
+		if !f(fd_EventSubmitProposal_max_exec_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+		return x.Proposer != ""
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
+		return len(x.PreActions) != 0
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+		return len(x.PostActions) != 0
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
+		return x.Metadata != ""
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.refund_actions":
+		return len(x.RefundActions) != 0
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_height":
+		return x.ExpireHeight != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_time":
+		return x.ExpireTime != nil
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.quantity":
+		return x.Quantity != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executors":
+		return len(x.Executors) != 0
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executor_group_id":
+		return x.ExecutorGroupId != uint64(0)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.deposit":
+		return len(x.Deposit) != 0
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.max_exec_gas":
+		return x.MaxExecGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+		x.Proposer = ""
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
+		x.PreActions = nil
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+		x.PostActions = nil
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
+		x.Metadata = ""
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.refund_actions":
+		x.RefundActions = nil
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_height":
+		x.ExpireHeight = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_time":
+		x.ExpireTime = nil
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.quantity":
+		x.Quantity = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executors":
+		x.Executors = nil
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executor_group_id":
+		x.ExecutorGroupId = uint64(0)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.deposit":
+		x.Deposit = nil
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.max_exec_gas":
+		x.MaxExecGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
+		if len(x.PreActions) == 0 {
+			return protoreflect.ValueOfList(&_EventSubmitProposal_3_list{})
+		}
+		listValue := &_EventSubmitProposal_3_list{list: &x.PreActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+		if len(x.PostActions) == 0 {
+			return protoreflect.ValueOfList(&_EventSubmitProposal_4_list{})
+		}
+		listValue := &_EventSubmitProposal_4_list{list: &x.PostActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.refund_actions":
+		if len(x.RefundActions) == 0 {
+			return protoreflect.ValueOfList(&_EventSubmitProposal_6_list{})
+		}
+		listValue := &_EventSubmitProposal_6_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_height":
+		value := x.ExpireHeight
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_time":
+		value := x.ExpireTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executors":
+		if len(x.Executors) == 0 {
+			return protoreflect.ValueOfList(&_EventSubmitProposal_10_list{})
+		}
+		listValue := &_EventSubmitProposal_10_list{list: &x.Executors}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executor_group_id":
+		value := x.ExecutorGroupId
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_EventSubmitProposal_12_list{})
+		}
+		listValue := &_EventSubmitProposal_12_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.max_exec_gas":
+		value := x.MaxExecGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
+		lv := value.List()
+		clv := lv.(*_EventSubmitProposal_3_list)
+		x.PreActions = *clv.list
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+		lv := value.List()
+		clv := lv.(*_EventSubmitProposal_4_list)
+		x.PostActions = *clv.list
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.refund_actions":
+		lv := value.List()
+		clv := lv.(*_EventSubmitProposal_6_list)
+		x.RefundActions = *clv.list
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_height":
+		x.ExpireHeight = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_time":
+		x.ExpireTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.quantity":
+		x.Quantity = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executors":
+		lv := value.List()
+		clv := lv.(*_EventSubmitProposal_10_list)
+		x.Executors = *clv.list
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executor_group_id":
+		x.ExecutorGroupId = value.Uint()
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.deposit":
+		lv := value.List()
+		clv := lv.(*_EventSubmitProposal_12_list)
+		x.Deposit = *clv.list
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.max_exec_gas":
+		x.MaxExecGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
+		if x.PreActions == nil {
+			x.PreActions = []*anypb.Any{}
+		}
+		value := &_EventSubmitProposal_3_list{list: &x.PreActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+		if x.PostActions == nil {
+			x.PostActions = []*anypb.Any{}
+		}
+		value := &_EventSubmitProposal_4_list{list: &x.PostActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.refund_actions":
+		if x.RefundActions == nil {
+			x.RefundActions = []*anypb.Any{}
+		}
+		value := &_EventSubmitProposal_6_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_time":
+		if x.ExpireTime == nil {
+			x.ExpireTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpireTime.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executors":
+		if x.Executors == nil {
+			x.Executors = []string{}
+		}
+		value := &_EventSubmitProposal_10_list{list: &x.Executors}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_EventSubmitProposal_12_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
+		panic(fmt.Errorf("field metadata of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_height":
+		panic(fmt.Errorf("field expire_height of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.quantity":
+		panic(fmt.Errorf("field quantity of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executor_group_id":
+		panic(fmt.Errorf("field executor_group_id of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.max_exec_gas":
+		panic(fmt.Errorf("field max_exec_gas of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventSubmitProposal_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventSubmitProposal_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.refund_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventSubmitProposal_6_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.expire_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.quantity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventSubmitProposal_10_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.executor_group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventSubmitProposal_12_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventSubmitProposal.max_exec_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventSubmitProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSubmitProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSubmitProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSubmitProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PreActions) > 0 {
+			for _, e := range x.PreActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PostActions) > 0 {
+			for _, e := range x.PostActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefundActions) > 0 {
+			for _, e := range x.RefundActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpireHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpireHeight))
+		}
+		if x.ExpireTime != nil {
+			l = options.Size(x.ExpireTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Quantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quantity))
+		}
+		if len(x.Executors) > 0 {
+			for _, s := range x.Executors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExecutorGroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorGroupId))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxExecGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxExecGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSubmitProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxExecGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExecGas))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.ExecutorGroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorGroupId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Executors) > 0 {
+			for iNdEx := len(x.Executors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Executors[iNdEx])
+				copy(dAtA[i:], x.Executors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executors[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.Quantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quantity))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ExpireTime != nil {
+			encoded, err := options.Marshal(x.ExpireTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ExpireHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpireHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.RefundActions) > 0 {
+			for iNdEx := len(x.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.PostActions) > 0 {
+			for iNdEx := len(x.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PreActions) > 0 {
+			for iNdEx := len(x.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSubmitProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreActions = append(x.PreActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostActions = append(x.PostActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActions[len(x.PostActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActions = append(x.RefundActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActions[len(x.RefundActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+				}
+				x.ExpireHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpireHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpireTime == nil {
+					x.ExpireTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpireTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				x.Quantity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Quantity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executors = append(x.Executors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+				}
+				x.ExecutorGroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorGroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+				}
+				x.MaxExecGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxExecGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventCancelProposal_3_list)(nil)
+
+type _EventCancelProposal_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventCancelProposal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventCancelProposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventCancelProposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventCancelProposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventCancelProposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventCancelProposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventCancelProposal_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventCancelProposal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventCancelProposal_4_list)(nil)
+
+type _EventCancelProposal_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventCancelProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventCancelProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventCancelProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventCancelProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventCancelProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventCancelProposal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventCancelProposal_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventCancelProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventCancelProposal                protoreflect.MessageDescriptor
+	fd_EventCancelProposal_proposer       protoreflect.FieldDescriptor
+	fd_EventCancelProposal_agent          protoreflect.FieldDescriptor
+	fd_EventCancelProposal_refund_actions protoreflect.FieldDescriptor
+	fd_EventCancelProposal_deposit        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_event_proto_init()
+	md_EventCancelProposal = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventCancelProposal")
+	fd_EventCancelProposal_proposer = md_EventCancelProposal.Fields().ByName("proposer")
+	fd_EventCancelProposal_agent = md_EventCancelProposal.Fields().ByName("agent")
+	fd_EventCancelProposal_refund_actions = md_EventCancelProposal.Fields().ByName("refund_actions")
+	fd_EventCancelProposal_deposit = md_EventCancelProposal.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventCancelProposal)(nil)
+
+type fastReflection_EventCancelProposal EventCancelProposal
+
+func (x *EventCancelProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCancelProposal)(x)
+}
+
+func (x *EventCancelProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventCancelProposal_messageType fastReflection_EventCancelProposal_messageType
+var _ protoreflect.MessageType = fastReflection_EventCancelProposal_messageType{}
+
+type fastReflection_EventCancelProposal_messageType struct{}
+
+func (x fastReflection_EventCancelProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCancelProposal)(nil)
+}
+func (x fastReflection_EventCancelProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCancelProposal)
+}
+func (x fastReflection_EventCancelProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCancelProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventCancelProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCancelProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventCancelProposal) Type() protoreflect.MessageType {
+	return _fastReflection_EventCancelProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventCancelProposal) New() protoreflect.Message {
+	return new(fastReflection_EventCancelProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventCancelProposal) Interface() protoreflect.ProtoMessage {
+	return (*EventCancelProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventCancelProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_EventCancelProposal_proposer, value) {
+			return
+		}
+	}
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_EventCancelProposal_agent, value) {
+			return
+		}
+	}
+	if len(x.RefundActions) != 0 {
+		value := protoreflect.ValueOfList(&_EventCancelProposal_3_list{list: &x.RefundActions})
+		if !f(fd_EventCancelProposal_refund_actions, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_EventCancelProposal_4_list{list: &x.Deposit})
+		if !f(fd_EventCancelProposal_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventCancelProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.proposer":
+		return x.Proposer != ""
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.agent":
+		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.refund_actions":
+		return len(x.RefundActions) != 0
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.deposit":
+		return len(x.Deposit) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCancelProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCancelProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCancelProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.proposer":
+		x.Proposer = ""
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.agent":
+		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.refund_actions":
+		x.RefundActions = nil
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.deposit":
+		x.Deposit = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCancelProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCancelProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventCancelProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.refund_actions":
+		if len(x.RefundActions) == 0 {
+			return protoreflect.ValueOfList(&_EventCancelProposal_3_list{})
+		}
+		listValue := &_EventCancelProposal_3_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_EventCancelProposal_4_list{})
+		}
+		listValue := &_EventCancelProposal_4_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCancelProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCancelProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCancelProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.agent":
+		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.refund_actions":
+		lv := value.List()
+		clv := lv.(*_EventCancelProposal_3_list)
+		x.RefundActions = *clv.list
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.deposit":
+		lv := value.List()
+		clv := lv.(*_EventCancelProposal_4_list)
+		x.Deposit = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCancelProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCancelProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCancelProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.refund_actions":
+		if x.RefundActions == nil {
+			x.RefundActions = []*anypb.Any{}
+		}
+		value := &_EventCancelProposal_3_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_EventCancelProposal_4_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.EventCancelProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventCancelProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCancelProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCancelProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventCancelProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.agent":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.refund_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventCancelProposal_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventCancelProposal.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventCancelProposal_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCancelProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCancelProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventCancelProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventCancelProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventCancelProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCancelProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventCancelProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventCancelProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventCancelProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefundActions) > 0 {
+			for _, e := range x.RefundActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventCancelProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.RefundActions) > 0 {
+			for iNdEx := len(x.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventCancelProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCancelProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActions = append(x.RefundActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActions[len(x.RefundActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventExpireProposal_3_list)(nil)
+
+type _EventExpireProposal_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventExpireProposal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventExpireProposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventExpireProposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventExpireProposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventExpireProposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExpireProposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventExpireProposal_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExpireProposal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventExpireProposal_5_list)(nil)
+
+type _EventExpireProposal_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventExpireProposal_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventExpireProposal_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventExpireProposal_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventExpireProposal_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventExpireProposal_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExpireProposal_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventExpireProposal_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExpireProposal_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventExpireProposal                protoreflect.MessageDescriptor
+	fd_EventExpireProposal_proposer       protoreflect.FieldDescriptor
+	fd_EventExpireProposal_agent          protoreflect.FieldDescriptor
+	fd_EventExpireProposal_refund_actions protoreflect.FieldDescriptor
+	fd_EventExpireProposal_error          protoreflect.FieldDescriptor
+	fd_EventExpireProposal_deposit        protoreflect.FieldDescriptor
+	fd_EventExpireProposal_deposit_burned protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_event_proto_init()
+	md_EventExpireProposal = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventExpireProposal")
+	fd_EventExpireProposal_proposer = md_EventExpireProposal.Fields().ByName("proposer")
+	fd_EventExpireProposal_agent = md_EventExpireProposal.Fields().ByName("agent")
+	fd_EventExpireProposal_refund_actions = md_EventExpireProposal.Fields().ByName("refund_actions")
+	fd_EventExpireProposal_error = md_EventExpireProposal.Fields().ByName("error")
+	fd_EventExpireProposal_deposit = md_EventExpireProposal.Fields().ByName("deposit")
+	fd_EventExpireProposal_deposit_burned = md_EventExpireProposal.Fields().ByName("deposit_burned")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventExpireProposal)(nil)
+
+type fastReflection_EventExpireProposal EventExpireProposal
+
+func (x *EventExpireProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventExpireProposal)(x)
+}
+
+func (x *EventExpireProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventExpireProposal_messageType fastReflection_EventExpireProposal_messageType
+var _ protoreflect.MessageType = fastReflection_EventExpireProposal_messageType{}
+
+type fastReflection_EventExpireProposal_messageType struct{}
+
+func (x fastReflection_EventExpireProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventExpireProposal)(nil)
+}
+func (x fastReflection_EventExpireProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventExpireProposal)
+}
+func (x fastReflection_EventExpireProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExpireProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventExpireProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExpireProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventExpireProposal) Type() protoreflect.MessageType {
+	return _fastReflection_EventExpireProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventExpireProposal) New() protoreflect.Message {
+	return new(fastReflection_EventExpireProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventExpireProposal) Interface() protoreflect.ProtoMessage {
+	return (*EventExpireProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventExpireProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_EventExpireProposal_proposer, value) {
+			return
+		}
+	}
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_EventExpireProposal_agent, value) {
+			return
+		}
+	}
+	if len(x.RefundActions) != 0 {
+		value := protoreflect.ValueOfList(&_EventExpireProposal_3_list{list: &x.RefundActions})
+		if !f(fd_EventExpireProposal_refund_actions, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventExpireProposal_error, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_EventExpireProposal_5_list{list: &x.Deposit})
+		if !f(fd_EventExpireProposal_deposit, value) {
+			return
+		}
+	}
+	if x.DepositBurned != false {
+		value := protoreflect.ValueOfBool(x.DepositBurned)
+		if !f(fd_EventExpireProposal_deposit_burned, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventExpireProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.proposer":
+		return x.Proposer != ""
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.agent":
+		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.refund_actions":
+		return len(x.RefundActions) != 0
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.error":
+		return x.Error != ""
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit":
+		return len(x.Deposit) != 0
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit_burned":
+		return x.DepositBurned != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExpireProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExpireProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExpireProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.proposer":
+		x.Proposer = ""
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.agent":
+		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.refund_actions":
+		x.RefundActions = nil
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.error":
+		x.Error = ""
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit":
+		x.Deposit = nil
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit_burned":
+		x.DepositBurned = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExpireProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExpireProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventExpireProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.refund_actions":
+		if len(x.RefundActions) == 0 {
+			return protoreflect.ValueOfList(&_EventExpireProposal_3_list{})
+		}
+		listValue := &_EventExpireProposal_3_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_EventExpireProposal_5_list{})
+		}
+		listValue := &_EventExpireProposal_5_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit_burned":
+		value := x.DepositBurned
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExpireProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExpireProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExpireProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.agent":
+		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.refund_actions":
+		lv := value.List()
+		clv := lv.(*_EventExpireProposal_3_list)
+		x.RefundActions = *clv.list
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.error":
+		x.Error = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit":
+		lv := value.List()
+		clv := lv.(*_EventExpireProposal_5_list)
+		x.Deposit = *clv.list
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit_burned":
+		x.DepositBurned = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExpireProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExpireProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExpireProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.refund_actions":
+		if x.RefundActions == nil {
+			x.RefundActions = []*anypb.Any{}
+		}
+		value := &_EventExpireProposal_3_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_EventExpireProposal_5_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.EventExpireProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventExpireProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.error":
+		panic(fmt.Errorf("field error of message andromeda.escrow.v1alpha1.EventExpireProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit_burned":
+		panic(fmt.Errorf("field deposit_burned of message andromeda.escrow.v1alpha1.EventExpireProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExpireProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExpireProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventExpireProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.agent":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.refund_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventExpireProposal_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.error":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventExpireProposal_5_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventExpireProposal.deposit_burned":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExpireProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExpireProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventExpireProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventExpireProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventExpireProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventExpireProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventExpireProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventExpireProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventExpireProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefundActions) > 0 {
+			for _, e := range x.RefundActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DepositBurned {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventExpireProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DepositBurned {
+			i--
+			if x.DepositBurned {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.RefundActions) > 0 {
+			for iNdEx := len(x.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventExpireProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExpireProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExpireProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActions = append(x.RefundActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActions[len(x.RefundActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositBurned", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DepositBurned = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventUpdateProposal_3_list)(nil)
+
+type _EventUpdateProposal_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventUpdateProposal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateProposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateProposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateProposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateProposal_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventUpdateProposal_4_list)(nil)
+
+type _EventUpdateProposal_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventUpdateProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateProposal_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventUpdateProposal_5_list)(nil)
+
+type _EventUpdateProposal_5_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventUpdateProposal_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateProposal_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateProposal_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateProposal_5_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateProposal_5_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventUpdateProposal_8_list)(nil)
+
+type _EventUpdateProposal_8_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventUpdateProposal_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateProposal_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateProposal_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateProposal_8_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateProposal_8_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventUpdateProposal_9_list)(nil)
+
+type _EventUpdateProposal_9_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventUpdateProposal_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventUpdateProposal_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventUpdateProposal_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventUpdateProposal_9_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventUpdateProposal_9_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventUpdateProposal_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventUpdateProposal                       protoreflect.MessageDescriptor
+	fd_EventUpdateProposal_proposer              protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_agent                 protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_pre_actions           protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_post_actions_before   protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_post_actions_after    protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_metadata_before       protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_metadata_after        protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_refund_actions_before protoreflect.FieldDescriptor
+	fd_EventUpdateProposal_refund_actions_after  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_event_proto_init()
+	md_EventUpdateProposal = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventUpdateProposal")
+	fd_EventUpdateProposal_proposer = md_EventUpdateProposal.Fields().ByName("proposer")
+	fd_EventUpdateProposal_agent = md_EventUpdateProposal.Fields().ByName("agent")
+	fd_EventUpdateProposal_pre_actions = md_EventUpdateProposal.Fields().ByName("pre_actions")
+	fd_EventUpdateProposal_post_actions_before = md_EventUpdateProposal.Fields().ByName("post_actions_before")
+	fd_EventUpdateProposal_post_actions_after = md_EventUpdateProposal.Fields().ByName("post_actions_after")
+	fd_EventUpdateProposal_metadata_before = md_EventUpdateProposal.Fields().ByName("metadata_before")
+	fd_EventUpdateProposal_metadata_after = md_EventUpdateProposal.Fields().ByName("metadata_after")
+	fd_EventUpdateProposal_refund_actions_before = md_EventUpdateProposal.Fields().ByName("refund_actions_before")
+	fd_EventUpdateProposal_refund_actions_after = md_EventUpdateProposal.Fields().ByName("refund_actions_after")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateProposal)(nil)
+
+type fastReflection_EventUpdateProposal EventUpdateProposal
+
+func (x *EventUpdateProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateProposal)(x)
+}
+
+func (x *EventUpdateProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateProposal_messageType fastReflection_EventUpdateProposal_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateProposal_messageType{}
+
+type fastReflection_EventUpdateProposal_messageType struct{}
+
+func (x fastReflection_EventUpdateProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateProposal)(nil)
+}
+func (x fastReflection_EventUpdateProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProposal)
+}
+func (x fastReflection_EventUpdateProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateProposal) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateProposal) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateProposal) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_EventUpdateProposal_proposer, value) {
+			return
+		}
+	}
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_EventUpdateProposal_agent, value) {
+			return
+		}
+	}
+	if len(x.PreActions) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateProposal_3_list{list: &x.PreActions})
+		if !f(fd_EventUpdateProposal_pre_actions, value) {
+			return
+		}
+	}
+	if len(x.PostActionsBefore) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateProposal_4_list{list: &x.PostActionsBefore})
+		if !f(fd_EventUpdateProposal_post_actions_before, value) {
+			return
+		}
+	}
+	if len(x.PostActionsAfter) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateProposal_5_list{list: &x.PostActionsAfter})
+		if !f(fd_EventUpdateProposal_post_actions_after, value) {
+			return
+		}
+	}
+	if x.MetadataBefore != "" {
+		value := protoreflect.ValueOfString(x.MetadataBefore)
+		if !f(fd_EventUpdateProposal_metadata_before, value) {
+			return
+		}
+	}
+	if x.MetadataAfter != "" {
+		value := protoreflect.ValueOfString(x.MetadataAfter)
+		if !f(fd_EventUpdateProposal_metadata_after, value) {
+			return
+		}
+	}
+	if len(x.RefundActionsBefore) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateProposal_8_list{list: &x.RefundActionsBefore})
+		if !f(fd_EventUpdateProposal_refund_actions_before, value) {
+			return
+		}
+	}
+	if len(x.RefundActionsAfter) != 0 {
+		value := protoreflect.ValueOfList(&_EventUpdateProposal_9_list{list: &x.RefundActionsAfter})
+		if !f(fd_EventUpdateProposal_refund_actions_after, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.proposer":
+		return x.Proposer != ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.agent":
+		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.pre_actions":
+		return len(x.PreActions) != 0
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_before":
+		return len(x.PostActionsBefore) != 0
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_after":
+		return len(x.PostActionsAfter) != 0
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_before":
+		return x.MetadataBefore != ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_after":
+		return x.MetadataAfter != ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_before":
+		return len(x.RefundActionsBefore) != 0
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_after":
+		return len(x.RefundActionsAfter) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventUpdateProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.proposer":
+		x.Proposer = ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.agent":
+		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.pre_actions":
+		x.PreActions = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_before":
+		x.PostActionsBefore = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_after":
+		x.PostActionsAfter = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_before":
+		x.MetadataBefore = ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_after":
+		x.MetadataAfter = ""
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_before":
+		x.RefundActionsBefore = nil
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_after":
+		x.RefundActionsAfter = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventUpdateProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.pre_actions":
+		if len(x.PreActions) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateProposal_3_list{})
+		}
+		listValue := &_EventUpdateProposal_3_list{list: &x.PreActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_before":
+		if len(x.PostActionsBefore) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateProposal_4_list{})
+		}
+		listValue := &_EventUpdateProposal_4_list{list: &x.PostActionsBefore}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_after":
+		if len(x.PostActionsAfter) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateProposal_5_list{})
+		}
+		listValue := &_EventUpdateProposal_5_list{list: &x.PostActionsAfter}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_before":
+		value := x.MetadataBefore
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_after":
+		value := x.MetadataAfter
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_before":
+		if len(x.RefundActionsBefore) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateProposal_8_list{})
+		}
+		listValue := &_EventUpdateProposal_8_list{list: &x.RefundActionsBefore}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_after":
+		if len(x.RefundActionsAfter) == 0 {
+			return protoreflect.ValueOfList(&_EventUpdateProposal_9_list{})
+		}
+		listValue := &_EventUpdateProposal_9_list{list: &x.RefundActionsAfter}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventUpdateProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.agent":
+		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.pre_actions":
+		lv := value.List()
+		clv := lv.(*_EventUpdateProposal_3_list)
+		x.PreActions = *clv.list
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_before":
+		lv := value.List()
+		clv := lv.(*_EventUpdateProposal_4_list)
+		x.PostActionsBefore = *clv.list
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_after":
+		lv := value.List()
+		clv := lv.(*_EventUpdateProposal_5_list)
+		x.PostActionsAfter = *clv.list
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_before":
+		x.MetadataBefore = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_after":
+		x.MetadataAfter = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_before":
+		lv := value.List()
+		clv := lv.(*_EventUpdateProposal_8_list)
+		x.RefundActionsBefore = *clv.list
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_after":
+		lv := value.List()
+		clv := lv.(*_EventUpdateProposal_9_list)
+		x.RefundActionsAfter = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventUpdateProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.pre_actions":
+		if x.PreActions == nil {
+			x.PreActions = []*anypb.Any{}
+		}
+		value := &_EventUpdateProposal_3_list{list: &x.PreActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_before":
+		if x.PostActionsBefore == nil {
+			x.PostActionsBefore = []*anypb.Any{}
+		}
+		value := &_EventUpdateProposal_4_list{list: &x.PostActionsBefore}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_after":
+		if x.PostActionsAfter == nil {
+			x.PostActionsAfter = []*anypb.Any{}
+		}
+		value := &_EventUpdateProposal_5_list{list: &x.PostActionsAfter}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_before":
+		if x.RefundActionsBefore == nil {
+			x.RefundActionsBefore = []*anypb.Any{}
+		}
+		value := &_EventUpdateProposal_8_list{list: &x.RefundActionsBefore}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_after":
+		if x.RefundActionsAfter == nil {
+			x.RefundActionsAfter = []*anypb.Any{}
+		}
+		value := &_EventUpdateProposal_9_list{list: &x.RefundActionsAfter}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.EventUpdateProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventUpdateProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_before":
+		panic(fmt.Errorf("field metadata_before of message andromeda.escrow.v1alpha1.EventUpdateProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_after":
+		panic(fmt.Errorf("field metadata_after of message andromeda.escrow.v1alpha1.EventUpdateProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventUpdateProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.agent":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.pre_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventUpdateProposal_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_before":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventUpdateProposal_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_after":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventUpdateProposal_5_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_before":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.metadata_after":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_before":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventUpdateProposal_8_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_after":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventUpdateProposal_9_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventUpdateProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventUpdateProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventUpdateProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PreActions) > 0 {
+			for _, e := range x.PreActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PostActionsBefore) > 0 {
+			for _, e := range x.PostActionsBefore {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PostActionsAfter) > 0 {
+			for _, e := range x.PostActionsAfter {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MetadataBefore)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MetadataAfter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefundActionsBefore) > 0 {
+			for _, e := range x.RefundActionsBefore {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RefundActionsAfter) > 0 {
+			for _, e := range x.RefundActionsAfter {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RefundActionsAfter) > 0 {
+			for iNdEx := len(x.RefundActionsAfter) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActionsAfter[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.RefundActionsBefore) > 0 {
+			for iNdEx := len(x.RefundActionsBefore) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActionsBefore[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.MetadataAfter) > 0 {
+			i -= len(x.MetadataAfter)
+			copy(dAtA[i:], x.MetadataAfter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetadataAfter)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MetadataBefore) > 0 {
+			i -= len(x.MetadataBefore)
+			copy(dAtA[i:], x.MetadataBefore)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetadataBefore)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.PostActionsAfter) > 0 {
+			for iNdEx := len(x.PostActionsAfter) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostActionsAfter[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PostActionsBefore) > 0 {
+			for iNdEx := len(x.PostActionsBefore) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostActionsBefore[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PreActions) > 0 {
+			for iNdEx := len(x.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreActions = append(x.PreActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActionsBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostActionsBefore = append(x.PostActionsBefore, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActionsBefore[len(x.PostActionsBefore)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActionsAfter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostActionsAfter = append(x.PostActionsAfter, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActionsAfter[len(x.PostActionsAfter)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataBefore", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetadataBefore = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataAfter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetadataAfter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActionsBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActionsBefore = append(x.RefundActionsBefore, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActionsBefore[len(x.RefundActionsBefore)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActionsAfter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActionsAfter = append(x.RefundActionsAfter, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActionsAfter[len(x.RefundActionsAfter)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventExec_2_list)(nil)
+
+type _EventExec_2_list struct {
+	list *[]string
+}
+
+func (x *_EventExec_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventExec_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventExec_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventExec_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventExec_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventExec at list field Agents as it is not of Message kind"))
+}
+
+func (x *_EventExec_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventExec_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventExec_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventExec_3_list)(nil)
+
+type _EventExec_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_EventExec_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventExec_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventExec_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventExec_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventExec_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExec_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventExec_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExec_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventExec_4_list)(nil)
+
+type _EventExec_4_list struct {
+	list *[]uint64
+}
+
+func (x *_EventExec_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventExec_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_EventExec_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventExec_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventExec_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventExec at list field Quantities as it is not of Message kind"))
+}
+
+func (x *_EventExec_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventExec_4_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_EventExec_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventExec_5_list)(nil)
+
+type _EventExec_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventExec_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventExec_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventExec_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventExec_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventExec_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExec_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventExec_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExec_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventExec_6_list)(nil)
+
+type _EventExec_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventExec_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventExec_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventExec_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventExec_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventExec_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExec_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventExec_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventExec_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventExec                protoreflect.MessageDescriptor
+	fd_EventExec_executor       protoreflect.FieldDescriptor
+	fd_EventExec_agents         protoreflect.FieldDescriptor
+	fd_EventExec_actions        protoreflect.FieldDescriptor
+	fd_EventExec_quantities     protoreflect.FieldDescriptor
+	fd_EventExec_declared_value protoreflect.FieldDescriptor
+	fd_EventExec_fee            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_event_proto_init()
+	md_EventExec = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventExec")
+	fd_EventExec_executor = md_EventExec.Fields().ByName("executor")
+	fd_EventExec_agents = md_EventExec.Fields().ByName("agents")
+	fd_EventExec_actions = md_EventExec.Fields().ByName("actions")
+	fd_EventExec_quantities = md_EventExec.Fields().ByName("quantities")
+	fd_EventExec_declared_value = md_EventExec.Fields().ByName("declared_value")
+	fd_EventExec_fee = md_EventExec.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventExec)(nil)
+
+type fastReflection_EventExec EventExec
+
+func (x *EventExec) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventExec)(x)
+}
+
+func (x *EventExec) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventExec_messageType fastReflection_EventExec_messageType
+var _ protoreflect.MessageType = fastReflection_EventExec_messageType{}
+
+type fastReflection_EventExec_messageType struct{}
+
+func (x fastReflection_EventExec_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventExec)(nil)
+}
+func (x fastReflection_EventExec_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventExec)
+}
+func (x fastReflection_EventExec_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExec
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventExec) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExec
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventExec) Type() protoreflect.MessageType {
+	return _fastReflection_EventExec_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventExec) New() protoreflect.Message {
+	return new(fastReflection_EventExec)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventExec) Interface() protoreflect.ProtoMessage {
+	return (*EventExec)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventExec) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_EventExec_executor, value) {
+			return
+		}
 	}
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_EventCreateAgent_creator, value) {
+	if len(x.Agents) != 0 {
+		value := protoreflect.ValueOfList(&_EventExec_2_list{list: &x.Agents})
+		if !f(fd_EventExec_agents, value) {
+			return
+		}
+	}
+	if len(x.Actions) != 0 {
+		value := protoreflect.ValueOfList(&_EventExec_3_list{list: &x.Actions})
+		if !f(fd_EventExec_actions, value) {
+			return
+		}
+	}
+	if len(x.Quantities) != 0 {
+		value := protoreflect.ValueOfList(&_EventExec_4_list{list: &x.Quantities})
+		if !f(fd_EventExec_quantities, value) {
+			return
+		}
+	}
+	if len(x.DeclaredValue) != 0 {
+		value := protoreflect.ValueOfList(&_EventExec_5_list{list: &x.DeclaredValue})
+		if !f(fd_EventExec_declared_value, value) {
+			return
+		}
+	}
+	if len(x.Fee) != 0 {
+		value := protoreflect.ValueOfList(&_EventExec_6_list{list: &x.Fee})
+		if !f(fd_EventExec_fee, value) {
 			return
 		}
 	}
@@ -585,17 +6937,25 @@ func (x *fastReflection_EventCreateAgent) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventCreateAgent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventExec) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
-		return x.Agent != ""
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
-		return x.Creator != ""
+	case "andromeda.escrow.v1alpha1.EventExec.executor":
+		return x.Executor != ""
+	case "andromeda.escrow.v1alpha1.EventExec.agents":
+		return len(x.Agents) != 0
+	case "andromeda.escrow.v1alpha1.EventExec.actions":
+		return len(x.Actions) != 0
+	case "andromeda.escrow.v1alpha1.EventExec.quantities":
+		return len(x.Quantities) != 0
+	case "andromeda.escrow.v1alpha1.EventExec.declared_value":
+		return len(x.DeclaredValue) != 0
+	case "andromeda.escrow.v1alpha1.EventExec.fee":
+		return len(x.Fee) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -605,17 +6965,25 @@ func (x *fastReflection_EventCreateAgent) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAgent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventExec) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
-		x.Agent = ""
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
-		x.Creator = ""
+	case "andromeda.escrow.v1alpha1.EventExec.executor":
+		x.Executor = ""
+	case "andromeda.escrow.v1alpha1.EventExec.agents":
+		x.Agents = nil
+	case "andromeda.escrow.v1alpha1.EventExec.actions":
+		x.Actions = nil
+	case "andromeda.escrow.v1alpha1.EventExec.quantities":
+		x.Quantities = nil
+	case "andromeda.escrow.v1alpha1.EventExec.declared_value":
+		x.DeclaredValue = nil
+	case "andromeda.escrow.v1alpha1.EventExec.fee":
+		x.Fee = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -625,19 +6993,46 @@ func (x *fastReflection_EventCreateAgent) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventCreateAgent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventExec) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
-		value := x.Agent
-		return protoreflect.ValueOfString(value)
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
-		value := x.Creator
+	case "andromeda.escrow.v1alpha1.EventExec.executor":
+		value := x.Executor
 		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventExec.agents":
+		if len(x.Agents) == 0 {
+			return protoreflect.ValueOfList(&_EventExec_2_list{})
+		}
+		listValue := &_EventExec_2_list{list: &x.Agents}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventExec.actions":
+		if len(x.Actions) == 0 {
+			return protoreflect.ValueOfList(&_EventExec_3_list{})
+		}
+		listValue := &_EventExec_3_list{list: &x.Actions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventExec.quantities":
+		if len(x.Quantities) == 0 {
+			return protoreflect.ValueOfList(&_EventExec_4_list{})
+		}
+		listValue := &_EventExec_4_list{list: &x.Quantities}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventExec.declared_value":
+		if len(x.DeclaredValue) == 0 {
+			return protoreflect.ValueOfList(&_EventExec_5_list{})
+		}
+		listValue := &_EventExec_5_list{list: &x.DeclaredValue}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.EventExec.fee":
+		if len(x.Fee) == 0 {
+			return protoreflect.ValueOfList(&_EventExec_6_list{})
+		}
+		listValue := &_EventExec_6_list{list: &x.Fee}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -651,17 +7046,35 @@ func (x *fastReflection_EventCreateAgent) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAgent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventExec) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
-		x.Agent = value.Interface().(string)
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
-		x.Creator = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventExec.executor":
+		x.Executor = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventExec.agents":
+		lv := value.List()
+		clv := lv.(*_EventExec_2_list)
+		x.Agents = *clv.list
+	case "andromeda.escrow.v1alpha1.EventExec.actions":
+		lv := value.List()
+		clv := lv.(*_EventExec_3_list)
+		x.Actions = *clv.list
+	case "andromeda.escrow.v1alpha1.EventExec.quantities":
+		lv := value.List()
+		clv := lv.(*_EventExec_4_list)
+		x.Quantities = *clv.list
+	case "andromeda.escrow.v1alpha1.EventExec.declared_value":
+		lv := value.List()
+		clv := lv.(*_EventExec_5_list)
+		x.DeclaredValue = *clv.list
+	case "andromeda.escrow.v1alpha1.EventExec.fee":
+		lv := value.List()
+		clv := lv.(*_EventExec_6_list)
+		x.Fee = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -675,44 +7088,85 @@ func (x *fastReflection_EventCreateAgent) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAgent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventExec) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
-		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventCreateAgent is not mutable"))
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
-		panic(fmt.Errorf("field creator of message andromeda.escrow.v1alpha1.EventCreateAgent is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExec.agents":
+		if x.Agents == nil {
+			x.Agents = []string{}
+		}
+		value := &_EventExec_2_list{list: &x.Agents}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventExec.actions":
+		if x.Actions == nil {
+			x.Actions = []*anypb.Any{}
+		}
+		value := &_EventExec_3_list{list: &x.Actions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventExec.quantities":
+		if x.Quantities == nil {
+			x.Quantities = []uint64{}
+		}
+		value := &_EventExec_4_list{list: &x.Quantities}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventExec.declared_value":
+		if x.DeclaredValue == nil {
+			x.DeclaredValue = []*v1beta1.Coin{}
+		}
+		value := &_EventExec_5_list{list: &x.DeclaredValue}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventExec.fee":
+		if x.Fee == nil {
+			x.Fee = []*v1beta1.Coin{}
+		}
+		value := &_EventExec_6_list{list: &x.Fee}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.EventExec.executor":
+		panic(fmt.Errorf("field executor of message andromeda.escrow.v1alpha1.EventExec is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventCreateAgent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventExec) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.agent":
-		return protoreflect.ValueOfString("")
-	case "andromeda.escrow.v1alpha1.EventCreateAgent.creator":
+	case "andromeda.escrow.v1alpha1.EventExec.executor":
 		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventExec.agents":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventExec_2_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventExec.actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventExec_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventExec.quantities":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_EventExec_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventExec.declared_value":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventExec_5_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventExec.fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventExec_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventCreateAgent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventExec) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventCreateAgent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventExec", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -720,7 +7174,7 @@ func (x *fastReflection_EventCreateAgent) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventCreateAgent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventExec) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -731,7 +7185,7 @@ func (x *fastReflection_EventCreateAgent) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAgent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventExec) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -743,7 +7197,7 @@ func (x *fastReflection_EventCreateAgent) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventCreateAgent) IsValid() bool {
+func (x *fastReflection_EventExec) IsValid() bool {
 	return x != nil
 }
 
@@ -753,9 +7207,9 @@ func (x *fastReflection_EventCreateAgent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventExec) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventCreateAgent)
+		x := input.Message.Interface().(*EventExec)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -767,13 +7221,40 @@ func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Agent)
+		l = len(x.Executor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Agents) > 0 {
+			for _, s := range x.Agents {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Actions) > 0 {
+			for _, e := range x.Actions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Quantities) > 0 {
+			l = 0
+			for _, e := range x.Quantities {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.DeclaredValue) > 0 {
+			for _, e := range x.DeclaredValue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Fee) > 0 {
+			for _, e := range x.Fee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -785,36 +7266,106 @@ func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventCreateAgent)
+		x := input.Message.Interface().(*EventExec)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 				Buf:               input.Buf,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Fee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.DeclaredValue) > 0 {
+			for iNdEx := len(x.DeclaredValue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DeclaredValue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Quantities) > 0 {
+			var pksize2 int
+			for _, num := range x.Quantities {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.Quantities {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
-		if len(x.Agent) > 0 {
-			i -= len(x.Agent)
-			copy(dAtA[i:], x.Agent)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+		if len(x.Actions) > 0 {
+			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Actions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agents) > 0 {
+			for iNdEx := len(x.Agents) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Agents[iNdEx])
+				copy(dAtA[i:], x.Agents[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agents[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -829,7 +7380,7 @@ func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventCreateAgent)
+		x := input.Message.Interface().(*EventExec)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -861,15 +7412,15 @@ func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAgent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExec: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -897,11 +7448,11 @@ func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Agent = string(dAtA[iNdEx:postIndex])
+				x.Executor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -929,7 +7480,185 @@ func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Agents = append(x.Agents, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actions = append(x.Actions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Actions[len(x.Actions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Quantities = append(x.Quantities, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Quantities) == 0 {
+						x.Quantities = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Quantities = append(x.Quantities, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantities", wireType)
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeclaredValue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeclaredValue = append(x.DeclaredValue, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeclaredValue[len(x.DeclaredValue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = append(x.Fee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee[len(x.Fee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -966,137 +7695,86 @@ func (x *fastReflection_EventCreateAgent) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_EventSubmitProposal_3_list)(nil)
-
-type _EventSubmitProposal_3_list struct {
-	list *[]*anypb.Any
-}
-
-func (x *_EventSubmitProposal_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_EventSubmitProposal_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_EventSubmitProposal_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_EventSubmitProposal_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_EventSubmitProposal_3_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_EventSubmitProposal_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_EventSubmitProposal_3_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_EventSubmitProposal_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_EventSubmitProposal_4_list)(nil)
+var _ protoreflect.List = (*_EventExecProposal_4_list)(nil)
 
-type _EventSubmitProposal_4_list struct {
+type _EventExecProposal_4_list struct {
 	list *[]*anypb.Any
 }
 
-func (x *_EventSubmitProposal_4_list) Len() int {
+func (x *_EventExecProposal_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_EventSubmitProposal_4_list) Get(i int) protoreflect.Value {
+func (x *_EventExecProposal_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_EventSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
+func (x *_EventExecProposal_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_EventSubmitProposal_4_list) Append(value protoreflect.Value) {
+func (x *_EventExecProposal_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_EventSubmitProposal_4_list) AppendMutable() protoreflect.Value {
+func (x *_EventExecProposal_4_list) AppendMutable() protoreflect.Value {
 	v := new(anypb.Any)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_EventSubmitProposal_4_list) Truncate(n int) {
+func (x *_EventExecProposal_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_EventSubmitProposal_4_list) NewElement() protoreflect.Value {
+func (x *_EventExecProposal_4_list) NewElement() protoreflect.Value {
 	v := new(anypb.Any)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_EventSubmitProposal_4_list) IsValid() bool {
+func (x *_EventExecProposal_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_EventSubmitProposal              protoreflect.MessageDescriptor
-	fd_EventSubmitProposal_proposer     protoreflect.FieldDescriptor
-	fd_EventSubmitProposal_agent        protoreflect.FieldDescriptor
-	fd_EventSubmitProposal_pre_actions  protoreflect.FieldDescriptor
-	fd_EventSubmitProposal_post_actions protoreflect.FieldDescriptor
-	fd_EventSubmitProposal_metadata     protoreflect.FieldDescriptor
+	md_EventExecProposal              protoreflect.MessageDescriptor
+	fd_EventExecProposal_executor     protoreflect.FieldDescriptor
+	fd_EventExecProposal_proposer     protoreflect.FieldDescriptor
+	fd_EventExecProposal_agent        protoreflect.FieldDescriptor
+	fd_EventExecProposal_post_actions protoreflect.FieldDescriptor
+	fd_EventExecProposal_quantity     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_andromeda_escrow_v1alpha1_event_proto_init()
-	md_EventSubmitProposal = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventSubmitProposal")
-	fd_EventSubmitProposal_proposer = md_EventSubmitProposal.Fields().ByName("proposer")
-	fd_EventSubmitProposal_agent = md_EventSubmitProposal.Fields().ByName("agent")
-	fd_EventSubmitProposal_pre_actions = md_EventSubmitProposal.Fields().ByName("pre_actions")
-	fd_EventSubmitProposal_post_actions = md_EventSubmitProposal.Fields().ByName("post_actions")
-	fd_EventSubmitProposal_metadata = md_EventSubmitProposal.Fields().ByName("metadata")
+	md_EventExecProposal = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventExecProposal")
+	fd_EventExecProposal_executor = md_EventExecProposal.Fields().ByName("executor")
+	fd_EventExecProposal_proposer = md_EventExecProposal.Fields().ByName("proposer")
+	fd_EventExecProposal_agent = md_EventExecProposal.Fields().ByName("agent")
+	fd_EventExecProposal_post_actions = md_EventExecProposal.Fields().ByName("post_actions")
+	fd_EventExecProposal_quantity = md_EventExecProposal.Fields().ByName("quantity")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventSubmitProposal)(nil)
-
-type fastReflection_EventSubmitProposal EventSubmitProposal
+var _ protoreflect.Message = (*fastReflection_EventExecProposal)(nil)
 
-func (x *EventSubmitProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventSubmitProposal)(x)
+type fastReflection_EventExecProposal EventExecProposal
+
+func (x *EventExecProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventExecProposal)(x)
 }
 
-func (x *EventSubmitProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[2]
+func (x *EventExecProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,43 +7785,43 @@ func (x *EventSubmitProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventSubmitProposal_messageType fastReflection_EventSubmitProposal_messageType
-var _ protoreflect.MessageType = fastReflection_EventSubmitProposal_messageType{}
+var _fastReflection_EventExecProposal_messageType fastReflection_EventExecProposal_messageType
+var _ protoreflect.MessageType = fastReflection_EventExecProposal_messageType{}
 
-type fastReflection_EventSubmitProposal_messageType struct{}
+type fastReflection_EventExecProposal_messageType struct{}
 
-func (x fastReflection_EventSubmitProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventSubmitProposal)(nil)
+func (x fastReflection_EventExecProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventExecProposal)(nil)
 }
-func (x fastReflection_EventSubmitProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventSubmitProposal)
+func (x fastReflection_EventExecProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventExecProposal)
 }
-func (x fastReflection_EventSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSubmitProposal
+func (x fastReflection_EventExecProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExecProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSubmitProposal
+func (x *fastReflection_EventExecProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventExecProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventSubmitProposal) Type() protoreflect.MessageType {
-	return _fastReflection_EventSubmitProposal_messageType
+func (x *fastReflection_EventExecProposal) Type() protoreflect.MessageType {
+	return _fastReflection_EventExecProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventSubmitProposal) New() protoreflect.Message {
-	return new(fastReflection_EventSubmitProposal)
+func (x *fastReflection_EventExecProposal) New() protoreflect.Message {
+	return new(fastReflection_EventExecProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventSubmitProposal) Interface() protoreflect.ProtoMessage {
-	return (*EventSubmitProposal)(x)
+func (x *fastReflection_EventExecProposal) Interface() protoreflect.ProtoMessage {
+	return (*EventExecProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1151,34 +7829,34 @@ func (x *fastReflection_EventSubmitProposal) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventExecProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_EventExecProposal_executor, value) {
+			return
+		}
+	}
 	if x.Proposer != "" {
 		value := protoreflect.ValueOfString(x.Proposer)
-		if !f(fd_EventSubmitProposal_proposer, value) {
+		if !f(fd_EventExecProposal_proposer, value) {
 			return
 		}
 	}
 	if x.Agent != "" {
 		value := protoreflect.ValueOfString(x.Agent)
-		if !f(fd_EventSubmitProposal_agent, value) {
-			return
-		}
-	}
-	if len(x.PreActions) != 0 {
-		value := protoreflect.ValueOfList(&_EventSubmitProposal_3_list{list: &x.PreActions})
-		if !f(fd_EventSubmitProposal_pre_actions, value) {
+		if !f(fd_EventExecProposal_agent, value) {
 			return
 		}
 	}
 	if len(x.PostActions) != 0 {
-		value := protoreflect.ValueOfList(&_EventSubmitProposal_4_list{list: &x.PostActions})
-		if !f(fd_EventSubmitProposal_post_actions, value) {
+		value := protoreflect.ValueOfList(&_EventExecProposal_4_list{list: &x.PostActions})
+		if !f(fd_EventExecProposal_post_actions, value) {
 			return
 		}
 	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_EventSubmitProposal_metadata, value) {
+	if x.Quantity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Quantity)
+		if !f(fd_EventExecProposal_quantity, value) {
 			return
 		}
 	}
@@ -1195,23 +7873,23 @@ func (x *fastReflection_EventSubmitProposal) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventExecProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.executor":
+		return x.Executor != ""
+	case "andromeda.escrow.v1alpha1.EventExecProposal.proposer":
 		return x.Proposer != ""
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.agent":
 		return x.Agent != ""
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
-		return len(x.PreActions) != 0
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.post_actions":
 		return len(x.PostActions) != 0
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
-		return x.Metadata != ""
+	case "andromeda.escrow.v1alpha1.EventExecProposal.quantity":
+		return x.Quantity != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExecProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExecProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1221,23 +7899,23 @@ func (x *fastReflection_EventSubmitProposal) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventExecProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.executor":
+		x.Executor = ""
+	case "andromeda.escrow.v1alpha1.EventExecProposal.proposer":
 		x.Proposer = ""
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.agent":
 		x.Agent = ""
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
-		x.PreActions = nil
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.post_actions":
 		x.PostActions = nil
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
-		x.Metadata = ""
+	case "andromeda.escrow.v1alpha1.EventExecProposal.quantity":
+		x.Quantity = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExecProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExecProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1247,34 +7925,31 @@ func (x *fastReflection_EventSubmitProposal) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventExecProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.executor":
+		value := x.Executor
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventExecProposal.proposer":
 		value := x.Proposer
 		return protoreflect.ValueOfString(value)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.agent":
 		value := x.Agent
 		return protoreflect.ValueOfString(value)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
-		if len(x.PreActions) == 0 {
-			return protoreflect.ValueOfList(&_EventSubmitProposal_3_list{})
-		}
-		listValue := &_EventSubmitProposal_3_list{list: &x.PreActions}
-		return protoreflect.ValueOfList(listValue)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.post_actions":
 		if len(x.PostActions) == 0 {
-			return protoreflect.ValueOfList(&_EventSubmitProposal_4_list{})
+			return protoreflect.ValueOfList(&_EventExecProposal_4_list{})
 		}
-		listValue := &_EventSubmitProposal_4_list{list: &x.PostActions}
+		listValue := &_EventExecProposal_4_list{list: &x.PostActions}
 		return protoreflect.ValueOfList(listValue)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.EventExecProposal.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExecProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExecProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1288,27 +7963,25 @@ func (x *fastReflection_EventSubmitProposal) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventExecProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.executor":
+		x.Executor = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventExecProposal.proposer":
 		x.Proposer = value.Interface().(string)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.agent":
 		x.Agent = value.Interface().(string)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
-		lv := value.List()
-		clv := lv.(*_EventSubmitProposal_3_list)
-		x.PreActions = *clv.list
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.post_actions":
 		lv := value.List()
-		clv := lv.(*_EventSubmitProposal_4_list)
+		clv := lv.(*_EventExecProposal_4_list)
 		x.PostActions = *clv.list
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
-		x.Metadata = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.EventExecProposal.quantity":
+		x.Quantity = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExecProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExecProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1322,66 +7995,61 @@ func (x *fastReflection_EventSubmitProposal) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventExecProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
-		if x.PreActions == nil {
-			x.PreActions = []*anypb.Any{}
-		}
-		value := &_EventSubmitProposal_3_list{list: &x.PreActions}
-		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.post_actions":
 		if x.PostActions == nil {
 			x.PostActions = []*anypb.Any{}
 		}
-		value := &_EventSubmitProposal_4_list{list: &x.PostActions}
+		value := &_EventExecProposal_4_list{list: &x.PostActions}
 		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
-		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
-		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
-		panic(fmt.Errorf("field metadata of message andromeda.escrow.v1alpha1.EventSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExecProposal.executor":
+		panic(fmt.Errorf("field executor of message andromeda.escrow.v1alpha1.EventExecProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExecProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.EventExecProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExecProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.EventExecProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.EventExecProposal.quantity":
+		panic(fmt.Errorf("field quantity of message andromeda.escrow.v1alpha1.EventExecProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExecProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExecProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventExecProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.executor":
 		return protoreflect.ValueOfString("")
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.proposer":
 		return protoreflect.ValueOfString("")
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_EventSubmitProposal_3_list{list: &list})
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_EventSubmitProposal_4_list{list: &list})
-	case "andromeda.escrow.v1alpha1.EventSubmitProposal.metadata":
+	case "andromeda.escrow.v1alpha1.EventExecProposal.agent":
 		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.EventExecProposal.post_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_EventExecProposal_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.EventExecProposal.quantity":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExecProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExecProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventExecProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventSubmitProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventExecProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1389,7 +8057,7 @@ func (x *fastReflection_EventSubmitProposal) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventSubmitProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventExecProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1400,7 +8068,7 @@ func (x *fastReflection_EventSubmitProposal) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventExecProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1412,7 +8080,7 @@ func (x *fastReflection_EventSubmitProposal) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventSubmitProposal) IsValid() bool {
+func (x *fastReflection_EventExecProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -1422,9 +8090,9 @@ func (x *fastReflection_EventSubmitProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventExecProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventSubmitProposal)
+		x := input.Message.Interface().(*EventExecProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1436,6 +8104,10 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
+		l = len(x.Executor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		l = len(x.Proposer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -1444,21 +8116,14 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.PreActions) > 0 {
-			for _, e := range x.PreActions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if len(x.PostActions) > 0 {
 			for _, e := range x.PostActions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Quantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quantity))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1470,7 +8135,7 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventSubmitProposal)
+		x := input.Message.Interface().(*EventExecProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1489,12 +8154,10 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+		if x.Quantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quantity))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x28
 		}
 		if len(x.PostActions) > 0 {
 			for iNdEx := len(x.PostActions) - 1; iNdEx >= 0; iNdEx-- {
@@ -1512,34 +8175,25 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 				dAtA[i] = 0x22
 			}
 		}
-		if len(x.PreActions) > 0 {
-			for iNdEx := len(x.PreActions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreActions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
 		if len(x.Agent) > 0 {
 			i -= len(x.Agent)
 			copy(dAtA[i:], x.Agent)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 		if len(x.Proposer) > 0 {
 			i -= len(x.Proposer)
 			copy(dAtA[i:], x.Proposer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -1553,7 +8207,7 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventSubmitProposal)
+		x := input.Message.Interface().(*EventExecProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1585,15 +8239,15 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExecProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExecProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1621,11 +8275,11 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Proposer = string(dAtA[iNdEx:postIndex])
+				x.Executor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1653,13 +8307,13 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Agent = string(dAtA[iNdEx:postIndex])
+				x.Proposer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1669,25 +8323,23 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreActions = append(x.PreActions, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
@@ -1724,10 +8376,10 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 				}
 				iNdEx = postIndex
 			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 				}
-				var stringLen uint64
+				x.Quantity = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1737,24 +8389,11 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Quantity |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1790,795 +8429,591 @@ func (x *fastReflection_EventSubmitProposal) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_EventExec_2_list)(nil)
-
-type _EventExec_2_list struct {
-	list *[]string
-}
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: andromeda/escrow/v1alpha1/event.proto
 
-func (x *_EventExec_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
-func (x *_EventExec_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+// EventUpdateParams is emitted on Msg/UpdateParams.
+type EventUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *_EventExec_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+	// the address of the module authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the maximum length allowed for metadata
+	MaxMetadataLength uint64 `protobuf:"varint,2,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *v1beta1.Coin `protobuf:"bytes,3,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,4,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit []*v1beta1.Coin `protobuf:"bytes,5,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3" json:"min_proposal_deposit,omitempty"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,6,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,7,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,8,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,9,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,10,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,11,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,12,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,13,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,14,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,15,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,16,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,17,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,18,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,19,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,20,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
 }
 
-func (x *_EventExec_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *EventUpdateParams) Reset() {
+	*x = EventUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *_EventExec_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message EventExec at list field Agents as it is not of Message kind"))
+func (x *EventUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *_EventExec_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+func (*EventUpdateParams) ProtoMessage() {}
 
-func (x *_EventExec_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+// Deprecated: Use EventUpdateParams.ProtoReflect.Descriptor instead.
+func (*EventUpdateParams) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *_EventExec_2_list) IsValid() bool {
-	return x.list != nil
+func (x *EventUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
 }
 
-var _ protoreflect.List = (*_EventExec_3_list)(nil)
-
-type _EventExec_3_list struct {
-	list *[]*anypb.Any
+func (x *EventUpdateParams) GetMaxMetadataLength() uint64 {
+	if x != nil {
+		return x.MaxMetadataLength
+	}
+	return 0
 }
 
-func (x *_EventExec_3_list) Len() int {
-	if x.list == nil {
-		return 0
+func (x *EventUpdateParams) GetExecFeeFlat() *v1beta1.Coin {
+	if x != nil {
+		return x.ExecFeeFlat
 	}
-	return len(*x.list)
+	return nil
 }
 
-func (x *_EventExec_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *EventUpdateParams) GetExecFeeBps() uint32 {
+	if x != nil {
+		return x.ExecFeeBps
+	}
+	return 0
 }
 
-func (x *_EventExec_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
+func (x *EventUpdateParams) GetMinProposalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinProposalDeposit
+	}
+	return nil
 }
 
-func (x *_EventExec_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
+func (x *EventUpdateParams) GetBurnExpiredDeposits() bool {
+	if x != nil {
+		return x.BurnExpiredDeposits
+	}
+	return false
 }
 
-func (x *_EventExec_3_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *EventUpdateParams) GetMaxPreActions() uint64 {
+	if x != nil {
+		return x.MaxPreActions
+	}
+	return 0
 }
 
-func (x *_EventExec_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+func (x *EventUpdateParams) GetMaxPostActions() uint64 {
+	if x != nil {
+		return x.MaxPostActions
 	}
-	*x.list = (*x.list)[:n]
+	return 0
 }
 
-func (x *_EventExec_3_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *EventUpdateParams) GetMaxActions() uint64 {
+	if x != nil {
+		return x.MaxActions
+	}
+	return 0
 }
 
-func (x *_EventExec_3_list) IsValid() bool {
-	return x.list != nil
+func (x *EventUpdateParams) GetMaxAgentsPerExec() uint64 {
+	if x != nil {
+		return x.MaxAgentsPerExec
+	}
+	return 0
 }
 
-var (
-	md_EventExec          protoreflect.MessageDescriptor
-	fd_EventExec_executor protoreflect.FieldDescriptor
-	fd_EventExec_agents   protoreflect.FieldDescriptor
-	fd_EventExec_actions  protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_andromeda_escrow_v1alpha1_event_proto_init()
-	md_EventExec = File_andromeda_escrow_v1alpha1_event_proto.Messages().ByName("EventExec")
-	fd_EventExec_executor = md_EventExec.Fields().ByName("executor")
-	fd_EventExec_agents = md_EventExec.Fields().ByName("agents")
-	fd_EventExec_actions = md_EventExec.Fields().ByName("actions")
+func (x *EventUpdateParams) GetMaxActionSize() uint64 {
+	if x != nil {
+		return x.MaxActionSize
+	}
+	return 0
 }
 
-var _ protoreflect.Message = (*fastReflection_EventExec)(nil)
-
-type fastReflection_EventExec EventExec
-
-func (x *EventExec) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventExec)(x)
+func (x *EventUpdateParams) GetAllowedMessageTypes() []string {
+	if x != nil {
+		return x.AllowedMessageTypes
+	}
+	return nil
 }
 
-func (x *EventExec) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *EventUpdateParams) GetDeniedMessageTypes() []string {
+	if x != nil {
+		return x.DeniedMessageTypes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-var _fastReflection_EventExec_messageType fastReflection_EventExec_messageType
-var _ protoreflect.MessageType = fastReflection_EventExec_messageType{}
-
-type fastReflection_EventExec_messageType struct{}
-
-func (x fastReflection_EventExec_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventExec)(nil)
-}
-func (x fastReflection_EventExec_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventExec)
-}
-func (x fastReflection_EventExec_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventExec
+func (x *EventUpdateParams) GetMaxNestingDepth() uint64 {
+	if x != nil {
+		return x.MaxNestingDepth
+	}
+	return 0
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_EventExec) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventExec
+func (x *EventUpdateParams) GetGasPerAgent() uint64 {
+	if x != nil {
+		return x.GasPerAgent
+	}
+	return 0
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventExec) Type() protoreflect.MessageType {
-	return _fastReflection_EventExec_messageType
+func (x *EventUpdateParams) GetGasPerAction() uint64 {
+	if x != nil {
+		return x.GasPerAction
+	}
+	return 0
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventExec) New() protoreflect.Message {
-	return new(fastReflection_EventExec)
+func (x *EventUpdateParams) GetGasPerActionByte() uint64 {
+	if x != nil {
+		return x.GasPerActionByte
+	}
+	return 0
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventExec) Interface() protoreflect.ProtoMessage {
-	return (*EventExec)(x)
+func (x *EventUpdateParams) GetMaxPrunesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunesPerBlock
+	}
+	return 0
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_EventExec) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Executor != "" {
-		value := protoreflect.ValueOfString(x.Executor)
-		if !f(fd_EventExec_executor, value) {
-			return
-		}
-	}
-	if len(x.Agents) != 0 {
-		value := protoreflect.ValueOfList(&_EventExec_2_list{list: &x.Agents})
-		if !f(fd_EventExec_agents, value) {
-			return
-		}
-	}
-	if len(x.Actions) != 0 {
-		value := protoreflect.ValueOfList(&_EventExec_3_list{list: &x.Actions})
-		if !f(fd_EventExec_actions, value) {
-			return
-		}
+func (x *EventUpdateParams) GetMaxRefundActions() uint64 {
+	if x != nil {
+		return x.MaxRefundActions
 	}
+	return 0
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventExec) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventExec.executor":
-		return x.Executor != ""
-	case "andromeda.escrow.v1alpha1.EventExec.agents":
-		return len(x.Agents) != 0
-	case "andromeda.escrow.v1alpha1.EventExec.actions":
-		return len(x.Actions) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
+func (x *EventUpdateParams) GetMaxSimulateGas() uint64 {
+	if x != nil {
+		return x.MaxSimulateGas
 	}
+	return 0
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExec) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventExec.executor":
-		x.Executor = ""
-	case "andromeda.escrow.v1alpha1.EventExec.agents":
-		x.Agents = nil
-	case "andromeda.escrow.v1alpha1.EventExec.actions":
-		x.Actions = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
+// EventCreateAgent is emitted on Msg/CreateAgent.
+type EventCreateAgent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the address of the created agent
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the address of the account created the agent
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *EventCreateAgent) Reset() {
+	*x = EventCreateAgent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventExec) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "andromeda.escrow.v1alpha1.EventExec.executor":
-		value := x.Executor
-		return protoreflect.ValueOfString(value)
-	case "andromeda.escrow.v1alpha1.EventExec.agents":
-		if len(x.Agents) == 0 {
-			return protoreflect.ValueOfList(&_EventExec_2_list{})
-		}
-		listValue := &_EventExec_2_list{list: &x.Agents}
-		return protoreflect.ValueOfList(listValue)
-	case "andromeda.escrow.v1alpha1.EventExec.actions":
-		if len(x.Actions) == 0 {
-			return protoreflect.ValueOfList(&_EventExec_3_list{})
-		}
-		listValue := &_EventExec_3_list{list: &x.Actions}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", descriptor.FullName()))
-	}
+func (x *EventCreateAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExec) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventExec.executor":
-		x.Executor = value.Interface().(string)
-	case "andromeda.escrow.v1alpha1.EventExec.agents":
-		lv := value.List()
-		clv := lv.(*_EventExec_2_list)
-		x.Agents = *clv.list
-	case "andromeda.escrow.v1alpha1.EventExec.actions":
-		lv := value.List()
-		clv := lv.(*_EventExec_3_list)
-		x.Actions = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
+func (*EventCreateAgent) ProtoMessage() {}
+
+// Deprecated: Use EventCreateAgent.ProtoReflect.Descriptor instead.
+func (*EventCreateAgent) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventCreateAgent) GetAgent() string {
+	if x != nil {
+		return x.Agent
 	}
+	return ""
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExec) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventExec.agents":
-		if x.Agents == nil {
-			x.Agents = []string{}
-		}
-		value := &_EventExec_2_list{list: &x.Agents}
-		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.EventExec.actions":
-		if x.Actions == nil {
-			x.Actions = []*anypb.Any{}
-		}
-		value := &_EventExec_3_list{list: &x.Actions}
-		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.EventExec.executor":
-		panic(fmt.Errorf("field executor of message andromeda.escrow.v1alpha1.EventExec is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
+func (x *EventCreateAgent) GetCreator() string {
+	if x != nil {
+		return x.Creator
 	}
+	return ""
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventExec) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.EventExec.executor":
-		return protoreflect.ValueOfString("")
-	case "andromeda.escrow.v1alpha1.EventExec.agents":
-		list := []string{}
-		return protoreflect.ValueOfList(&_EventExec_2_list{list: &list})
-	case "andromeda.escrow.v1alpha1.EventExec.actions":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_EventExec_3_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.EventExec"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.EventExec does not contain field %s", fd.FullName()))
+// EventSubmitProposal is emitted on Msg/SubmitProposal.
+type EventSubmitProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the messages which has been executed on the submission
+	PreActions []*anypb.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the messages which will be executed after the actions included in Msg/Exec
+	PostActions []*anypb.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// any arbitrary metadata attached to the proposal
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*anypb.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// the total quantity of the units
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit []*v1beta1.Coin `protobuf:"bytes,12,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+}
+
+func (x *EventSubmitProposal) Reset() {
+	*x = EventSubmitProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventExec) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.EventExec", d.FullName()))
+func (x *EventSubmitProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSubmitProposal) ProtoMessage() {}
+
+// Deprecated: Use EventSubmitProposal.ProtoReflect.Descriptor instead.
+func (*EventSubmitProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventSubmitProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
 	}
-	panic("unreachable")
+	return ""
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventExec) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *EventSubmitProposal) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventExec) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *EventSubmitProposal) GetPreActions() []*anypb.Any {
+	if x != nil {
+		return x.PreActions
+	}
+	return nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_EventExec) IsValid() bool {
-	return x != nil
+func (x *EventSubmitProposal) GetPostActions() []*anypb.Any {
+	if x != nil {
+		return x.PostActions
+	}
+	return nil
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_EventExec) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventExec)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.Executor)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Agents) > 0 {
-			for _, s := range x.Agents {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Actions) > 0 {
-			for _, e := range x.Actions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *EventSubmitProposal) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *EventSubmitProposal) GetRefundActions() []*anypb.Any {
+	if x != nil {
+		return x.RefundActions
 	}
+	return nil
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventExec)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Actions) > 0 {
-			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Actions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Agents) > 0 {
-			for iNdEx := len(x.Agents) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Agents[iNdEx])
-				copy(dAtA[i:], x.Agents[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agents[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if len(x.Executor) > 0 {
-			i -= len(x.Executor)
-			copy(dAtA[i:], x.Executor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *EventSubmitProposal) GetExpireHeight() uint64 {
+	if x != nil {
+		return x.ExpireHeight
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventExec)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExec: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Executor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Agents = append(x.Agents, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Actions = append(x.Actions, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Actions[len(x.Actions)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return 0
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *EventSubmitProposal) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+func (x *EventSubmitProposal) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *EventSubmitProposal) GetExecutors() []string {
+	if x != nil {
+		return x.Executors
+	}
+	return nil
+}
+
+func (x *EventSubmitProposal) GetExecutorGroupId() uint64 {
+	if x != nil {
+		return x.ExecutorGroupId
+	}
+	return 0
+}
+
+func (x *EventSubmitProposal) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: andromeda/escrow/v1alpha1/event.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *EventSubmitProposal) GetMaxExecGas() uint64 {
+	if x != nil {
+		return x.MaxExecGas
+	}
+	return 0
+}
 
-// EventUpdateParams is emitted on Msg/UpdateParams.
-type EventUpdateParams struct {
+// EventCancelProposal is emitted on Msg/CancelProposal.
+type EventCancelProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the address of the module authority
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// the maximum length allowed for metadata
-	MaxMetadataLength uint64 `protobuf:"varint,2,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the messages executed on the cancellation
+	RefundActions []*anypb.Any `protobuf:"bytes,3,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the deposit refunded to the proposer
+	Deposit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (x *EventUpdateParams) Reset() {
-	*x = EventUpdateParams{}
+func (x *EventCancelProposal) Reset() {
+	*x = EventCancelProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[0]
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateParams) String() string {
+func (x *EventCancelProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateParams) ProtoMessage() {}
+func (*EventCancelProposal) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateParams.ProtoReflect.Descriptor instead.
-func (*EventUpdateParams) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EventCancelProposal.ProtoReflect.Descriptor instead.
+func (*EventCancelProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EventUpdateParams) GetAuthority() string {
+func (x *EventCancelProposal) GetProposer() string {
 	if x != nil {
-		return x.Authority
+		return x.Proposer
 	}
 	return ""
 }
 
-func (x *EventUpdateParams) GetMaxMetadataLength() uint64 {
+func (x *EventCancelProposal) GetAgent() string {
 	if x != nil {
-		return x.MaxMetadataLength
+		return x.Agent
 	}
-	return 0
+	return ""
 }
 
-// EventCreateAgent is emitted on Msg/CreateAgent.
-type EventCreateAgent struct {
+func (x *EventCancelProposal) GetRefundActions() []*anypb.Any {
+	if x != nil {
+		return x.RefundActions
+	}
+	return nil
+}
+
+func (x *EventCancelProposal) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// EventExpireProposal is emitted on the pruning of an expired proposal.
+type EventExpireProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the address of the created agent
-	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
-	// the address of the account created the agent
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-}
-
-func (x *EventCreateAgent) Reset() {
-	*x = EventCreateAgent{}
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the messages executed on the expiry
+	RefundActions []*anypb.Any `protobuf:"bytes,3,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the error raised by the refund_actions, if any
+	// Note: the proposal is kept with refund_failed set if the refund_actions fail.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// the deposit forfeited
+	Deposit []*v1beta1.Coin `protobuf:"bytes,5,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// whether the deposit has been burned
+	// Note: false means the deposit has been sent to the community pool.
+	DepositBurned bool `protobuf:"varint,6,opt,name=deposit_burned,json=depositBurned,proto3" json:"deposit_burned,omitempty"`
+}
+
+func (x *EventExpireProposal) Reset() {
+	*x = EventExpireProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[1]
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventCreateAgent) String() string {
+func (x *EventExpireProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCreateAgent) ProtoMessage() {}
+func (*EventExpireProposal) ProtoMessage() {}
 
-// Deprecated: Use EventCreateAgent.ProtoReflect.Descriptor instead.
-func (*EventCreateAgent) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventExpireProposal.ProtoReflect.Descriptor instead.
+func (*EventExpireProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *EventCreateAgent) GetAgent() string {
+func (x *EventExpireProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *EventExpireProposal) GetAgent() string {
 	if x != nil {
 		return x.Agent
 	}
 	return ""
 }
 
-func (x *EventCreateAgent) GetCreator() string {
+func (x *EventExpireProposal) GetRefundActions() []*anypb.Any {
 	if x != nil {
-		return x.Creator
+		return x.RefundActions
+	}
+	return nil
+}
+
+func (x *EventExpireProposal) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-// EventSubmitProposal is emitted on Msg/SubmitProposal.
-type EventSubmitProposal struct {
+func (x *EventExpireProposal) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *EventExpireProposal) GetDepositBurned() bool {
+	if x != nil {
+		return x.DepositBurned
+	}
+	return false
+}
+
+// EventUpdateProposal is emitted on Msg/UpdateProposal.
+type EventUpdateProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2587,69 +9022,105 @@ type EventSubmitProposal struct {
 	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// the address of the agent in charge
 	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
-	// the messages which has been executed on the submission
+	// the additional messages executed on the update
 	PreActions []*anypb.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
-	// the messages which will be executed after the actions included in Msg/Exec
-	PostActions []*anypb.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
-	// any arbitrary metadata attached to the proposal
-	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-}
-
-func (x *EventSubmitProposal) Reset() {
-	*x = EventSubmitProposal{}
+	// the post_actions before the update
+	PostActionsBefore []*anypb.Any `protobuf:"bytes,4,rep,name=post_actions_before,json=postActionsBefore,proto3" json:"post_actions_before,omitempty"`
+	// the post_actions after the update
+	PostActionsAfter []*anypb.Any `protobuf:"bytes,5,rep,name=post_actions_after,json=postActionsAfter,proto3" json:"post_actions_after,omitempty"`
+	// the metadata before the update
+	MetadataBefore string `protobuf:"bytes,6,opt,name=metadata_before,json=metadataBefore,proto3" json:"metadata_before,omitempty"`
+	// the metadata after the update
+	MetadataAfter string `protobuf:"bytes,7,opt,name=metadata_after,json=metadataAfter,proto3" json:"metadata_after,omitempty"`
+	// the refund_actions before the update
+	RefundActionsBefore []*anypb.Any `protobuf:"bytes,8,rep,name=refund_actions_before,json=refundActionsBefore,proto3" json:"refund_actions_before,omitempty"`
+	// the refund_actions after the update
+	RefundActionsAfter []*anypb.Any `protobuf:"bytes,9,rep,name=refund_actions_after,json=refundActionsAfter,proto3" json:"refund_actions_after,omitempty"`
+}
+
+func (x *EventUpdateProposal) Reset() {
+	*x = EventUpdateProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[2]
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSubmitProposal) String() string {
+func (x *EventUpdateProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSubmitProposal) ProtoMessage() {}
+func (*EventUpdateProposal) ProtoMessage() {}
 
-// Deprecated: Use EventSubmitProposal.ProtoReflect.Descriptor instead.
-func (*EventSubmitProposal) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventUpdateProposal.ProtoReflect.Descriptor instead.
+func (*EventUpdateProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *EventSubmitProposal) GetProposer() string {
+func (x *EventUpdateProposal) GetProposer() string {
 	if x != nil {
 		return x.Proposer
 	}
 	return ""
 }
 
-func (x *EventSubmitProposal) GetAgent() string {
+func (x *EventUpdateProposal) GetAgent() string {
 	if x != nil {
 		return x.Agent
 	}
 	return ""
 }
 
-func (x *EventSubmitProposal) GetPreActions() []*anypb.Any {
+func (x *EventUpdateProposal) GetPreActions() []*anypb.Any {
 	if x != nil {
 		return x.PreActions
 	}
 	return nil
 }
 
-func (x *EventSubmitProposal) GetPostActions() []*anypb.Any {
+func (x *EventUpdateProposal) GetPostActionsBefore() []*anypb.Any {
 	if x != nil {
-		return x.PostActions
+		return x.PostActionsBefore
 	}
 	return nil
 }
 
-func (x *EventSubmitProposal) GetMetadata() string {
+func (x *EventUpdateProposal) GetPostActionsAfter() []*anypb.Any {
 	if x != nil {
-		return x.Metadata
+		return x.PostActionsAfter
+	}
+	return nil
+}
+
+func (x *EventUpdateProposal) GetMetadataBefore() string {
+	if x != nil {
+		return x.MetadataBefore
+	}
+	return ""
+}
+
+func (x *EventUpdateProposal) GetMetadataAfter() string {
+	if x != nil {
+		return x.MetadataAfter
 	}
 	return ""
 }
 
+func (x *EventUpdateProposal) GetRefundActionsBefore() []*anypb.Any {
+	if x != nil {
+		return x.RefundActionsBefore
+	}
+	return nil
+}
+
+func (x *EventUpdateProposal) GetRefundActionsAfter() []*anypb.Any {
+	if x != nil {
+		return x.RefundActionsAfter
+	}
+	return nil
+}
+
 // EventExec is emitted on Msg/Exec.
 type EventExec struct {
 	state         protoimpl.MessageState
@@ -2662,12 +9133,18 @@ type EventExec struct {
 	Agents []string `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
 	// the messages executed on the execution
 	Actions []*anypb.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// the quantities of the units filled, in the same order of the agents
+	Quantities []uint64 `protobuf:"varint,4,rep,packed,name=quantities,proto3" json:"quantities,omitempty"`
+	// the value of the execution declared by the executor
+	DeclaredValue []*v1beta1.Coin `protobuf:"bytes,5,rep,name=declared_value,json=declaredValue,proto3" json:"declared_value,omitempty"`
+	// the fee charged to the executor
+	Fee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (x *EventExec) Reset() {
 	*x = EventExec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[3]
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2681,7 +9158,7 @@ func (*EventExec) ProtoMessage() {}
 
 // Deprecated: Use EventExec.ProtoReflect.Descriptor instead.
 func (*EventExec) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{3}
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EventExec) GetExecutor() string {
@@ -2705,6 +9182,104 @@ func (x *EventExec) GetActions() []*anypb.Any {
 	return nil
 }
 
+func (x *EventExec) GetQuantities() []uint64 {
+	if x != nil {
+		return x.Quantities
+	}
+	return nil
+}
+
+func (x *EventExec) GetDeclaredValue() []*v1beta1.Coin {
+	if x != nil {
+		return x.DeclaredValue
+	}
+	return nil
+}
+
+func (x *EventExec) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// EventExecProposal is emitted on Msg/Exec for each proposal executed, after
+// the execution of its post_actions.
+type EventExecProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the address of the account executed the proposal
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the post_actions executed on the execution
+	// Note: the post_actions are scaled by the quantity if the proposal is
+	// fillable partially.
+	PostActions []*anypb.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// the quantity of the units filled
+	// Note: zero means the proposal is not fillable partially.
+	Quantity uint64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *EventExecProposal) Reset() {
+	*x = EventExecProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_event_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventExecProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventExecProposal) ProtoMessage() {}
+
+// Deprecated: Use EventExecProposal.ProtoReflect.Descriptor instead.
+func (*EventExecProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventExecProposal) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *EventExecProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *EventExecProposal) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *EventExecProposal) GetPostActions() []*anypb.Any {
+	if x != nil {
+		return x.PostActions
+	}
+	return nil
+}
+
+func (x *EventExecProposal) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 var File_andromeda_escrow_v1alpha1_event_proto protoreflect.FileDescriptor
 
 var file_andromeda_escrow_v1alpha1_event_proto_rawDesc = []byte{
@@ -2712,67 +9287,260 @@ var file_andromeda_escrow_v1alpha1_event_proto_rawDesc = []byte{
 	0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e,
 	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65,
 	0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61,
-	0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x76, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x61, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xed, 0x07, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x87, 0x02,
-	0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x70,
-	0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b,
-	0x70, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a,
+	0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x3d, 0x0a,
+	0x0d, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0c,
+	0x65, 0x78, 0x65, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x12, 0x7d,
+	0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x75,
+	0x72, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50,
+	0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x45,
+	0x78, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x14, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x22, 0x0a,
+	0x0d, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x50, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
+	0x75, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x22,
+	0x76, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xb5, 0x05, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12,
+	0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x0a, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x3b, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x65, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x47, 0x61, 0x73, 0x22,
+	0x9f, 0x02, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a,
+	0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x65, 0x0a, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x22, 0xdc, 0x02, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a,
-	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12,
+	0x3b, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0d, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x65, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x22, 0x9e, 0x04, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x35,
+	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x12, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x10, 0x70,
+	0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x48, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0xdf, 0x01,
-	0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e,
-	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42,
-	0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2f, 0x65, 0x73, 0x63,
-	0x72, 0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x73, 0x63,
-	0x72, 0x6f, 0x77, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x45,
-	0x58, 0xaa, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x45, 0x73,
-	0x63, 0x72, 0x6f, 0x77, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x19,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x14, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x12, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x22, 0x96, 0x03, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12,
+	0x34, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x6c, 0x61,
+	0x72, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x64, 0x65,
+	0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5d, 0x0a, 0x03, 0x66,
+	0x65, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x11, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x12, 0x34, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0c,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0xdf, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d,
+	0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61,
+	0x2f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x3b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2,
+	0x02, 0x03, 0x41, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64,
+	0x61, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0xca, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x25,
 	0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
-	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x41, 0x6e, 0x64, 0x72,
-	0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x1b, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x3a, 0x3a, 0x45,
-	0x73, 0x63, 0x72, 0x6f, 0x77, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64,
+	0x61, 0x3a, 0x3a, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2787,23 +9555,46 @@ func file_andromeda_escrow_v1alpha1_event_proto_rawDescGZIP() []byte {
 	return file_andromeda_escrow_v1alpha1_event_proto_rawDescData
 }
 
-var file_andromeda_escrow_v1alpha1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_andromeda_escrow_v1alpha1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_andromeda_escrow_v1alpha1_event_proto_goTypes = []interface{}{
-	(*EventUpdateParams)(nil),   // 0: andromeda.escrow.v1alpha1.EventUpdateParams
-	(*EventCreateAgent)(nil),    // 1: andromeda.escrow.v1alpha1.EventCreateAgent
-	(*EventSubmitProposal)(nil), // 2: andromeda.escrow.v1alpha1.EventSubmitProposal
-	(*EventExec)(nil),           // 3: andromeda.escrow.v1alpha1.EventExec
-	(*anypb.Any)(nil),           // 4: google.protobuf.Any
+	(*EventUpdateParams)(nil),     // 0: andromeda.escrow.v1alpha1.EventUpdateParams
+	(*EventCreateAgent)(nil),      // 1: andromeda.escrow.v1alpha1.EventCreateAgent
+	(*EventSubmitProposal)(nil),   // 2: andromeda.escrow.v1alpha1.EventSubmitProposal
+	(*EventCancelProposal)(nil),   // 3: andromeda.escrow.v1alpha1.EventCancelProposal
+	(*EventExpireProposal)(nil),   // 4: andromeda.escrow.v1alpha1.EventExpireProposal
+	(*EventUpdateProposal)(nil),   // 5: andromeda.escrow.v1alpha1.EventUpdateProposal
+	(*EventExec)(nil),             // 6: andromeda.escrow.v1alpha1.EventExec
+	(*EventExecProposal)(nil),     // 7: andromeda.escrow.v1alpha1.EventExecProposal
+	(*v1beta1.Coin)(nil),          // 8: cosmos.base.v1beta1.Coin
+	(*anypb.Any)(nil),             // 9: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_andromeda_escrow_v1alpha1_event_proto_depIdxs = []int32{
-	4, // 0: andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions:type_name -> google.protobuf.Any
-	4, // 1: andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions:type_name -> google.protobuf.Any
-	4, // 2: andromeda.escrow.v1alpha1.EventExec.actions:type_name -> google.protobuf.Any
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: andromeda.escrow.v1alpha1.EventUpdateParams.exec_fee_flat:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 1: andromeda.escrow.v1alpha1.EventUpdateParams.min_proposal_deposit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 2: andromeda.escrow.v1alpha1.EventSubmitProposal.pre_actions:type_name -> google.protobuf.Any
+	9,  // 3: andromeda.escrow.v1alpha1.EventSubmitProposal.post_actions:type_name -> google.protobuf.Any
+	9,  // 4: andromeda.escrow.v1alpha1.EventSubmitProposal.refund_actions:type_name -> google.protobuf.Any
+	10, // 5: andromeda.escrow.v1alpha1.EventSubmitProposal.expire_time:type_name -> google.protobuf.Timestamp
+	8,  // 6: andromeda.escrow.v1alpha1.EventSubmitProposal.deposit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 7: andromeda.escrow.v1alpha1.EventCancelProposal.refund_actions:type_name -> google.protobuf.Any
+	8,  // 8: andromeda.escrow.v1alpha1.EventCancelProposal.deposit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 9: andromeda.escrow.v1alpha1.EventExpireProposal.refund_actions:type_name -> google.protobuf.Any
+	8,  // 10: andromeda.escrow.v1alpha1.EventExpireProposal.deposit:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 11: andromeda.escrow.v1alpha1.EventUpdateProposal.pre_actions:type_name -> google.protobuf.Any
+	9,  // 12: andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_before:type_name -> google.protobuf.Any
+	9,  // 13: andromeda.escrow.v1alpha1.EventUpdateProposal.post_actions_after:type_name -> google.protobuf.Any
+	9,  // 14: andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_before:type_name -> google.protobuf.Any
+	9,  // 15: andromeda.escrow.v1alpha1.EventUpdateProposal.refund_actions_after:type_name -> google.protobuf.Any
+	9,  // 16: andromeda.escrow.v1alpha1.EventExec.actions:type_name -> google.protobuf.Any
+	8,  // 17: andromeda.escrow.v1alpha1.EventExec.declared_value:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 18: andromeda.escrow.v1alpha1.EventExec.fee:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 19: andromeda.escrow.v1alpha1.EventExecProposal.post_actions:type_name -> google.protobuf.Any
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_andromeda_escrow_v1alpha1_event_proto_init() }
@@ -2849,6 +9640,42 @@ func file_andromeda_escrow_v1alpha1_event_proto_init() {
 			}
 		}
 		file_andromeda_escrow_v1alpha1_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventCancelProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_andromeda_escrow_v1alpha1_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventExpireProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_andromeda_escrow_v1alpha1_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_andromeda_escrow_v1alpha1_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventExec); i {
 			case 0:
 				return &v.state
@@ -2860,6 +9687,18 @@ func file_andromeda_escrow_v1alpha1_event_proto_init() {
 				return nil
 			}
 		}
+		file_andromeda_escrow_v1alpha1_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventExecProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2867,7 +9706,7 @@ func file_andromeda_escrow_v1alpha1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_andromeda_escrow_v1alpha1_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,13 +2,16 @@
 package escrowv1alpha1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -773,15 +776,194 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_GenesisState_Params_4_list)(nil)
+
+type _GenesisState_Params_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_GenesisState_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_Params_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_Params_11_list)(nil)
+
+type _GenesisState_Params_11_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_Params_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState_Params at list field AllowedMessageTypes as it is not of Message kind"))
+}
+
+func (x *_GenesisState_Params_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_Params_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_Params_12_list)(nil)
+
+type _GenesisState_Params_12_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_Params_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState_Params at list field DeniedMessageTypes as it is not of Message kind"))
+}
+
+func (x *_GenesisState_Params_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_Params_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState_Params                     protoreflect.MessageDescriptor
-	fd_GenesisState_Params_max_metadata_length protoreflect.FieldDescriptor
+	md_GenesisState_Params                       protoreflect.MessageDescriptor
+	fd_GenesisState_Params_max_metadata_length   protoreflect.FieldDescriptor
+	fd_GenesisState_Params_exec_fee_flat         protoreflect.FieldDescriptor
+	fd_GenesisState_Params_exec_fee_bps          protoreflect.FieldDescriptor
+	fd_GenesisState_Params_min_proposal_deposit  protoreflect.FieldDescriptor
+	fd_GenesisState_Params_burn_expired_deposits protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_pre_actions       protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_post_actions      protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_actions           protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_agents_per_exec   protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_action_size       protoreflect.FieldDescriptor
+	fd_GenesisState_Params_allowed_message_types protoreflect.FieldDescriptor
+	fd_GenesisState_Params_denied_message_types  protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_nesting_depth     protoreflect.FieldDescriptor
+	fd_GenesisState_Params_gas_per_agent         protoreflect.FieldDescriptor
+	fd_GenesisState_Params_gas_per_action        protoreflect.FieldDescriptor
+	fd_GenesisState_Params_gas_per_action_byte   protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_prunes_per_block  protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_refund_actions    protoreflect.FieldDescriptor
+	fd_GenesisState_Params_max_simulate_gas      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_andromeda_escrow_v1alpha1_genesis_proto_init()
 	md_GenesisState_Params = File_andromeda_escrow_v1alpha1_genesis_proto.Messages().ByName("GenesisState").Messages().ByName("Params")
 	fd_GenesisState_Params_max_metadata_length = md_GenesisState_Params.Fields().ByName("max_metadata_length")
+	fd_GenesisState_Params_exec_fee_flat = md_GenesisState_Params.Fields().ByName("exec_fee_flat")
+	fd_GenesisState_Params_exec_fee_bps = md_GenesisState_Params.Fields().ByName("exec_fee_bps")
+	fd_GenesisState_Params_min_proposal_deposit = md_GenesisState_Params.Fields().ByName("min_proposal_deposit")
+	fd_GenesisState_Params_burn_expired_deposits = md_GenesisState_Params.Fields().ByName("burn_expired_deposits")
+	fd_GenesisState_Params_max_pre_actions = md_GenesisState_Params.Fields().ByName("max_pre_actions")
+	fd_GenesisState_Params_max_post_actions = md_GenesisState_Params.Fields().ByName("max_post_actions")
+	fd_GenesisState_Params_max_actions = md_GenesisState_Params.Fields().ByName("max_actions")
+	fd_GenesisState_Params_max_agents_per_exec = md_GenesisState_Params.Fields().ByName("max_agents_per_exec")
+	fd_GenesisState_Params_max_action_size = md_GenesisState_Params.Fields().ByName("max_action_size")
+	fd_GenesisState_Params_allowed_message_types = md_GenesisState_Params.Fields().ByName("allowed_message_types")
+	fd_GenesisState_Params_denied_message_types = md_GenesisState_Params.Fields().ByName("denied_message_types")
+	fd_GenesisState_Params_max_nesting_depth = md_GenesisState_Params.Fields().ByName("max_nesting_depth")
+	fd_GenesisState_Params_gas_per_agent = md_GenesisState_Params.Fields().ByName("gas_per_agent")
+	fd_GenesisState_Params_gas_per_action = md_GenesisState_Params.Fields().ByName("gas_per_action")
+	fd_GenesisState_Params_gas_per_action_byte = md_GenesisState_Params.Fields().ByName("gas_per_action_byte")
+	fd_GenesisState_Params_max_prunes_per_block = md_GenesisState_Params.Fields().ByName("max_prunes_per_block")
+	fd_GenesisState_Params_max_refund_actions = md_GenesisState_Params.Fields().ByName("max_refund_actions")
+	fd_GenesisState_Params_max_simulate_gas = md_GenesisState_Params.Fields().ByName("max_simulate_gas")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState_Params)(nil)
@@ -855,6 +1037,114 @@ func (x *fastReflection_GenesisState_Params) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.ExecFeeFlat != nil {
+		value := protoreflect.ValueOfMessage(x.ExecFeeFlat.ProtoReflect())
+		if !f(fd_GenesisState_Params_exec_fee_flat, value) {
+			return
+		}
+	}
+	if x.ExecFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExecFeeBps)
+		if !f(fd_GenesisState_Params_exec_fee_bps, value) {
+			return
+		}
+	}
+	if len(x.MinProposalDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_Params_4_list{list: &x.MinProposalDeposit})
+		if !f(fd_GenesisState_Params_min_proposal_deposit, value) {
+			return
+		}
+	}
+	if x.BurnExpiredDeposits != false {
+		value := protoreflect.ValueOfBool(x.BurnExpiredDeposits)
+		if !f(fd_GenesisState_Params_burn_expired_deposits, value) {
+			return
+		}
+	}
+	if x.MaxPreActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPreActions)
+		if !f(fd_GenesisState_Params_max_pre_actions, value) {
+			return
+		}
+	}
+	if x.MaxPostActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPostActions)
+		if !f(fd_GenesisState_Params_max_post_actions, value) {
+			return
+		}
+	}
+	if x.MaxActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActions)
+		if !f(fd_GenesisState_Params_max_actions, value) {
+			return
+		}
+	}
+	if x.MaxAgentsPerExec != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAgentsPerExec)
+		if !f(fd_GenesisState_Params_max_agents_per_exec, value) {
+			return
+		}
+	}
+	if x.MaxActionSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActionSize)
+		if !f(fd_GenesisState_Params_max_action_size, value) {
+			return
+		}
+	}
+	if len(x.AllowedMessageTypes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_Params_11_list{list: &x.AllowedMessageTypes})
+		if !f(fd_GenesisState_Params_allowed_message_types, value) {
+			return
+		}
+	}
+	if len(x.DeniedMessageTypes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_Params_12_list{list: &x.DeniedMessageTypes})
+		if !f(fd_GenesisState_Params_denied_message_types, value) {
+			return
+		}
+	}
+	if x.MaxNestingDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxNestingDepth)
+		if !f(fd_GenesisState_Params_max_nesting_depth, value) {
+			return
+		}
+	}
+	if x.GasPerAgent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerAgent)
+		if !f(fd_GenesisState_Params_gas_per_agent, value) {
+			return
+		}
+	}
+	if x.GasPerAction != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerAction)
+		if !f(fd_GenesisState_Params_gas_per_action, value) {
+			return
+		}
+	}
+	if x.GasPerActionByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerActionByte)
+		if !f(fd_GenesisState_Params_gas_per_action_byte, value) {
+			return
+		}
+	}
+	if x.MaxPrunesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunesPerBlock)
+		if !f(fd_GenesisState_Params_max_prunes_per_block, value) {
+			return
+		}
+	}
+	if x.MaxRefundActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRefundActions)
+		if !f(fd_GenesisState_Params_max_refund_actions, value) {
+			return
+		}
+	}
+	if x.MaxSimulateGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSimulateGas)
+		if !f(fd_GenesisState_Params_max_simulate_gas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -872,6 +1162,42 @@ func (x *fastReflection_GenesisState_Params) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_metadata_length":
 		return x.MaxMetadataLength != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_flat":
+		return x.ExecFeeFlat != nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_bps":
+		return x.ExecFeeBps != uint32(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.min_proposal_deposit":
+		return len(x.MinProposalDeposit) != 0
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.burn_expired_deposits":
+		return x.BurnExpiredDeposits != false
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_pre_actions":
+		return x.MaxPreActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_post_actions":
+		return x.MaxPostActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_actions":
+		return x.MaxActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_agents_per_exec":
+		return x.MaxAgentsPerExec != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_action_size":
+		return x.MaxActionSize != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.allowed_message_types":
+		return len(x.AllowedMessageTypes) != 0
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.denied_message_types":
+		return len(x.DeniedMessageTypes) != 0
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_nesting_depth":
+		return x.MaxNestingDepth != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_agent":
+		return x.GasPerAgent != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action":
+		return x.GasPerAction != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action_byte":
+		return x.GasPerActionByte != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_prunes_per_block":
+		return x.MaxPrunesPerBlock != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_refund_actions":
+		return x.MaxRefundActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_simulate_gas":
+		return x.MaxSimulateGas != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Params"))
@@ -890,6 +1216,42 @@ func (x *fastReflection_GenesisState_Params) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_metadata_length":
 		x.MaxMetadataLength = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_flat":
+		x.ExecFeeFlat = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_bps":
+		x.ExecFeeBps = uint32(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.min_proposal_deposit":
+		x.MinProposalDeposit = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.burn_expired_deposits":
+		x.BurnExpiredDeposits = false
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_pre_actions":
+		x.MaxPreActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_post_actions":
+		x.MaxPostActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_actions":
+		x.MaxActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_agents_per_exec":
+		x.MaxAgentsPerExec = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_action_size":
+		x.MaxActionSize = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.allowed_message_types":
+		x.AllowedMessageTypes = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.denied_message_types":
+		x.DeniedMessageTypes = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_nesting_depth":
+		x.MaxNestingDepth = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_agent":
+		x.GasPerAgent = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action":
+		x.GasPerAction = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action_byte":
+		x.GasPerActionByte = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_prunes_per_block":
+		x.MaxPrunesPerBlock = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_refund_actions":
+		x.MaxRefundActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_simulate_gas":
+		x.MaxSimulateGas = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Params"))
@@ -909,6 +1271,69 @@ func (x *fastReflection_GenesisState_Params) Get(descriptor protoreflect.FieldDe
 	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_metadata_length":
 		value := x.MaxMetadataLength
 		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_flat":
+		value := x.ExecFeeFlat
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_bps":
+		value := x.ExecFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.min_proposal_deposit":
+		if len(x.MinProposalDeposit) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_Params_4_list{})
+		}
+		listValue := &_GenesisState_Params_4_list{list: &x.MinProposalDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.burn_expired_deposits":
+		value := x.BurnExpiredDeposits
+		return protoreflect.ValueOfBool(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_pre_actions":
+		value := x.MaxPreActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_post_actions":
+		value := x.MaxPostActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_actions":
+		value := x.MaxActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_agents_per_exec":
+		value := x.MaxAgentsPerExec
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_action_size":
+		value := x.MaxActionSize
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.allowed_message_types":
+		if len(x.AllowedMessageTypes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_Params_11_list{})
+		}
+		listValue := &_GenesisState_Params_11_list{list: &x.AllowedMessageTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.denied_message_types":
+		if len(x.DeniedMessageTypes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_Params_12_list{})
+		}
+		listValue := &_GenesisState_Params_12_list{list: &x.DeniedMessageTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_nesting_depth":
+		value := x.MaxNestingDepth
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_agent":
+		value := x.GasPerAgent
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action":
+		value := x.GasPerAction
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action_byte":
+		value := x.GasPerActionByte
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_prunes_per_block":
+		value := x.MaxPrunesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_refund_actions":
+		value := x.MaxRefundActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_simulate_gas":
+		value := x.MaxSimulateGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Params"))
@@ -931,6 +1356,48 @@ func (x *fastReflection_GenesisState_Params) Set(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_metadata_length":
 		x.MaxMetadataLength = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_flat":
+		x.ExecFeeFlat = value.Message().Interface().(*v1beta1.Coin)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_bps":
+		x.ExecFeeBps = uint32(value.Uint())
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.min_proposal_deposit":
+		lv := value.List()
+		clv := lv.(*_GenesisState_Params_4_list)
+		x.MinProposalDeposit = *clv.list
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.burn_expired_deposits":
+		x.BurnExpiredDeposits = value.Bool()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_pre_actions":
+		x.MaxPreActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_post_actions":
+		x.MaxPostActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_actions":
+		x.MaxActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_agents_per_exec":
+		x.MaxAgentsPerExec = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_action_size":
+		x.MaxActionSize = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.allowed_message_types":
+		lv := value.List()
+		clv := lv.(*_GenesisState_Params_11_list)
+		x.AllowedMessageTypes = *clv.list
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.denied_message_types":
+		lv := value.List()
+		clv := lv.(*_GenesisState_Params_12_list)
+		x.DeniedMessageTypes = *clv.list
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_nesting_depth":
+		x.MaxNestingDepth = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_agent":
+		x.GasPerAgent = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action":
+		x.GasPerAction = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action_byte":
+		x.GasPerActionByte = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_prunes_per_block":
+		x.MaxPrunesPerBlock = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_refund_actions":
+		x.MaxRefundActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_simulate_gas":
+		x.MaxSimulateGas = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Params"))
@@ -951,8 +1418,59 @@ func (x *fastReflection_GenesisState_Params) Set(fd protoreflect.FieldDescriptor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_GenesisState_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_flat":
+		if x.ExecFeeFlat == nil {
+			x.ExecFeeFlat = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ExecFeeFlat.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.min_proposal_deposit":
+		if x.MinProposalDeposit == nil {
+			x.MinProposalDeposit = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_Params_4_list{list: &x.MinProposalDeposit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.allowed_message_types":
+		if x.AllowedMessageTypes == nil {
+			x.AllowedMessageTypes = []string{}
+		}
+		value := &_GenesisState_Params_11_list{list: &x.AllowedMessageTypes}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.denied_message_types":
+		if x.DeniedMessageTypes == nil {
+			x.DeniedMessageTypes = []string{}
+		}
+		value := &_GenesisState_Params_12_list{list: &x.DeniedMessageTypes}
+		return protoreflect.ValueOfList(value)
 	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_metadata_length":
 		panic(fmt.Errorf("field max_metadata_length of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_bps":
+		panic(fmt.Errorf("field exec_fee_bps of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.burn_expired_deposits":
+		panic(fmt.Errorf("field burn_expired_deposits of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_pre_actions":
+		panic(fmt.Errorf("field max_pre_actions of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_post_actions":
+		panic(fmt.Errorf("field max_post_actions of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_actions":
+		panic(fmt.Errorf("field max_actions of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_agents_per_exec":
+		panic(fmt.Errorf("field max_agents_per_exec of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_action_size":
+		panic(fmt.Errorf("field max_action_size of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_nesting_depth":
+		panic(fmt.Errorf("field max_nesting_depth of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_agent":
+		panic(fmt.Errorf("field gas_per_agent of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action":
+		panic(fmt.Errorf("field gas_per_action of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action_byte":
+		panic(fmt.Errorf("field gas_per_action_byte of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_prunes_per_block":
+		panic(fmt.Errorf("field max_prunes_per_block of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_refund_actions":
+		panic(fmt.Errorf("field max_refund_actions of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_simulate_gas":
+		panic(fmt.Errorf("field max_simulate_gas of message andromeda.escrow.v1alpha1.GenesisState.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Params"))
@@ -968,6 +1486,46 @@ func (x *fastReflection_GenesisState_Params) NewField(fd protoreflect.FieldDescr
 	switch fd.FullName() {
 	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_metadata_length":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_flat":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.min_proposal_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_Params_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.burn_expired_deposits":
+		return protoreflect.ValueOfBool(false)
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_pre_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_post_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_agents_per_exec":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_action_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.allowed_message_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_Params_11_list{list: &list})
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.denied_message_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_Params_12_list{list: &list})
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_nesting_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_agent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.gas_per_action_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_prunes_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_refund_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Params.max_simulate_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Params"))
@@ -1040,6 +1598,70 @@ func (x *fastReflection_GenesisState_Params) ProtoMethods() *protoiface.Methods
 		if x.MaxMetadataLength != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxMetadataLength))
 		}
+		if x.ExecFeeFlat != nil {
+			l = options.Size(x.ExecFeeFlat)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecFeeBps))
+		}
+		if len(x.MinProposalDeposit) > 0 {
+			for _, e := range x.MinProposalDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BurnExpiredDeposits {
+			n += 2
+		}
+		if x.MaxPreActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPreActions))
+		}
+		if x.MaxPostActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPostActions))
+		}
+		if x.MaxActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActions))
+		}
+		if x.MaxAgentsPerExec != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAgentsPerExec))
+		}
+		if x.MaxActionSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActionSize))
+		}
+		if len(x.AllowedMessageTypes) > 0 {
+			for _, s := range x.AllowedMessageTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedMessageTypes) > 0 {
+			for _, s := range x.DeniedMessageTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxNestingDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNestingDepth))
+		}
+		if x.GasPerAgent != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerAgent))
+		}
+		if x.GasPerAction != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerAction))
+		}
+		if x.GasPerActionByte != 0 {
+			n += 2 + runtime.Sov(uint64(x.GasPerActionByte))
+		}
+		if x.MaxPrunesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPrunesPerBlock))
+		}
+		if x.MaxRefundActions != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRefundActions))
+		}
+		if x.MaxSimulateGas != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxSimulateGas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1069,49 +1691,180 @@ func (x *fastReflection_GenesisState_Params) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxMetadataLength != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataLength))
+		if x.MaxSimulateGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSimulateGas))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.MaxRefundActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRefundActions))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState_Params)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		if x.MaxPrunesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunesPerBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+		if x.GasPerActionByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerActionByte))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.GasPerAction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerAction))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.GasPerAgent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerAgent))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.MaxNestingDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNestingDepth))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.DeniedMessageTypes) > 0 {
+			for iNdEx := len(x.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedMessageTypes[iNdEx])
+				copy(dAtA[i:], x.DeniedMessageTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedMessageTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.AllowedMessageTypes) > 0 {
+			for iNdEx := len(x.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedMessageTypes[iNdEx])
+				copy(dAtA[i:], x.AllowedMessageTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedMessageTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.MaxActionSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActionSize))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxAgentsPerExec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAgentsPerExec))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActions))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxPostActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPostActions))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxPreActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPreActions))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.BurnExpiredDeposits {
+			i--
+			if x.BurnExpiredDeposits {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MinProposalDeposit) > 0 {
+			for iNdEx := len(x.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinProposalDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.ExecFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecFeeBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ExecFeeFlat != nil {
+			encoded, err := options.Marshal(x.ExecFeeFlat)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxMetadataLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataLength))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState_Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
 			fieldNum := int32(wire >> 3)
@@ -1142,6 +1895,407 @@ func (x *fastReflection_GenesisState_Params) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecFeeFlat == nil {
+					x.ExecFeeFlat = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecFeeFlat); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
+				}
+				x.ExecFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinProposalDeposit = append(x.MinProposalDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinProposalDeposit[len(x.MinProposalDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnExpiredDeposits = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
+				}
+				x.MaxPreActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPreActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
+				}
+				x.MaxPostActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPostActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
+				}
+				x.MaxActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+				}
+				x.MaxAgentsPerExec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+				}
+				x.MaxActionSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActionSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedMessageTypes = append(x.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedMessageTypes = append(x.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+				}
+				x.MaxNestingDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNestingDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+				}
+				x.GasPerAgent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerAgent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+				}
+				x.GasPerAction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerAction |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+				}
+				x.GasPerActionByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerActionByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+				}
+				x.MaxPrunesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+				}
+				x.MaxRefundActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRefundActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
+				}
+				x.MaxSimulateGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSimulateGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1763,13 +2917,172 @@ func (x *_GenesisState_Proposal_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_Proposal_6_list)(nil)
+
+type _GenesisState_Proposal_6_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_GenesisState_Proposal_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_Proposal_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_Proposal_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_Proposal_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_Proposal_6_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_Proposal_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_Proposal_6_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_Proposal_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_Proposal_10_list)(nil)
+
+type _GenesisState_Proposal_10_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_Proposal_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_Proposal_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_Proposal_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_Proposal_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_Proposal_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState_Proposal at list field Executors as it is not of Message kind"))
+}
+
+func (x *_GenesisState_Proposal_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_Proposal_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_Proposal_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_Proposal_12_list)(nil)
+
+type _GenesisState_Proposal_12_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_GenesisState_Proposal_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_Proposal_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_Proposal_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_Proposal_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_Proposal_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_Proposal_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_Proposal_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_Proposal_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState_Proposal              protoreflect.MessageDescriptor
-	fd_GenesisState_Proposal_agent        protoreflect.FieldDescriptor
-	fd_GenesisState_Proposal_proposer     protoreflect.FieldDescriptor
-	fd_GenesisState_Proposal_pre_actions  protoreflect.FieldDescriptor
-	fd_GenesisState_Proposal_post_actions protoreflect.FieldDescriptor
-	fd_GenesisState_Proposal_metadata     protoreflect.FieldDescriptor
+	md_GenesisState_Proposal                   protoreflect.MessageDescriptor
+	fd_GenesisState_Proposal_agent             protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_proposer          protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_pre_actions       protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_post_actions      protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_metadata          protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_refund_actions    protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_expire_height     protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_expire_time       protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_quantity          protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_executors         protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_executor_group_id protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_deposit           protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_max_exec_gas      protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_submit_height     protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_submit_time       protoreflect.FieldDescriptor
+	fd_GenesisState_Proposal_refund_failed     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1780,6 +3093,17 @@ func init() {
 	fd_GenesisState_Proposal_pre_actions = md_GenesisState_Proposal.Fields().ByName("pre_actions")
 	fd_GenesisState_Proposal_post_actions = md_GenesisState_Proposal.Fields().ByName("post_actions")
 	fd_GenesisState_Proposal_metadata = md_GenesisState_Proposal.Fields().ByName("metadata")
+	fd_GenesisState_Proposal_refund_actions = md_GenesisState_Proposal.Fields().ByName("refund_actions")
+	fd_GenesisState_Proposal_expire_height = md_GenesisState_Proposal.Fields().ByName("expire_height")
+	fd_GenesisState_Proposal_expire_time = md_GenesisState_Proposal.Fields().ByName("expire_time")
+	fd_GenesisState_Proposal_quantity = md_GenesisState_Proposal.Fields().ByName("quantity")
+	fd_GenesisState_Proposal_executors = md_GenesisState_Proposal.Fields().ByName("executors")
+	fd_GenesisState_Proposal_executor_group_id = md_GenesisState_Proposal.Fields().ByName("executor_group_id")
+	fd_GenesisState_Proposal_deposit = md_GenesisState_Proposal.Fields().ByName("deposit")
+	fd_GenesisState_Proposal_max_exec_gas = md_GenesisState_Proposal.Fields().ByName("max_exec_gas")
+	fd_GenesisState_Proposal_submit_height = md_GenesisState_Proposal.Fields().ByName("submit_height")
+	fd_GenesisState_Proposal_submit_time = md_GenesisState_Proposal.Fields().ByName("submit_time")
+	fd_GenesisState_Proposal_refund_failed = md_GenesisState_Proposal.Fields().ByName("refund_failed")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState_Proposal)(nil)
@@ -1877,6 +3201,72 @@ func (x *fastReflection_GenesisState_Proposal) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if len(x.RefundActions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_Proposal_6_list{list: &x.RefundActions})
+		if !f(fd_GenesisState_Proposal_refund_actions, value) {
+			return
+		}
+	}
+	if x.ExpireHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpireHeight)
+		if !f(fd_GenesisState_Proposal_expire_height, value) {
+			return
+		}
+	}
+	if x.ExpireTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpireTime.ProtoReflect())
+		if !f(fd_GenesisState_Proposal_expire_time, value) {
+			return
+		}
+	}
+	if x.Quantity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Quantity)
+		if !f(fd_GenesisState_Proposal_quantity, value) {
+			return
+		}
+	}
+	if len(x.Executors) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_Proposal_10_list{list: &x.Executors})
+		if !f(fd_GenesisState_Proposal_executors, value) {
+			return
+		}
+	}
+	if x.ExecutorGroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutorGroupId)
+		if !f(fd_GenesisState_Proposal_executor_group_id, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_Proposal_12_list{list: &x.Deposit})
+		if !f(fd_GenesisState_Proposal_deposit, value) {
+			return
+		}
+	}
+	if x.MaxExecGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxExecGas)
+		if !f(fd_GenesisState_Proposal_max_exec_gas, value) {
+			return
+		}
+	}
+	if x.SubmitHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SubmitHeight)
+		if !f(fd_GenesisState_Proposal_submit_height, value) {
+			return
+		}
+	}
+	if x.SubmitTime != nil {
+		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+		if !f(fd_GenesisState_Proposal_submit_time, value) {
+			return
+		}
+	}
+	if x.RefundFailed != false {
+		value := protoreflect.ValueOfBool(x.RefundFailed)
+		if !f(fd_GenesisState_Proposal_refund_failed, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1902,6 +3292,28 @@ func (x *fastReflection_GenesisState_Proposal) Has(fd protoreflect.FieldDescript
 		return len(x.PostActions) != 0
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.metadata":
 		return x.Metadata != ""
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_actions":
+		return len(x.RefundActions) != 0
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_height":
+		return x.ExpireHeight != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_time":
+		return x.ExpireTime != nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.quantity":
+		return x.Quantity != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executors":
+		return len(x.Executors) != 0
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executor_group_id":
+		return x.ExecutorGroupId != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.deposit":
+		return len(x.Deposit) != 0
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.max_exec_gas":
+		return x.MaxExecGas != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_height":
+		return x.SubmitHeight != uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_time":
+		return x.SubmitTime != nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_failed":
+		return x.RefundFailed != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Proposal"))
@@ -1928,6 +3340,28 @@ func (x *fastReflection_GenesisState_Proposal) Clear(fd protoreflect.FieldDescri
 		x.PostActions = nil
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.metadata":
 		x.Metadata = ""
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_actions":
+		x.RefundActions = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_height":
+		x.ExpireHeight = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_time":
+		x.ExpireTime = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.quantity":
+		x.Quantity = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executors":
+		x.Executors = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executor_group_id":
+		x.ExecutorGroupId = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.deposit":
+		x.Deposit = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.max_exec_gas":
+		x.MaxExecGas = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_height":
+		x.SubmitHeight = uint64(0)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_time":
+		x.SubmitTime = nil
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_failed":
+		x.RefundFailed = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Proposal"))
@@ -1965,6 +3399,48 @@ func (x *fastReflection_GenesisState_Proposal) Get(descriptor protoreflect.Field
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.metadata":
 		value := x.Metadata
 		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_actions":
+		if len(x.RefundActions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_Proposal_6_list{})
+		}
+		listValue := &_GenesisState_Proposal_6_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_height":
+		value := x.ExpireHeight
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_time":
+		value := x.ExpireTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executors":
+		if len(x.Executors) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_Proposal_10_list{})
+		}
+		listValue := &_GenesisState_Proposal_10_list{list: &x.Executors}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executor_group_id":
+		value := x.ExecutorGroupId
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_Proposal_12_list{})
+		}
+		listValue := &_GenesisState_Proposal_12_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.max_exec_gas":
+		value := x.MaxExecGas
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_height":
+		value := x.SubmitHeight
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_time":
+		value := x.SubmitTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_failed":
+		value := x.RefundFailed
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Proposal"))
@@ -1999,6 +3475,34 @@ func (x *fastReflection_GenesisState_Proposal) Set(fd protoreflect.FieldDescript
 		x.PostActions = *clv.list
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.metadata":
 		x.Metadata = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_actions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_Proposal_6_list)
+		x.RefundActions = *clv.list
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_height":
+		x.ExpireHeight = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_time":
+		x.ExpireTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.quantity":
+		x.Quantity = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executors":
+		lv := value.List()
+		clv := lv.(*_GenesisState_Proposal_10_list)
+		x.Executors = *clv.list
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executor_group_id":
+		x.ExecutorGroupId = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.deposit":
+		lv := value.List()
+		clv := lv.(*_GenesisState_Proposal_12_list)
+		x.Deposit = *clv.list
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.max_exec_gas":
+		x.MaxExecGas = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_height":
+		x.SubmitHeight = value.Uint()
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_time":
+		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_failed":
+		x.RefundFailed = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Proposal"))
@@ -2031,12 +3535,52 @@ func (x *fastReflection_GenesisState_Proposal) Mutable(fd protoreflect.FieldDesc
 		}
 		value := &_GenesisState_Proposal_4_list{list: &x.PostActions}
 		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_actions":
+		if x.RefundActions == nil {
+			x.RefundActions = []*anypb.Any{}
+		}
+		value := &_GenesisState_Proposal_6_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_time":
+		if x.ExpireTime == nil {
+			x.ExpireTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpireTime.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executors":
+		if x.Executors == nil {
+			x.Executors = []string{}
+		}
+		value := &_GenesisState_Proposal_10_list{list: &x.Executors}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_Proposal_12_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_time":
+		if x.SubmitTime == nil {
+			x.SubmitTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.agent":
 		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.proposer":
 		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.metadata":
 		panic(fmt.Errorf("field metadata of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_height":
+		panic(fmt.Errorf("field expire_height of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.quantity":
+		panic(fmt.Errorf("field quantity of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executor_group_id":
+		panic(fmt.Errorf("field executor_group_id of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.max_exec_gas":
+		panic(fmt.Errorf("field max_exec_gas of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_height":
+		panic(fmt.Errorf("field submit_height of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_failed":
+		panic(fmt.Errorf("field refund_failed of message andromeda.escrow.v1alpha1.GenesisState.Proposal is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Proposal"))
@@ -2062,6 +3606,33 @@ func (x *fastReflection_GenesisState_Proposal) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfList(&_GenesisState_Proposal_4_list{list: &list})
 	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.metadata":
 		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_GenesisState_Proposal_6_list{list: &list})
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.quantity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_Proposal_10_list{list: &list})
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.executor_group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_Proposal_12_list{list: &list})
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.max_exec_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_failed":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.GenesisState.Proposal"))
@@ -2151,38 +3722,188 @@ func (x *fastReflection_GenesisState_Proposal) ProtoMethods() *protoiface.Method
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefundActions) > 0 {
+			for _, e := range x.RefundActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpireHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpireHeight))
+		}
+		if x.ExpireTime != nil {
+			l = options.Size(x.ExpireTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Quantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quantity))
+		}
+		if len(x.Executors) > 0 {
+			for _, s := range x.Executors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExecutorGroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorGroupId))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxExecGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxExecGas))
+		}
+		if x.SubmitHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmitHeight))
+		}
+		if x.SubmitTime != nil {
+			l = options.Size(x.SubmitTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RefundFailed {
+			n += 3
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState_Proposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RefundFailed {
+			i--
+			if x.RefundFailed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.SubmitTime != nil {
+			encoded, err := options.Marshal(x.SubmitTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.SubmitHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmitHeight))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.MaxExecGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExecGas))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.ExecutorGroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorGroupId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Executors) > 0 {
+			for iNdEx := len(x.Executors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Executors[iNdEx])
+				copy(dAtA[i:], x.Executors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executors[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if x.Quantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quantity))
+			i--
+			dAtA[i] = 0x48
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if x.ExpireTime != nil {
+			encoded, err := options.Marshal(x.ExpireTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
 		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState_Proposal)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
+		if x.ExpireHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpireHeight))
+			i--
+			dAtA[i] = 0x38
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		if len(x.RefundActions) > 0 {
+			for iNdEx := len(x.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
 		}
 		if len(x.Metadata) > 0 {
 			i -= len(x.Metadata)
@@ -2288,9 +4009,226 @@ func (x *fastReflection_GenesisState_Proposal) ProtoMethods() *protoiface.Method
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreActions = append(x.PreActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostActions = append(x.PostActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActions[len(x.PostActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActions = append(x.RefundActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActions[len(x.RefundActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+				}
+				x.ExpireHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpireHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2300,27 +4238,50 @@ func (x *fastReflection_GenesisState_Proposal) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Agent = string(dAtA[iNdEx:postIndex])
+				if x.ExpireTime == nil {
+					x.ExpireTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpireTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 2:
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				x.Quantity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Quantity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2348,11 +4309,30 @@ func (x *fastReflection_GenesisState_Proposal) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Proposer = string(dAtA[iNdEx:postIndex])
+				x.Executors = append(x.Executors, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 3:
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+				}
+				x.ExecutorGroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorGroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2379,14 +4359,52 @@ func (x *fastReflection_GenesisState_Proposal) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreActions = append(x.PreActions, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+				}
+				x.MaxExecGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxExecGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+				}
+				x.SubmitHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmitHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2413,16 +4431,18 @@ func (x *fastReflection_GenesisState_Proposal) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PostActions = append(x.PostActions, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActions[len(x.PostActions)-1]); err != nil {
+				if x.SubmitTime == nil {
+					x.SubmitTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2432,24 +4452,12 @@ func (x *fastReflection_GenesisState_Proposal) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
+				x.RefundFailed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2570,6 +4578,59 @@ type GenesisState_Params struct {
 
 	// the maximum length allowed for metadata
 	MaxMetadataLength uint64 `protobuf:"varint,1,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *v1beta1.Coin `protobuf:"bytes,2,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,3,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3" json:"min_proposal_deposit,omitempty"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,5,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,6,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,7,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,8,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,9,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,10,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,11,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,12,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,13,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,14,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,15,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,16,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,17,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,18,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,19,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
 }
 
 func (x *GenesisState_Params) Reset() {
@@ -2599,6 +4660,132 @@ func (x *GenesisState_Params) GetMaxMetadataLength() uint64 {
 	return 0
 }
 
+func (x *GenesisState_Params) GetExecFeeFlat() *v1beta1.Coin {
+	if x != nil {
+		return x.ExecFeeFlat
+	}
+	return nil
+}
+
+func (x *GenesisState_Params) GetExecFeeBps() uint32 {
+	if x != nil {
+		return x.ExecFeeBps
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMinProposalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinProposalDeposit
+	}
+	return nil
+}
+
+func (x *GenesisState_Params) GetBurnExpiredDeposits() bool {
+	if x != nil {
+		return x.BurnExpiredDeposits
+	}
+	return false
+}
+
+func (x *GenesisState_Params) GetMaxPreActions() uint64 {
+	if x != nil {
+		return x.MaxPreActions
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMaxPostActions() uint64 {
+	if x != nil {
+		return x.MaxPostActions
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMaxActions() uint64 {
+	if x != nil {
+		return x.MaxActions
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMaxAgentsPerExec() uint64 {
+	if x != nil {
+		return x.MaxAgentsPerExec
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMaxActionSize() uint64 {
+	if x != nil {
+		return x.MaxActionSize
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetAllowedMessageTypes() []string {
+	if x != nil {
+		return x.AllowedMessageTypes
+	}
+	return nil
+}
+
+func (x *GenesisState_Params) GetDeniedMessageTypes() []string {
+	if x != nil {
+		return x.DeniedMessageTypes
+	}
+	return nil
+}
+
+func (x *GenesisState_Params) GetMaxNestingDepth() uint64 {
+	if x != nil {
+		return x.MaxNestingDepth
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetGasPerAgent() uint64 {
+	if x != nil {
+		return x.GasPerAgent
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetGasPerAction() uint64 {
+	if x != nil {
+		return x.GasPerAction
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetGasPerActionByte() uint64 {
+	if x != nil {
+		return x.GasPerActionByte
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMaxPrunesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMaxRefundActions() uint64 {
+	if x != nil {
+		return x.MaxRefundActions
+	}
+	return 0
+}
+
+func (x *GenesisState_Params) GetMaxSimulateGas() uint64 {
+	if x != nil {
+		return x.MaxSimulateGas
+	}
+	return 0
+}
+
 // Agent defines an account taking charge of a proposal.
 type GenesisState_Agent struct {
 	state         protoimpl.MessageState
@@ -2661,6 +4848,38 @@ type GenesisState_Proposal struct {
 	PostActions []*anypb.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
 	// any arbitrary metadata attached to the proposal
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	RefundActions []*anypb.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// the remaining quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the deposit held by the module
+	Deposit []*v1beta1.Coin `protobuf:"bytes,12,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,13,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
+	// the block height at which the proposal has been submitted
+	SubmitHeight uint64 `protobuf:"varint,14,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// the block time at which the proposal has been submitted
+	SubmitTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=submit_time,json=submitTime,proto3" json:"submit_time,omitempty"`
+	// whether the refund_actions failed on the expiry of the proposal
+	// Note: such a proposal is left out of the pruning, so that its proposer may
+	// replace the refund_actions and cancel it.
+	RefundFailed bool `protobuf:"varint,16,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
 }
 
 func (x *GenesisState_Proposal) Reset() {
@@ -2718,6 +4937,83 @@ func (x *GenesisState_Proposal) GetMetadata() string {
 	return ""
 }
 
+func (x *GenesisState_Proposal) GetRefundActions() []*anypb.Any {
+	if x != nil {
+		return x.RefundActions
+	}
+	return nil
+}
+
+func (x *GenesisState_Proposal) GetExpireHeight() uint64 {
+	if x != nil {
+		return x.ExpireHeight
+	}
+	return 0
+}
+
+func (x *GenesisState_Proposal) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *GenesisState_Proposal) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GenesisState_Proposal) GetExecutors() []string {
+	if x != nil {
+		return x.Executors
+	}
+	return nil
+}
+
+func (x *GenesisState_Proposal) GetExecutorGroupId() uint64 {
+	if x != nil {
+		return x.ExecutorGroupId
+	}
+	return 0
+}
+
+func (x *GenesisState_Proposal) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *GenesisState_Proposal) GetMaxExecGas() uint64 {
+	if x != nil {
+		return x.MaxExecGas
+	}
+	return 0
+}
+
+func (x *GenesisState_Proposal) GetSubmitHeight() uint64 {
+	if x != nil {
+		return x.SubmitHeight
+	}
+	return 0
+}
+
+func (x *GenesisState_Proposal) GetSubmitTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmitTime
+	}
+	return nil
+}
+
+func (x *GenesisState_Proposal) GetRefundFailed() bool {
+	if x != nil {
+		return x.RefundFailed
+	}
+	return false
+}
+
 var File_andromeda_escrow_v1alpha1_genesis_proto protoreflect.FileDescriptor
 
 var file_andromeda_escrow_v1alpha1_genesis_proto_rawDesc = []byte{
@@ -2725,68 +5021,165 @@ var file_andromeda_escrow_v1alpha1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65,
 	0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x61, 0x6e, 0x64, 0x72, 0x6f,
 	0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x68, 0x61, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x05, 0x0a, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x6e,
-	0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xe4, 0x10, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65,
 	0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61,
-	0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x1a, 0x38, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x1a, 0x6f, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x6e, 0x65, 0x78, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6e, 0x64, 0x72,
+	0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x4e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e,
+	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x1a, 0xaa, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x61, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x0d, 0x65,
+	0x78, 0x65, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0b, 0x65,
+	0x78, 0x65, 0x63, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x78,
+	0x65, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x12, 0x7d, 0x0a, 0x14,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x75, 0x72, 0x6e,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x45, 0x78, 0x65,
+	0x63, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x79, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x1a, 0x6f, 0x0a,
+	0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0xb7,
+	0x06, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x05, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
 	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a,
+	0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x41, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x36,
+	0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
 	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x1a, 0xfc, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
-	0x6e, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37,
-	0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x42, 0xe1, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x6e, 0x64, 0x72,
-	0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x64, 0x72, 0x6f,
-	0x6d, 0x65, 0x64, 0x61, 0x2f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72,
-	0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x56, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64,
-	0x61, 0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0xe2, 0x02, 0x25, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c, 0x45, 0x73,
-	0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x41, 0x6e, 0x64, 0x72,
-	0x6f, 0x6d, 0x65, 0x64, 0x61, 0x3a, 0x3a, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x3a, 0x3a, 0x56,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x65, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x47, 0x61, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x41, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0xe1, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d,
+	0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x45, 0x58, 0xaa, 0x02,
+	0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x19, 0x41, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65,
+	0x64, 0x61, 0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x1b, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x3a, 0x3a, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2807,19 +5200,27 @@ var file_andromeda_escrow_v1alpha1_genesis_proto_goTypes = []interface{}{
 	(*GenesisState_Params)(nil),   // 1: andromeda.escrow.v1alpha1.GenesisState.Params
 	(*GenesisState_Agent)(nil),    // 2: andromeda.escrow.v1alpha1.GenesisState.Agent
 	(*GenesisState_Proposal)(nil), // 3: andromeda.escrow.v1alpha1.GenesisState.Proposal
-	(*anypb.Any)(nil),             // 4: google.protobuf.Any
+	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
+	(*anypb.Any)(nil),             // 5: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_andromeda_escrow_v1alpha1_genesis_proto_depIdxs = []int32{
-	1, // 0: andromeda.escrow.v1alpha1.GenesisState.params:type_name -> andromeda.escrow.v1alpha1.GenesisState.Params
-	2, // 1: andromeda.escrow.v1alpha1.GenesisState.agents:type_name -> andromeda.escrow.v1alpha1.GenesisState.Agent
-	3, // 2: andromeda.escrow.v1alpha1.GenesisState.proposals:type_name -> andromeda.escrow.v1alpha1.GenesisState.Proposal
-	4, // 3: andromeda.escrow.v1alpha1.GenesisState.Proposal.pre_actions:type_name -> google.protobuf.Any
-	4, // 4: andromeda.escrow.v1alpha1.GenesisState.Proposal.post_actions:type_name -> google.protobuf.Any
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: andromeda.escrow.v1alpha1.GenesisState.params:type_name -> andromeda.escrow.v1alpha1.GenesisState.Params
+	2,  // 1: andromeda.escrow.v1alpha1.GenesisState.agents:type_name -> andromeda.escrow.v1alpha1.GenesisState.Agent
+	3,  // 2: andromeda.escrow.v1alpha1.GenesisState.proposals:type_name -> andromeda.escrow.v1alpha1.GenesisState.Proposal
+	4,  // 3: andromeda.escrow.v1alpha1.GenesisState.Params.exec_fee_flat:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 4: andromeda.escrow.v1alpha1.GenesisState.Params.min_proposal_deposit:type_name -> cosmos.base.v1beta1.Coin
+	5,  // 5: andromeda.escrow.v1alpha1.GenesisState.Proposal.pre_actions:type_name -> google.protobuf.Any
+	5,  // 6: andromeda.escrow.v1alpha1.GenesisState.Proposal.post_actions:type_name -> google.protobuf.Any
+	5,  // 7: andromeda.escrow.v1alpha1.GenesisState.Proposal.refund_actions:type_name -> google.protobuf.Any
+	6,  // 8: andromeda.escrow.v1alpha1.GenesisState.Proposal.expire_time:type_name -> google.protobuf.Timestamp
+	4,  // 9: andromeda.escrow.v1alpha1.GenesisState.Proposal.deposit:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 10: andromeda.escrow.v1alpha1.GenesisState.Proposal.submit_time:type_name -> google.protobuf.Timestamp
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_andromeda_escrow_v1alpha1_genesis_proto_init() }
@@ -19,13 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName              = "/andromeda.escrow.v1alpha1.Query/Params"
-	Query_Agent_FullMethodName               = "/andromeda.escrow.v1alpha1.Query/Agent"
-	Query_AgentsByCreator_FullMethodName     = "/andromeda.escrow.v1alpha1.Query/AgentsByCreator"
-	Query_Agents_FullMethodName              = "/andromeda.escrow.v1alpha1.Query/Agents"
-	Query_Proposal_FullMethodName            = "/andromeda.escrow.v1alpha1.Query/Proposal"
-	Query_ProposalsByProposer_FullMethodName = "/andromeda.escrow.v1alpha1.Query/ProposalsByProposer"
-	Query_Proposals_FullMethodName           = "/andromeda.escrow.v1alpha1.Query/Proposals"
+	Query_Params_FullMethodName                  = "/andromeda.escrow.v1alpha1.Query/Params"
+	Query_Agent_FullMethodName                   = "/andromeda.escrow.v1alpha1.Query/Agent"
+	Query_AgentsByCreator_FullMethodName         = "/andromeda.escrow.v1alpha1.Query/AgentsByCreator"
+	Query_Agents_FullMethodName                  = "/andromeda.escrow.v1alpha1.Query/Agents"
+	Query_Proposal_FullMethodName                = "/andromeda.escrow.v1alpha1.Query/Proposal"
+	Query_ProposalsByProposer_FullMethodName     = "/andromeda.escrow.v1alpha1.Query/ProposalsByProposer"
+	Query_ProposalsByMessageType_FullMethodName  = "/andromeda.escrow.v1alpha1.Query/ProposalsByMessageType"
+	Query_ProposalsByOfferedAsset_FullMethodName = "/andromeda.escrow.v1alpha1.Query/ProposalsByOfferedAsset"
+	Query_ProposalsByAskedAsset_FullMethodName   = "/andromeda.escrow.v1alpha1.Query/ProposalsByAskedAsset"
+	Query_Proposals_FullMethodName               = "/andromeda.escrow.v1alpha1.Query/Proposals"
+	Query_SimulateExec_FullMethodName            = "/andromeda.escrow.v1alpha1.Query/SimulateExec"
+	Query_SimulateSubmit_FullMethodName          = "/andromeda.escrow.v1alpha1.Query/SimulateSubmit"
 )
 
 // QueryClient is the client API for Query service.
@@ -44,8 +49,22 @@ type QueryClient interface {
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
 	// ProposalsByProposer queries all the proposals by its proposer.
 	ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error)
+	// ProposalsByMessageType queries all the proposals by the type of the
+	// messages in their actions.
+	ProposalsByMessageType(ctx context.Context, in *QueryProposalsByMessageTypeRequest, opts ...grpc.CallOption) (*QueryProposalsByMessageTypeResponse, error)
+	// ProposalsByOfferedAsset queries all the proposals offering an asset in
+	// their pre-actions.
+	ProposalsByOfferedAsset(ctx context.Context, in *QueryProposalsByOfferedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByOfferedAssetResponse, error)
+	// ProposalsByAskedAsset queries all the proposals asking an asset in their
+	// post-actions.
+	ProposalsByAskedAsset(ctx context.Context, in *QueryProposalsByAskedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByAskedAssetResponse, error)
 	// Proposals queries all the proposals.
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// SimulateExec simulates Msg/Exec without committing its result.
+	SimulateExec(ctx context.Context, in *QuerySimulateExecRequest, opts ...grpc.CallOption) (*QuerySimulateExecResponse, error)
+	// SimulateSubmit simulates Msg/SubmitProposal without committing its
+	// result.
+	SimulateSubmit(ctx context.Context, in *QuerySimulateSubmitRequest, opts ...grpc.CallOption) (*QuerySimulateSubmitResponse, error)
 }
 
 type queryClient struct {
@@ -110,6 +129,33 @@ func (c *queryClient) ProposalsByProposer(ctx context.Context, in *QueryProposal
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByMessageType(ctx context.Context, in *QueryProposalsByMessageTypeRequest, opts ...grpc.CallOption) (*QueryProposalsByMessageTypeResponse, error) {
+	out := new(QueryProposalsByMessageTypeResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByMessageType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByOfferedAsset(ctx context.Context, in *QueryProposalsByOfferedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByOfferedAssetResponse, error) {
+	out := new(QueryProposalsByOfferedAssetResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByOfferedAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByAskedAsset(ctx context.Context, in *QueryProposalsByAskedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByAskedAssetResponse, error) {
+	out := new(QueryProposalsByAskedAssetResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByAskedAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
 	out := new(QueryProposalsResponse)
 	err := c.cc.Invoke(ctx, Query_Proposals_FullMethodName, in, out, opts...)
@@ -119,6 +165,24 @@ func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest,
 	return out, nil
 }
 
+func (c *queryClient) SimulateExec(ctx context.Context, in *QuerySimulateExecRequest, opts ...grpc.CallOption) (*QuerySimulateExecResponse, error) {
+	out := new(QuerySimulateExecResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateExec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSubmit(ctx context.Context, in *QuerySimulateSubmitRequest, opts ...grpc.CallOption) (*QuerySimulateSubmitResponse, error) {
+	out := new(QuerySimulateSubmitResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateSubmit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -135,8 +199,22 @@ type QueryServer interface {
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	// ProposalsByProposer queries all the proposals by its proposer.
 	ProposalsByProposer(context.Context, *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error)
+	// ProposalsByMessageType queries all the proposals by the type of the
+	// messages in their actions.
+	ProposalsByMessageType(context.Context, *QueryProposalsByMessageTypeRequest) (*QueryProposalsByMessageTypeResponse, error)
+	// ProposalsByOfferedAsset queries all the proposals offering an asset in
+	// their pre-actions.
+	ProposalsByOfferedAsset(context.Context, *QueryProposalsByOfferedAssetRequest) (*QueryProposalsByOfferedAssetResponse, error)
+	// ProposalsByAskedAsset queries all the proposals asking an asset in their
+	// post-actions.
+	ProposalsByAskedAsset(context.Context, *QueryProposalsByAskedAssetRequest) (*QueryProposalsByAskedAssetResponse, error)
 	// Proposals queries all the proposals.
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// SimulateExec simulates Msg/Exec without committing its result.
+	SimulateExec(context.Context, *QuerySimulateExecRequest) (*QuerySimulateExecResponse, error)
+	// SimulateSubmit simulates Msg/SubmitProposal without committing its
+	// result.
+	SimulateSubmit(context.Context, *QuerySimulateSubmitRequest) (*QuerySimulateSubmitResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -162,9 +240,24 @@ func (UnimplementedQueryServer) Proposal(context.Context, *QueryProposalRequest)
 func (UnimplementedQueryServer) ProposalsByProposer(context.Context, *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByProposer not implemented")
 }
+func (UnimplementedQueryServer) ProposalsByMessageType(context.Context, *QueryProposalsByMessageTypeRequest) (*QueryProposalsByMessageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByMessageType not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByOfferedAsset(context.Context, *QueryProposalsByOfferedAssetRequest) (*QueryProposalsByOfferedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByOfferedAsset not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByAskedAsset(context.Context, *QueryProposalsByAskedAssetRequest) (*QueryProposalsByAskedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByAskedAsset not implemented")
+}
 func (UnimplementedQueryServer) Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
 }
+func (UnimplementedQueryServer) SimulateExec(context.Context, *QuerySimulateExecRequest) (*QuerySimulateExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateExec not implemented")
+}
+func (UnimplementedQueryServer) SimulateSubmit(context.Context, *QuerySimulateSubmitRequest) (*QuerySimulateSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSubmit not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -286,6 +379,60 @@ func _Query_ProposalsByProposer_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByMessageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByMessageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByMessageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByMessageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByMessageType(ctx, req.(*QueryProposalsByMessageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByOfferedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByOfferedAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByOfferedAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByOfferedAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByOfferedAsset(ctx, req.(*QueryProposalsByOfferedAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByAskedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByAskedAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByAskedAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByAskedAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByAskedAsset(ctx, req.(*QueryProposalsByAskedAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProposalsRequest)
 	if err := dec(in); err != nil {
@@ -304,6 +451,42 @@ func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateExec(ctx, req.(*QuerySimulateExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateSubmit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSubmit(ctx, req.(*QuerySimulateSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -335,10 +518,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProposalsByProposer",
 			Handler:    _Query_ProposalsByProposer_Handler,
 		},
+		{
+			MethodName: "ProposalsByMessageType",
+			Handler:    _Query_ProposalsByMessageType_Handler,
+		},
+		{
+			MethodName: "ProposalsByOfferedAsset",
+			Handler:    _Query_ProposalsByOfferedAsset_Handler,
+		},
+		{
+			MethodName: "ProposalsByAskedAsset",
+			Handler:    _Query_ProposalsByAskedAsset_Handler,
+		},
 		{
 			MethodName: "Proposals",
 			Handler:    _Query_Proposals_Handler,
 		},
+		{
+			MethodName: "SimulateExec",
+			Handler:    _Query_SimulateExec_Handler,
+		},
+		{
+			MethodName: "SimulateSubmit",
+			Handler:    _Query_SimulateSubmit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "andromeda/escrow/v1alpha1/query.proto",
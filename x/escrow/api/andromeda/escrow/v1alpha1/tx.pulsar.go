@@ -2,23 +2,187 @@
 package escrowv1alpha1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_MsgUpdateParams_5_list)(nil)
+
+type _MsgUpdateParams_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgUpdateParams_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateParams_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateParams_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateParams_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateParams_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateParams_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateParams_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateParams_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdateParams_12_list)(nil)
+
+type _MsgUpdateParams_12_list struct {
+	list *[]string
+}
+
+func (x *_MsgUpdateParams_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateParams_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgUpdateParams_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateParams_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateParams_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgUpdateParams at list field AllowedMessageTypes as it is not of Message kind"))
+}
+
+func (x *_MsgUpdateParams_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateParams_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgUpdateParams_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdateParams_13_list)(nil)
+
+type _MsgUpdateParams_13_list struct {
+	list *[]string
+}
+
+func (x *_MsgUpdateParams_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateParams_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgUpdateParams_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateParams_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateParams_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgUpdateParams at list field DeniedMessageTypes as it is not of Message kind"))
+}
+
+func (x *_MsgUpdateParams_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateParams_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgUpdateParams_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateParams                     protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority           protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_max_metadata_length protoreflect.FieldDescriptor
+	md_MsgUpdateParams                       protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority             protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_metadata_length   protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_exec_fee_flat         protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_exec_fee_bps          protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_min_proposal_deposit  protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_burn_expired_deposits protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_pre_actions       protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_post_actions      protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_actions           protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_agents_per_exec   protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_action_size       protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_allowed_message_types protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_denied_message_types  protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_nesting_depth     protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_gas_per_agent         protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_gas_per_action        protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_gas_per_action_byte   protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_prunes_per_block  protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_refund_actions    protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_max_simulate_gas      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +190,24 @@ func init() {
 	md_MsgUpdateParams = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgUpdateParams")
 	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
 	fd_MsgUpdateParams_max_metadata_length = md_MsgUpdateParams.Fields().ByName("max_metadata_length")
+	fd_MsgUpdateParams_exec_fee_flat = md_MsgUpdateParams.Fields().ByName("exec_fee_flat")
+	fd_MsgUpdateParams_exec_fee_bps = md_MsgUpdateParams.Fields().ByName("exec_fee_bps")
+	fd_MsgUpdateParams_min_proposal_deposit = md_MsgUpdateParams.Fields().ByName("min_proposal_deposit")
+	fd_MsgUpdateParams_burn_expired_deposits = md_MsgUpdateParams.Fields().ByName("burn_expired_deposits")
+	fd_MsgUpdateParams_max_pre_actions = md_MsgUpdateParams.Fields().ByName("max_pre_actions")
+	fd_MsgUpdateParams_max_post_actions = md_MsgUpdateParams.Fields().ByName("max_post_actions")
+	fd_MsgUpdateParams_max_actions = md_MsgUpdateParams.Fields().ByName("max_actions")
+	fd_MsgUpdateParams_max_agents_per_exec = md_MsgUpdateParams.Fields().ByName("max_agents_per_exec")
+	fd_MsgUpdateParams_max_action_size = md_MsgUpdateParams.Fields().ByName("max_action_size")
+	fd_MsgUpdateParams_allowed_message_types = md_MsgUpdateParams.Fields().ByName("allowed_message_types")
+	fd_MsgUpdateParams_denied_message_types = md_MsgUpdateParams.Fields().ByName("denied_message_types")
+	fd_MsgUpdateParams_max_nesting_depth = md_MsgUpdateParams.Fields().ByName("max_nesting_depth")
+	fd_MsgUpdateParams_gas_per_agent = md_MsgUpdateParams.Fields().ByName("gas_per_agent")
+	fd_MsgUpdateParams_gas_per_action = md_MsgUpdateParams.Fields().ByName("gas_per_action")
+	fd_MsgUpdateParams_gas_per_action_byte = md_MsgUpdateParams.Fields().ByName("gas_per_action_byte")
+	fd_MsgUpdateParams_max_prunes_per_block = md_MsgUpdateParams.Fields().ByName("max_prunes_per_block")
+	fd_MsgUpdateParams_max_refund_actions = md_MsgUpdateParams.Fields().ByName("max_refund_actions")
+	fd_MsgUpdateParams_max_simulate_gas = md_MsgUpdateParams.Fields().ByName("max_simulate_gas")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
@@ -105,6 +287,114 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.ExecFeeFlat != nil {
+		value := protoreflect.ValueOfMessage(x.ExecFeeFlat.ProtoReflect())
+		if !f(fd_MsgUpdateParams_exec_fee_flat, value) {
+			return
+		}
+	}
+	if x.ExecFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExecFeeBps)
+		if !f(fd_MsgUpdateParams_exec_fee_bps, value) {
+			return
+		}
+	}
+	if len(x.MinProposalDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateParams_5_list{list: &x.MinProposalDeposit})
+		if !f(fd_MsgUpdateParams_min_proposal_deposit, value) {
+			return
+		}
+	}
+	if x.BurnExpiredDeposits != false {
+		value := protoreflect.ValueOfBool(x.BurnExpiredDeposits)
+		if !f(fd_MsgUpdateParams_burn_expired_deposits, value) {
+			return
+		}
+	}
+	if x.MaxPreActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPreActions)
+		if !f(fd_MsgUpdateParams_max_pre_actions, value) {
+			return
+		}
+	}
+	if x.MaxPostActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPostActions)
+		if !f(fd_MsgUpdateParams_max_post_actions, value) {
+			return
+		}
+	}
+	if x.MaxActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActions)
+		if !f(fd_MsgUpdateParams_max_actions, value) {
+			return
+		}
+	}
+	if x.MaxAgentsPerExec != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAgentsPerExec)
+		if !f(fd_MsgUpdateParams_max_agents_per_exec, value) {
+			return
+		}
+	}
+	if x.MaxActionSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActionSize)
+		if !f(fd_MsgUpdateParams_max_action_size, value) {
+			return
+		}
+	}
+	if len(x.AllowedMessageTypes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateParams_12_list{list: &x.AllowedMessageTypes})
+		if !f(fd_MsgUpdateParams_allowed_message_types, value) {
+			return
+		}
+	}
+	if len(x.DeniedMessageTypes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateParams_13_list{list: &x.DeniedMessageTypes})
+		if !f(fd_MsgUpdateParams_denied_message_types, value) {
+			return
+		}
+	}
+	if x.MaxNestingDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxNestingDepth)
+		if !f(fd_MsgUpdateParams_max_nesting_depth, value) {
+			return
+		}
+	}
+	if x.GasPerAgent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerAgent)
+		if !f(fd_MsgUpdateParams_gas_per_agent, value) {
+			return
+		}
+	}
+	if x.GasPerAction != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerAction)
+		if !f(fd_MsgUpdateParams_gas_per_action, value) {
+			return
+		}
+	}
+	if x.GasPerActionByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasPerActionByte)
+		if !f(fd_MsgUpdateParams_gas_per_action_byte, value) {
+			return
+		}
+	}
+	if x.MaxPrunesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunesPerBlock)
+		if !f(fd_MsgUpdateParams_max_prunes_per_block, value) {
+			return
+		}
+	}
+	if x.MaxRefundActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRefundActions)
+		if !f(fd_MsgUpdateParams_max_refund_actions, value) {
+			return
+		}
+	}
+	if x.MaxSimulateGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSimulateGas)
+		if !f(fd_MsgUpdateParams_max_simulate_gas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -124,6 +414,42 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 		return x.Authority != ""
 	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_metadata_length":
 		return x.MaxMetadataLength != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_flat":
+		return x.ExecFeeFlat != nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_bps":
+		return x.ExecFeeBps != uint32(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.min_proposal_deposit":
+		return len(x.MinProposalDeposit) != 0
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.burn_expired_deposits":
+		return x.BurnExpiredDeposits != false
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_pre_actions":
+		return x.MaxPreActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_post_actions":
+		return x.MaxPostActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_actions":
+		return x.MaxActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_agents_per_exec":
+		return x.MaxAgentsPerExec != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_action_size":
+		return x.MaxActionSize != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.allowed_message_types":
+		return len(x.AllowedMessageTypes) != 0
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.denied_message_types":
+		return len(x.DeniedMessageTypes) != 0
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_nesting_depth":
+		return x.MaxNestingDepth != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_agent":
+		return x.GasPerAgent != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action":
+		return x.GasPerAction != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action_byte":
+		return x.GasPerActionByte != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_prunes_per_block":
+		return x.MaxPrunesPerBlock != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_refund_actions":
+		return x.MaxRefundActions != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_simulate_gas":
+		return x.MaxSimulateGas != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParams"))
@@ -144,6 +470,42 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 		x.Authority = ""
 	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_metadata_length":
 		x.MaxMetadataLength = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_flat":
+		x.ExecFeeFlat = nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_bps":
+		x.ExecFeeBps = uint32(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.min_proposal_deposit":
+		x.MinProposalDeposit = nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.burn_expired_deposits":
+		x.BurnExpiredDeposits = false
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_pre_actions":
+		x.MaxPreActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_post_actions":
+		x.MaxPostActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_actions":
+		x.MaxActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_agents_per_exec":
+		x.MaxAgentsPerExec = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_action_size":
+		x.MaxActionSize = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.allowed_message_types":
+		x.AllowedMessageTypes = nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.denied_message_types":
+		x.DeniedMessageTypes = nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_nesting_depth":
+		x.MaxNestingDepth = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_agent":
+		x.GasPerAgent = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action":
+		x.GasPerAction = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action_byte":
+		x.GasPerActionByte = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_prunes_per_block":
+		x.MaxPrunesPerBlock = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_refund_actions":
+		x.MaxRefundActions = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_simulate_gas":
+		x.MaxSimulateGas = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParams"))
@@ -166,6 +528,69 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_metadata_length":
 		value := x.MaxMetadataLength
 		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_flat":
+		value := x.ExecFeeFlat
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_bps":
+		value := x.ExecFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.min_proposal_deposit":
+		if len(x.MinProposalDeposit) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateParams_5_list{})
+		}
+		listValue := &_MsgUpdateParams_5_list{list: &x.MinProposalDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.burn_expired_deposits":
+		value := x.BurnExpiredDeposits
+		return protoreflect.ValueOfBool(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_pre_actions":
+		value := x.MaxPreActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_post_actions":
+		value := x.MaxPostActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_actions":
+		value := x.MaxActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_agents_per_exec":
+		value := x.MaxAgentsPerExec
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_action_size":
+		value := x.MaxActionSize
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.allowed_message_types":
+		if len(x.AllowedMessageTypes) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateParams_12_list{})
+		}
+		listValue := &_MsgUpdateParams_12_list{list: &x.AllowedMessageTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.denied_message_types":
+		if len(x.DeniedMessageTypes) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateParams_13_list{})
+		}
+		listValue := &_MsgUpdateParams_13_list{list: &x.DeniedMessageTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_nesting_depth":
+		value := x.MaxNestingDepth
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_agent":
+		value := x.GasPerAgent
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action":
+		value := x.GasPerAction
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action_byte":
+		value := x.GasPerActionByte
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_prunes_per_block":
+		value := x.MaxPrunesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_refund_actions":
+		value := x.MaxRefundActions
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_simulate_gas":
+		value := x.MaxSimulateGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParams"))
@@ -190,6 +615,48 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 		x.Authority = value.Interface().(string)
 	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_metadata_length":
 		x.MaxMetadataLength = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_flat":
+		x.ExecFeeFlat = value.Message().Interface().(*v1beta1.Coin)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_bps":
+		x.ExecFeeBps = uint32(value.Uint())
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.min_proposal_deposit":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateParams_5_list)
+		x.MinProposalDeposit = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.burn_expired_deposits":
+		x.BurnExpiredDeposits = value.Bool()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_pre_actions":
+		x.MaxPreActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_post_actions":
+		x.MaxPostActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_actions":
+		x.MaxActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_agents_per_exec":
+		x.MaxAgentsPerExec = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_action_size":
+		x.MaxActionSize = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.allowed_message_types":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateParams_12_list)
+		x.AllowedMessageTypes = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.denied_message_types":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateParams_13_list)
+		x.DeniedMessageTypes = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_nesting_depth":
+		x.MaxNestingDepth = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_agent":
+		x.GasPerAgent = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action":
+		x.GasPerAction = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action_byte":
+		x.GasPerActionByte = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_prunes_per_block":
+		x.MaxPrunesPerBlock = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_refund_actions":
+		x.MaxRefundActions = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_simulate_gas":
+		x.MaxSimulateGas = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParams"))
@@ -210,10 +677,61 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_flat":
+		if x.ExecFeeFlat == nil {
+			x.ExecFeeFlat = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ExecFeeFlat.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.min_proposal_deposit":
+		if x.MinProposalDeposit == nil {
+			x.MinProposalDeposit = []*v1beta1.Coin{}
+		}
+		value := &_MsgUpdateParams_5_list{list: &x.MinProposalDeposit}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.allowed_message_types":
+		if x.AllowedMessageTypes == nil {
+			x.AllowedMessageTypes = []string{}
+		}
+		value := &_MsgUpdateParams_12_list{list: &x.AllowedMessageTypes}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.denied_message_types":
+		if x.DeniedMessageTypes == nil {
+			x.DeniedMessageTypes = []string{}
+		}
+		value := &_MsgUpdateParams_13_list{list: &x.DeniedMessageTypes}
+		return protoreflect.ValueOfList(value)
 	case "andromeda.escrow.v1alpha1.MsgUpdateParams.authority":
 		panic(fmt.Errorf("field authority of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
 	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_metadata_length":
 		panic(fmt.Errorf("field max_metadata_length of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_bps":
+		panic(fmt.Errorf("field exec_fee_bps of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.burn_expired_deposits":
+		panic(fmt.Errorf("field burn_expired_deposits of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_pre_actions":
+		panic(fmt.Errorf("field max_pre_actions of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_post_actions":
+		panic(fmt.Errorf("field max_post_actions of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_actions":
+		panic(fmt.Errorf("field max_actions of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_agents_per_exec":
+		panic(fmt.Errorf("field max_agents_per_exec of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_action_size":
+		panic(fmt.Errorf("field max_action_size of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_nesting_depth":
+		panic(fmt.Errorf("field max_nesting_depth of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_agent":
+		panic(fmt.Errorf("field gas_per_agent of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action":
+		panic(fmt.Errorf("field gas_per_action of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action_byte":
+		panic(fmt.Errorf("field gas_per_action_byte of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_prunes_per_block":
+		panic(fmt.Errorf("field max_prunes_per_block of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_refund_actions":
+		panic(fmt.Errorf("field max_refund_actions of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_simulate_gas":
+		panic(fmt.Errorf("field max_simulate_gas of message andromeda.escrow.v1alpha1.MsgUpdateParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParams"))
@@ -231,6 +749,46 @@ func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfString("")
 	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_metadata_length":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_flat":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.min_proposal_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgUpdateParams_5_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.burn_expired_deposits":
+		return protoreflect.ValueOfBool(false)
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_pre_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_post_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_agents_per_exec":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_action_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.allowed_message_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgUpdateParams_12_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.denied_message_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgUpdateParams_13_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_nesting_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_agent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.gas_per_action_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_prunes_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_refund_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgUpdateParams.max_simulate_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParams"))
@@ -307,6 +865,70 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		if x.MaxMetadataLength != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxMetadataLength))
 		}
+		if x.ExecFeeFlat != nil {
+			l = options.Size(x.ExecFeeFlat)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecFeeBps))
+		}
+		if len(x.MinProposalDeposit) > 0 {
+			for _, e := range x.MinProposalDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BurnExpiredDeposits {
+			n += 2
+		}
+		if x.MaxPreActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPreActions))
+		}
+		if x.MaxPostActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPostActions))
+		}
+		if x.MaxActions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActions))
+		}
+		if x.MaxAgentsPerExec != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAgentsPerExec))
+		}
+		if x.MaxActionSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActionSize))
+		}
+		if len(x.AllowedMessageTypes) > 0 {
+			for _, s := range x.AllowedMessageTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedMessageTypes) > 0 {
+			for _, s := range x.DeniedMessageTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxNestingDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNestingDepth))
+		}
+		if x.GasPerAgent != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasPerAgent))
+		}
+		if x.GasPerAction != 0 {
+			n += 2 + runtime.Sov(uint64(x.GasPerAction))
+		}
+		if x.GasPerActionByte != 0 {
+			n += 2 + runtime.Sov(uint64(x.GasPerActionByte))
+		}
+		if x.MaxPrunesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxPrunesPerBlock))
+		}
+		if x.MaxRefundActions != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRefundActions))
+		}
+		if x.MaxSimulateGas != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxSimulateGas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -336,34 +958,167 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxMetadataLength != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataLength))
+		if x.MaxSimulateGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSimulateGas))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
 		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+		if x.MaxRefundActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRefundActions))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.MaxPrunesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunesPerBlock))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
+		if x.GasPerActionByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerActionByte))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.GasPerAction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerAction))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.GasPerAgent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasPerAgent))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxNestingDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNestingDepth))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.DeniedMessageTypes) > 0 {
+			for iNdEx := len(x.DeniedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedMessageTypes[iNdEx])
+				copy(dAtA[i:], x.DeniedMessageTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedMessageTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.AllowedMessageTypes) > 0 {
+			for iNdEx := len(x.AllowedMessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedMessageTypes[iNdEx])
+				copy(dAtA[i:], x.AllowedMessageTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedMessageTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.MaxActionSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActionSize))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxAgentsPerExec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAgentsPerExec))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActions))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxPostActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPostActions))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxPreActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPreActions))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.BurnExpiredDeposits {
+			i--
+			if x.BurnExpiredDeposits {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.MinProposalDeposit) > 0 {
+			for iNdEx := len(x.MinProposalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinProposalDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.ExecFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecFeeBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ExecFeeFlat != nil {
+			encoded, err := options.Marshal(x.ExecFeeFlat)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxMetadataLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataLength))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
 			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
@@ -448,135 +1203,3827 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecFeeFlat", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecFeeFlat == nil {
+					x.ExecFeeFlat = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecFeeFlat); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecFeeBps", wireType)
+				}
+				x.ExecFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinProposalDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MinProposalDeposit = append(x.MinProposalDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinProposalDeposit[len(x.MinProposalDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
-)
-
-func init() {
-	file_andromeda_escrow_v1alpha1_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
-
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
-
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
-}
-
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
-
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
-
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
-}
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
-}
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredDeposits", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnExpiredDeposits = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPreActions", wireType)
+				}
+				x.MaxPreActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPreActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPostActions", wireType)
+				}
+				x.MaxPostActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPostActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActions", wireType)
+				}
+				x.MaxActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAgentsPerExec", wireType)
+				}
+				x.MaxAgentsPerExec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAgentsPerExec |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActionSize", wireType)
+				}
+				x.MaxActionSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActionSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedMessageTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedMessageTypes = append(x.AllowedMessageTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedMessageTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedMessageTypes = append(x.DeniedMessageTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNestingDepth", wireType)
+				}
+				x.MaxNestingDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNestingDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerAgent", wireType)
+				}
+				x.GasPerAgent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerAgent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerAction", wireType)
+				}
+				x.GasPerAction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerAction |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPerActionByte", wireType)
+				}
+				x.GasPerActionByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasPerActionByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunesPerBlock", wireType)
+				}
+				x.MaxPrunesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRefundActions", wireType)
+				}
+				x.MaxRefundActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRefundActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateGas", wireType)
+				}
+				x.MaxSimulateGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSimulateGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_tx_proto_init()
+	md_MsgUpdateParamsResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
+}
+
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgUpdateParamsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateAgent         protoreflect.MessageDescriptor
+	fd_MsgCreateAgent_creator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_tx_proto_init()
+	md_MsgCreateAgent = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgCreateAgent")
+	fd_MsgCreateAgent_creator = md_MsgCreateAgent.Fields().ByName("creator")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateAgent)(nil)
+
+type fastReflection_MsgCreateAgent MsgCreateAgent
+
+func (x *MsgCreateAgent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateAgent)(x)
+}
+
+func (x *MsgCreateAgent) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateAgent_messageType fastReflection_MsgCreateAgent_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateAgent_messageType{}
+
+type fastReflection_MsgCreateAgent_messageType struct{}
+
+func (x fastReflection_MsgCreateAgent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateAgent)(nil)
+}
+func (x fastReflection_MsgCreateAgent_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAgent)
+}
+func (x fastReflection_MsgCreateAgent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAgent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateAgent) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAgent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateAgent) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateAgent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateAgent) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAgent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateAgent) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateAgent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateAgent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgCreateAgent_creator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateAgent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
+		return x.Creator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
+		x.Creator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateAgent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
+		x.Creator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
+		panic(fmt.Errorf("field creator of message andromeda.escrow.v1alpha1.MsgCreateAgent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateAgent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateAgent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgCreateAgent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateAgent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateAgent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateAgent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateAgent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateAgent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateAgentResponse       protoreflect.MessageDescriptor
+	fd_MsgCreateAgentResponse_agent protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_tx_proto_init()
+	md_MsgCreateAgentResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgCreateAgentResponse")
+	fd_MsgCreateAgentResponse_agent = md_MsgCreateAgentResponse.Fields().ByName("agent")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateAgentResponse)(nil)
+
+type fastReflection_MsgCreateAgentResponse MsgCreateAgentResponse
+
+func (x *MsgCreateAgentResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateAgentResponse)(x)
+}
+
+func (x *MsgCreateAgentResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateAgentResponse_messageType fastReflection_MsgCreateAgentResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateAgentResponse_messageType{}
+
+type fastReflection_MsgCreateAgentResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateAgentResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateAgentResponse)(nil)
+}
+func (x fastReflection_MsgCreateAgentResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAgentResponse)
+}
+func (x fastReflection_MsgCreateAgentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAgentResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateAgentResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateAgentResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateAgentResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateAgentResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateAgentResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateAgentResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateAgentResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateAgentResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateAgentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_MsgCreateAgentResponse_agent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateAgentResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+		return x.Agent != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgentResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+		x.Agent = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateAgentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+		x.Agent = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.MsgCreateAgentResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateAgentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateAgentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgCreateAgentResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateAgentResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateAgentResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateAgentResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateAgentResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateAgentResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateAgentResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgentResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposal_3_list)(nil)
+
+type _MsgSubmitProposal_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgSubmitProposal_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposal_4_list)(nil)
+
+type _MsgSubmitProposal_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgSubmitProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposal_6_list)(nil)
+
+type _MsgSubmitProposal_6_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgSubmitProposal_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_6_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_6_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposal_10_list)(nil)
+
+type _MsgSubmitProposal_10_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitProposal_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitProposal_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitProposal at list field Executors as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitProposal_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitProposal_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitProposal                   protoreflect.MessageDescriptor
+	fd_MsgSubmitProposal_proposer          protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_agent             protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_pre_actions       protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_post_actions      protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_metadata          protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_refund_actions    protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_expire_height     protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_expire_time       protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_quantity          protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_executors         protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_executor_group_id protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_max_exec_gas      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_tx_proto_init()
+	md_MsgSubmitProposal = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgSubmitProposal")
+	fd_MsgSubmitProposal_proposer = md_MsgSubmitProposal.Fields().ByName("proposer")
+	fd_MsgSubmitProposal_agent = md_MsgSubmitProposal.Fields().ByName("agent")
+	fd_MsgSubmitProposal_pre_actions = md_MsgSubmitProposal.Fields().ByName("pre_actions")
+	fd_MsgSubmitProposal_post_actions = md_MsgSubmitProposal.Fields().ByName("post_actions")
+	fd_MsgSubmitProposal_metadata = md_MsgSubmitProposal.Fields().ByName("metadata")
+	fd_MsgSubmitProposal_refund_actions = md_MsgSubmitProposal.Fields().ByName("refund_actions")
+	fd_MsgSubmitProposal_expire_height = md_MsgSubmitProposal.Fields().ByName("expire_height")
+	fd_MsgSubmitProposal_expire_time = md_MsgSubmitProposal.Fields().ByName("expire_time")
+	fd_MsgSubmitProposal_quantity = md_MsgSubmitProposal.Fields().ByName("quantity")
+	fd_MsgSubmitProposal_executors = md_MsgSubmitProposal.Fields().ByName("executors")
+	fd_MsgSubmitProposal_executor_group_id = md_MsgSubmitProposal.Fields().ByName("executor_group_id")
+	fd_MsgSubmitProposal_max_exec_gas = md_MsgSubmitProposal.Fields().ByName("max_exec_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitProposal)(nil)
+
+type fastReflection_MsgSubmitProposal MsgSubmitProposal
+
+func (x *MsgSubmitProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposal)(x)
+}
+
+func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitProposal_messageType fastReflection_MsgSubmitProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitProposal_messageType{}
+
+type fastReflection_MsgSubmitProposal_messageType struct{}
+
+func (x fastReflection_MsgSubmitProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposal)(nil)
+}
+func (x fastReflection_MsgSubmitProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposal)
+}
+func (x fastReflection_MsgSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_MsgSubmitProposal_proposer, value) {
+			return
+		}
+	}
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_MsgSubmitProposal_agent, value) {
+			return
+		}
+	}
+	if len(x.PreActions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_3_list{list: &x.PreActions})
+		if !f(fd_MsgSubmitProposal_pre_actions, value) {
+			return
+		}
+	}
+	if len(x.PostActions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &x.PostActions})
+		if !f(fd_MsgSubmitProposal_post_actions, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_MsgSubmitProposal_metadata, value) {
+			return
+		}
+	}
+	if len(x.RefundActions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_6_list{list: &x.RefundActions})
+		if !f(fd_MsgSubmitProposal_refund_actions, value) {
+			return
+		}
+	}
+	if x.ExpireHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpireHeight)
+		if !f(fd_MsgSubmitProposal_expire_height, value) {
+			return
+		}
+	}
+	if x.ExpireTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpireTime.ProtoReflect())
+		if !f(fd_MsgSubmitProposal_expire_time, value) {
+			return
+		}
+	}
+	if x.Quantity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Quantity)
+		if !f(fd_MsgSubmitProposal_quantity, value) {
+			return
+		}
+	}
+	if len(x.Executors) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_10_list{list: &x.Executors})
+		if !f(fd_MsgSubmitProposal_executors, value) {
+			return
+		}
+	}
+	if x.ExecutorGroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutorGroupId)
+		if !f(fd_MsgSubmitProposal_executor_group_id, value) {
+			return
+		}
+	}
+	if x.MaxExecGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxExecGas)
+		if !f(fd_MsgSubmitProposal_max_exec_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+		return x.Proposer != ""
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
+		return len(x.PreActions) != 0
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
+		return len(x.PostActions) != 0
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
+		return x.Metadata != ""
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.refund_actions":
+		return len(x.RefundActions) != 0
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_height":
+		return x.ExpireHeight != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_time":
+		return x.ExpireTime != nil
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.quantity":
+		return x.Quantity != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executors":
+		return len(x.Executors) != 0
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executor_group_id":
+		return x.ExecutorGroupId != uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.max_exec_gas":
+		return x.MaxExecGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+		x.Proposer = ""
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
+		x.PreActions = nil
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
+		x.PostActions = nil
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
+		x.Metadata = ""
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.refund_actions":
+		x.RefundActions = nil
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_height":
+		x.ExpireHeight = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_time":
+		x.ExpireTime = nil
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.quantity":
+		x.Quantity = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executors":
+		x.Executors = nil
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executor_group_id":
+		x.ExecutorGroupId = uint64(0)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.max_exec_gas":
+		x.MaxExecGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
+		if len(x.PreActions) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_3_list{})
+		}
+		listValue := &_MsgSubmitProposal_3_list{list: &x.PreActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
+		if len(x.PostActions) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{})
+		}
+		listValue := &_MsgSubmitProposal_4_list{list: &x.PostActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.refund_actions":
+		if len(x.RefundActions) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_6_list{})
+		}
+		listValue := &_MsgSubmitProposal_6_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_height":
+		value := x.ExpireHeight
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_time":
+		value := x.ExpireTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executors":
+		if len(x.Executors) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_10_list{})
+		}
+		listValue := &_MsgSubmitProposal_10_list{list: &x.Executors}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executor_group_id":
+		value := x.ExecutorGroupId
+		return protoreflect.ValueOfUint64(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.max_exec_gas":
+		value := x.MaxExecGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_3_list)
+		x.PreActions = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_4_list)
+		x.PostActions = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.refund_actions":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_6_list)
+		x.RefundActions = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_height":
+		x.ExpireHeight = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_time":
+		x.ExpireTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.quantity":
+		x.Quantity = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executors":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_10_list)
+		x.Executors = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executor_group_id":
+		x.ExecutorGroupId = value.Uint()
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.max_exec_gas":
+		x.MaxExecGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
+		if x.PreActions == nil {
+			x.PreActions = []*anypb.Any{}
+		}
+		value := &_MsgSubmitProposal_3_list{list: &x.PreActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
+		if x.PostActions == nil {
+			x.PostActions = []*anypb.Any{}
+		}
+		value := &_MsgSubmitProposal_4_list{list: &x.PostActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.refund_actions":
+		if x.RefundActions == nil {
+			x.RefundActions = []*anypb.Any{}
+		}
+		value := &_MsgSubmitProposal_6_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_time":
+		if x.ExpireTime == nil {
+			x.ExpireTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpireTime.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executors":
+		if x.Executors == nil {
+			x.Executors = []string{}
+		}
+		value := &_MsgSubmitProposal_10_list{list: &x.Executors}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
+		panic(fmt.Errorf("field metadata of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_height":
+		panic(fmt.Errorf("field expire_height of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.quantity":
+		panic(fmt.Errorf("field quantity of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executor_group_id":
+		panic(fmt.Errorf("field executor_group_id of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.max_exec_gas":
+		panic(fmt.Errorf("field max_exec_gas of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.refund_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_6_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.quantity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_10_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.executor_group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.max_exec_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgSubmitProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PreActions) > 0 {
+			for _, e := range x.PreActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PostActions) > 0 {
+			for _, e := range x.PostActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefundActions) > 0 {
+			for _, e := range x.RefundActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpireHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpireHeight))
+		}
+		if x.ExpireTime != nil {
+			l = options.Size(x.ExpireTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Quantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quantity))
+		}
+		if len(x.Executors) > 0 {
+			for _, s := range x.Executors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExecutorGroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorGroupId))
+		}
+		if x.MaxExecGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxExecGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxExecGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExecGas))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.ExecutorGroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorGroupId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Executors) > 0 {
+			for iNdEx := len(x.Executors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Executors[iNdEx])
+				copy(dAtA[i:], x.Executors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executors[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.Quantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quantity))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ExpireTime != nil {
+			encoded, err := options.Marshal(x.ExpireTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ExpireHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpireHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.RefundActions) > 0 {
+			for iNdEx := len(x.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.PostActions) > 0 {
+			for iNdEx := len(x.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PreActions) > 0 {
+			for iNdEx := len(x.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreActions = append(x.PreActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostActions = append(x.PostActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActions[len(x.PostActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActions = append(x.RefundActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActions[len(x.RefundActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+				}
+				x.ExpireHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpireHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpireTime == nil {
+					x.ExpireTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpireTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				x.Quantity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Quantity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executors = append(x.Executors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorGroupId", wireType)
+				}
+				x.ExecutorGroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorGroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExecGas", wireType)
+				}
+				x.MaxExecGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxExecGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposalResponse_1_list)(nil)
+
+type _MsgSubmitProposalResponse_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposalResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitProposalResponse                      protoreflect.MessageDescriptor
+	fd_MsgSubmitProposalResponse_pre_action_responses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_tx_proto_init()
+	md_MsgSubmitProposalResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgSubmitProposalResponse")
+	fd_MsgSubmitProposalResponse_pre_action_responses = md_MsgSubmitProposalResponse.Fields().ByName("pre_action_responses")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitProposalResponse)(nil)
+
+type fastReflection_MsgSubmitProposalResponse MsgSubmitProposalResponse
+
+func (x *MsgSubmitProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposalResponse)(x)
+}
+
+func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitProposalResponse_messageType fastReflection_MsgSubmitProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitProposalResponse_messageType{}
+
+type fastReflection_MsgSubmitProposalResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposalResponse)(nil)
+}
+func (x fastReflection_MsgSubmitProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposalResponse)
+}
+func (x fastReflection_MsgSubmitProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PreActionResponses) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposalResponse_1_list{list: &x.PreActionResponses})
+		if !f(fd_MsgSubmitProposalResponse_pre_action_responses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse.pre_action_responses":
+		return len(x.PreActionResponses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse.pre_action_responses":
+		x.PreActionResponses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse.pre_action_responses":
+		if len(x.PreActionResponses) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposalResponse_1_list{})
+		}
+		listValue := &_MsgSubmitProposalResponse_1_list{list: &x.PreActionResponses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse.pre_action_responses":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposalResponse_1_list)
+		x.PreActionResponses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse.pre_action_responses":
+		if x.PreActionResponses == nil {
+			x.PreActionResponses = []*anypb.Any{}
+		}
+		value := &_MsgSubmitProposalResponse_1_list{list: &x.PreActionResponses}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgSubmitProposalResponse.pre_action_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposalResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgSubmitProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.PreActionResponses) > 0 {
+			for _, e := range x.PreActionResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PreActionResponses) > 0 {
+			for iNdEx := len(x.PreActionResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreActionResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActionResponses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreActionResponses = append(x.PreActionResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActionResponses[len(x.PreActionResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgExec_2_list)(nil)
+
+type _MsgExec_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgExec_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExec_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgExec_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExec_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExec_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgExec at list field Agents as it is not of Message kind"))
+}
+
+func (x *_MsgExec_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExec_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgExec_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgExec_3_list)(nil)
+
+type _MsgExec_3_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgExec_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExec_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExec_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExec_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExec_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExec_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExec_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExec_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgExec_4_list)(nil)
+
+type _MsgExec_4_list struct {
+	list *[]uint64
+}
+
+func (x *_MsgExec_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExec_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_MsgExec_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExec_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExec_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgExec at list field Quantities as it is not of Message kind"))
+}
+
+func (x *_MsgExec_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExec_4_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_MsgExec_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgExec_5_list)(nil)
+
+type _MsgExec_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgExec_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExec_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExec_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExec_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExec_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExec_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExec_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExec_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgExec                protoreflect.MessageDescriptor
+	fd_MsgExec_executor       protoreflect.FieldDescriptor
+	fd_MsgExec_agents         protoreflect.FieldDescriptor
+	fd_MsgExec_actions        protoreflect.FieldDescriptor
+	fd_MsgExec_quantities     protoreflect.FieldDescriptor
+	fd_MsgExec_declared_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_tx_proto_init()
+	md_MsgExec = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgExec")
+	fd_MsgExec_executor = md_MsgExec.Fields().ByName("executor")
+	fd_MsgExec_agents = md_MsgExec.Fields().ByName("agents")
+	fd_MsgExec_actions = md_MsgExec.Fields().ByName("actions")
+	fd_MsgExec_quantities = md_MsgExec.Fields().ByName("quantities")
+	fd_MsgExec_declared_value = md_MsgExec.Fields().ByName("declared_value")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgExec)(nil)
+
+type fastReflection_MsgExec MsgExec
+
+func (x *MsgExec) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExec)(x)
+}
+
+func (x *MsgExec) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgExec_messageType fastReflection_MsgExec_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExec_messageType{}
+
+type fastReflection_MsgExec_messageType struct{}
+
+func (x fastReflection_MsgExec_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExec)(nil)
+}
+func (x fastReflection_MsgExec_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExec)
+}
+func (x fastReflection_MsgExec_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExec
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgExec) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExec
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgExec) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExec_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgExec) New() protoreflect.Message {
+	return new(fastReflection_MsgExec)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgExec) Interface() protoreflect.ProtoMessage {
+	return (*MsgExec)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgExec) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_MsgExec_executor, value) {
+			return
+		}
+	}
+	if len(x.Agents) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExec_2_list{list: &x.Agents})
+		if !f(fd_MsgExec_agents, value) {
+			return
+		}
+	}
+	if len(x.Actions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExec_3_list{list: &x.Actions})
+		if !f(fd_MsgExec_actions, value) {
+			return
+		}
+	}
+	if len(x.Quantities) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExec_4_list{list: &x.Quantities})
+		if !f(fd_MsgExec_quantities, value) {
+			return
+		}
+	}
+	if len(x.DeclaredValue) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExec_5_list{list: &x.DeclaredValue})
+		if !f(fd_MsgExec_declared_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
 //
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgExec) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgExec.executor":
+		return x.Executor != ""
+	case "andromeda.escrow.v1alpha1.MsgExec.agents":
+		return len(x.Agents) != 0
+	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+		return len(x.Actions) != 0
+	case "andromeda.escrow.v1alpha1.MsgExec.quantities":
+		return len(x.Quantities) != 0
+	case "andromeda.escrow.v1alpha1.MsgExec.declared_value":
+		return len(x.DeclaredValue) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -586,13 +5033,23 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgExec) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgExec.executor":
+		x.Executor = ""
+	case "andromeda.escrow.v1alpha1.MsgExec.agents":
+		x.Agents = nil
+	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+		x.Actions = nil
+	case "andromeda.escrow.v1alpha1.MsgExec.quantities":
+		x.Quantities = nil
+	case "andromeda.escrow.v1alpha1.MsgExec.declared_value":
+		x.DeclaredValue = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -602,13 +5059,40 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExec) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgExec.executor":
+		value := x.Executor
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgExec.agents":
+		if len(x.Agents) == 0 {
+			return protoreflect.ValueOfList(&_MsgExec_2_list{})
+		}
+		listValue := &_MsgExec_2_list{list: &x.Agents}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+		if len(x.Actions) == 0 {
+			return protoreflect.ValueOfList(&_MsgExec_3_list{})
+		}
+		listValue := &_MsgExec_3_list{list: &x.Actions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgExec.quantities":
+		if len(x.Quantities) == 0 {
+			return protoreflect.ValueOfList(&_MsgExec_4_list{})
+		}
+		listValue := &_MsgExec_4_list{list: &x.Quantities}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgExec.declared_value":
+		if len(x.DeclaredValue) == 0 {
+			return protoreflect.ValueOfList(&_MsgExec_5_list{})
+		}
+		listValue := &_MsgExec_5_list{list: &x.DeclaredValue}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -622,13 +5106,31 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgExec) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgExec.executor":
+		x.Executor = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgExec.agents":
+		lv := value.List()
+		clv := lv.(*_MsgExec_2_list)
+		x.Agents = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+		lv := value.List()
+		clv := lv.(*_MsgExec_3_list)
+		x.Actions = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgExec.quantities":
+		lv := value.List()
+		clv := lv.(*_MsgExec_4_list)
+		x.Quantities = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgExec.declared_value":
+		lv := value.List()
+		clv := lv.(*_MsgExec_5_list)
+		x.DeclaredValue = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -642,36 +5144,76 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExec) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgExec.agents":
+		if x.Agents == nil {
+			x.Agents = []string{}
+		}
+		value := &_MsgExec_2_list{list: &x.Agents}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+		if x.Actions == nil {
+			x.Actions = []*anypb.Any{}
+		}
+		value := &_MsgExec_3_list{list: &x.Actions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgExec.quantities":
+		if x.Quantities == nil {
+			x.Quantities = []uint64{}
+		}
+		value := &_MsgExec_4_list{list: &x.Quantities}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgExec.declared_value":
+		if x.DeclaredValue == nil {
+			x.DeclaredValue = []*v1beta1.Coin{}
+		}
+		value := &_MsgExec_5_list{list: &x.DeclaredValue}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgExec.executor":
+		panic(fmt.Errorf("field executor of message andromeda.escrow.v1alpha1.MsgExec is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExec) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "andromeda.escrow.v1alpha1.MsgExec.executor":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgExec.agents":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgExec_2_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgExec_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgExec.quantities":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MsgExec_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgExec.declared_value":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgExec_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgExec) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgExec", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -679,7 +5221,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgExec) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -690,7 +5232,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgExec) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -702,7 +5244,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgExec) IsValid() bool {
 	return x != nil
 }
 
@@ -712,9 +5254,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgExec)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -726,6 +5268,35 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
+		l = len(x.Executor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Agents) > 0 {
+			for _, s := range x.Agents {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Actions) > 0 {
+			for _, e := range x.Actions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Quantities) > 0 {
+			l = 0
+			for _, e := range x.Quantities {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.DeclaredValue) > 0 {
+			for _, e := range x.DeclaredValue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -736,7 +5307,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgExec)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -755,6 +5326,74 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DeclaredValue) > 0 {
+			for iNdEx := len(x.DeclaredValue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DeclaredValue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Quantities) > 0 {
+			var pksize2 int
+			for _, num := range x.Quantities {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.Quantities {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Actions) > 0 {
+			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Actions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agents) > 0 {
+			for iNdEx := len(x.Agents) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Agents[iNdEx])
+				copy(dAtA[i:], x.Agents[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agents[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -766,7 +5405,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgExec)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -798,12 +5437,220 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agents = append(x.Agents, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actions = append(x.Actions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Actions[len(x.Actions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Quantities = append(x.Quantities, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Quantities) == 0 {
+						x.Quantities = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Quantities = append(x.Quantities, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantities", wireType)
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeclaredValue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeclaredValue = append(x.DeclaredValue, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeclaredValue[len(x.DeclaredValue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -839,27 +5686,131 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_MsgExecResponse_1_list)(nil)
+
+type _MsgExecResponse_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgExecResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExecResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExecResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExecResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExecResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExecResponse_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgExecResponse_2_list)(nil)
+
+type _MsgExecResponse_2_list struct {
+	list *[]*MsgExecResponse_PostActionResponses
+}
+
+func (x *_MsgExecResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExecResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExecResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgExecResponse_PostActionResponses)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExecResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgExecResponse_PostActionResponses)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExecResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(MsgExecResponse_PostActionResponses)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExecResponse_2_list) NewElement() protoreflect.Value {
+	v := new(MsgExecResponse_PostActionResponses)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCreateAgent         protoreflect.MessageDescriptor
-	fd_MsgCreateAgent_creator protoreflect.FieldDescriptor
+	md_MsgExecResponse                       protoreflect.MessageDescriptor
+	fd_MsgExecResponse_action_responses      protoreflect.FieldDescriptor
+	fd_MsgExecResponse_post_action_responses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_andromeda_escrow_v1alpha1_tx_proto_init()
-	md_MsgCreateAgent = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgCreateAgent")
-	fd_MsgCreateAgent_creator = md_MsgCreateAgent.Fields().ByName("creator")
+	md_MsgExecResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgExecResponse")
+	fd_MsgExecResponse_action_responses = md_MsgExecResponse.Fields().ByName("action_responses")
+	fd_MsgExecResponse_post_action_responses = md_MsgExecResponse.Fields().ByName("post_action_responses")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateAgent)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgExecResponse)(nil)
 
-type fastReflection_MsgCreateAgent MsgCreateAgent
+type fastReflection_MsgExecResponse MsgExecResponse
 
-func (x *MsgCreateAgent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateAgent)(x)
+func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse)(x)
 }
 
-func (x *MsgCreateAgent) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[2]
+func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,43 +5821,43 @@ func (x *MsgCreateAgent) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateAgent_messageType fastReflection_MsgCreateAgent_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateAgent_messageType{}
+var _fastReflection_MsgExecResponse_messageType fastReflection_MsgExecResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecResponse_messageType{}
 
-type fastReflection_MsgCreateAgent_messageType struct{}
+type fastReflection_MsgExecResponse_messageType struct{}
 
-func (x fastReflection_MsgCreateAgent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateAgent)(nil)
+func (x fastReflection_MsgExecResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse)(nil)
 }
-func (x fastReflection_MsgCreateAgent_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateAgent)
+func (x fastReflection_MsgExecResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse)
 }
-func (x fastReflection_MsgCreateAgent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateAgent
+func (x fastReflection_MsgExecResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateAgent) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateAgent
+func (x *fastReflection_MsgExecResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateAgent) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateAgent_messageType
+func (x *fastReflection_MsgExecResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateAgent) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateAgent)
+func (x *fastReflection_MsgExecResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateAgent) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateAgent)(x)
+func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -914,10 +5865,16 @@ func (x *fastReflection_MsgCreateAgent) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateAgent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgCreateAgent_creator, value) {
+func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ActionResponses) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecResponse_1_list{list: &x.ActionResponses})
+		if !f(fd_MsgExecResponse_action_responses, value) {
+			return
+		}
+	}
+	if len(x.PostActionResponses) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecResponse_2_list{list: &x.PostActionResponses})
+		if !f(fd_MsgExecResponse_post_action_responses, value) {
 			return
 		}
 	}
@@ -934,15 +5891,17 @@ func (x *fastReflection_MsgCreateAgent) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateAgent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
-		return x.Creator != ""
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.action_responses":
+		return len(x.ActionResponses) != 0
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.post_action_responses":
+		return len(x.PostActionResponses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -952,15 +5911,17 @@ func (x *fastReflection_MsgCreateAgent) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
-		x.Creator = ""
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.action_responses":
+		x.ActionResponses = nil
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.post_action_responses":
+		x.PostActionResponses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -970,16 +5931,25 @@ func (x *fastReflection_MsgCreateAgent) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateAgent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.action_responses":
+		if len(x.ActionResponses) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecResponse_1_list{})
+		}
+		listValue := &_MsgExecResponse_1_list{list: &x.ActionResponses}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.post_action_responses":
+		if len(x.PostActionResponses) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecResponse_2_list{})
+		}
+		listValue := &_MsgExecResponse_2_list{list: &x.PostActionResponses}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -993,15 +5963,21 @@ func (x *fastReflection_MsgCreateAgent) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
-		x.Creator = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.action_responses":
+		lv := value.List()
+		clv := lv.(*_MsgExecResponse_1_list)
+		x.ActionResponses = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.post_action_responses":
+		lv := value.List()
+		clv := lv.(*_MsgExecResponse_2_list)
+		x.PostActionResponses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1015,40 +5991,54 @@ func (x *fastReflection_MsgCreateAgent) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
-		panic(fmt.Errorf("field creator of message andromeda.escrow.v1alpha1.MsgCreateAgent is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.action_responses":
+		if x.ActionResponses == nil {
+			x.ActionResponses = []*anypb.Any{}
+		}
+		value := &_MsgExecResponse_1_list{list: &x.ActionResponses}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.post_action_responses":
+		if x.PostActionResponses == nil {
+			x.PostActionResponses = []*MsgExecResponse_PostActionResponses{}
+		}
+		value := &_MsgExecResponse_2_list{list: &x.PostActionResponses}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateAgent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgent.creator":
-		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.action_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgExecResponse_1_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.post_action_responses":
+		list := []*MsgExecResponse_PostActionResponses{}
+		return protoreflect.ValueOfList(&_MsgExecResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateAgent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgCreateAgent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgExecResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1056,7 +6046,7 @@ func (x *fastReflection_MsgCreateAgent) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateAgent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1067,7 +6057,7 @@ func (x *fastReflection_MsgCreateAgent) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1079,7 +6069,7 @@ func (x *fastReflection_MsgCreateAgent) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateAgent) IsValid() bool {
+func (x *fastReflection_MsgExecResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1089,9 +6079,9 @@ func (x *fastReflection_MsgCreateAgent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateAgent)
+		x := input.Message.Interface().(*MsgExecResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1103,9 +6093,17 @@ func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ActionResponses) > 0 {
+			for _, e := range x.ActionResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PostActionResponses) > 0 {
+			for _, e := range x.PostActionResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1117,7 +6115,7 @@ func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateAgent)
+		x := input.Message.Interface().(*MsgExecResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1136,12 +6134,37 @@ func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.PostActionResponses) > 0 {
+			for iNdEx := len(x.PostActionResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostActionResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ActionResponses) > 0 {
+			for iNdEx := len(x.ActionResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ActionResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1154,7 +6177,7 @@ func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateAgent)
+		x := input.Message.Interface().(*MsgExecResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1186,17 +6209,17 @@ func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActionResponses", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1206,23 +6229,59 @@ func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.ActionResponses = append(x.ActionResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ActionResponses[len(x.ActionResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActionResponses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostActionResponses = append(x.PostActionResponses, &MsgExecResponse_PostActionResponses{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActionResponses[len(x.PostActionResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1259,27 +6318,80 @@ func (x *fastReflection_MsgCreateAgent) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MsgExecResponse_PostActionResponses_2_list)(nil)
+
+type _MsgExecResponse_PostActionResponses_2_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_PostActionResponses_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgCreateAgentResponse       protoreflect.MessageDescriptor
-	fd_MsgCreateAgentResponse_agent protoreflect.FieldDescriptor
+	md_MsgExecResponse_PostActionResponses           protoreflect.MessageDescriptor
+	fd_MsgExecResponse_PostActionResponses_agent     protoreflect.FieldDescriptor
+	fd_MsgExecResponse_PostActionResponses_responses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_andromeda_escrow_v1alpha1_tx_proto_init()
-	md_MsgCreateAgentResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgCreateAgentResponse")
-	fd_MsgCreateAgentResponse_agent = md_MsgCreateAgentResponse.Fields().ByName("agent")
+	md_MsgExecResponse_PostActionResponses = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgExecResponse").Messages().ByName("PostActionResponses")
+	fd_MsgExecResponse_PostActionResponses_agent = md_MsgExecResponse_PostActionResponses.Fields().ByName("agent")
+	fd_MsgExecResponse_PostActionResponses_responses = md_MsgExecResponse_PostActionResponses.Fields().ByName("responses")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateAgentResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgExecResponse_PostActionResponses)(nil)
 
-type fastReflection_MsgCreateAgentResponse MsgCreateAgentResponse
+type fastReflection_MsgExecResponse_PostActionResponses MsgExecResponse_PostActionResponses
 
-func (x *MsgCreateAgentResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateAgentResponse)(x)
+func (x *MsgExecResponse_PostActionResponses) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse_PostActionResponses)(x)
 }
 
-func (x *MsgCreateAgentResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[3]
+func (x *MsgExecResponse_PostActionResponses) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,43 +6402,43 @@ func (x *MsgCreateAgentResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateAgentResponse_messageType fastReflection_MsgCreateAgentResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateAgentResponse_messageType{}
+var _fastReflection_MsgExecResponse_PostActionResponses_messageType fastReflection_MsgExecResponse_PostActionResponses_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecResponse_PostActionResponses_messageType{}
 
-type fastReflection_MsgCreateAgentResponse_messageType struct{}
+type fastReflection_MsgExecResponse_PostActionResponses_messageType struct{}
 
-func (x fastReflection_MsgCreateAgentResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateAgentResponse)(nil)
+func (x fastReflection_MsgExecResponse_PostActionResponses_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse_PostActionResponses)(nil)
 }
-func (x fastReflection_MsgCreateAgentResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateAgentResponse)
+func (x fastReflection_MsgExecResponse_PostActionResponses_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse_PostActionResponses)
 }
-func (x fastReflection_MsgCreateAgentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateAgentResponse
+func (x fastReflection_MsgExecResponse_PostActionResponses_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse_PostActionResponses
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateAgentResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateAgentResponse
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse_PostActionResponses
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateAgentResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateAgentResponse_messageType
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecResponse_PostActionResponses_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateAgentResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateAgentResponse)
+func (x *fastReflection_MsgExecResponse_PostActionResponses) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse_PostActionResponses)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateAgentResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateAgentResponse)(x)
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecResponse_PostActionResponses)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1334,10 +6446,16 @@ func (x *fastReflection_MsgCreateAgentResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateAgentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Agent != "" {
 		value := protoreflect.ValueOfString(x.Agent)
-		if !f(fd_MsgCreateAgentResponse_agent, value) {
+		if !f(fd_MsgExecResponse_PostActionResponses_agent, value) {
+			return
+		}
+	}
+	if len(x.Responses) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecResponse_PostActionResponses_2_list{list: &x.Responses})
+		if !f(fd_MsgExecResponse_PostActionResponses_responses, value) {
 			return
 		}
 	}
@@ -1354,15 +6472,17 @@ func (x *fastReflection_MsgCreateAgentResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateAgentResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.agent":
 		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.responses":
+		return len(x.Responses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1372,15 +6492,17 @@ func (x *fastReflection_MsgCreateAgentResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgentResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.agent":
 		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.responses":
+		x.Responses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1390,16 +6512,22 @@ func (x *fastReflection_MsgCreateAgentResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateAgentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.agent":
 		value := x.Agent
 		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.responses":
+		if len(x.Responses) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecResponse_PostActionResponses_2_list{})
+		}
+		listValue := &_MsgExecResponse_PostActionResponses_2_list{list: &x.Responses}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1413,15 +6541,19 @@ func (x *fastReflection_MsgCreateAgentResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.agent":
 		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.responses":
+		lv := value.List()
+		clv := lv.(*_MsgExecResponse_PostActionResponses_2_list)
+		x.Responses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1435,40 +6567,49 @@ func (x *fastReflection_MsgCreateAgentResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
-		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.MsgCreateAgentResponse is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.responses":
+		if x.Responses == nil {
+			x.Responses = []*anypb.Any{}
+		}
+		value := &_MsgExecResponse_PostActionResponses_2_list{list: &x.Responses}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateAgentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgCreateAgentResponse.agent":
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.agent":
 		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgExecResponse_PostActionResponses_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCreateAgentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCreateAgentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateAgentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgCreateAgentResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1476,7 +6617,7 @@ func (x *fastReflection_MsgCreateAgentResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateAgentResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1487,7 +6628,7 @@ func (x *fastReflection_MsgCreateAgentResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateAgentResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1499,7 +6640,7 @@ func (x *fastReflection_MsgCreateAgentResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateAgentResponse) IsValid() bool {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) IsValid() bool {
 	return x != nil
 }
 
@@ -1509,9 +6650,9 @@ func (x *fastReflection_MsgCreateAgentResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgExecResponse_PostActionResponses) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateAgentResponse)
+		x := input.Message.Interface().(*MsgExecResponse_PostActionResponses)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1527,6 +6668,12 @@ func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Responses) > 0 {
+			for _, e := range x.Responses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1537,7 +6684,7 @@ func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateAgentResponse)
+		x := input.Message.Interface().(*MsgExecResponse_PostActionResponses)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1556,6 +6703,22 @@ func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Responses) > 0 {
+			for iNdEx := len(x.Responses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Responses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.Agent) > 0 {
 			i -= len(x.Agent)
 			copy(dAtA[i:], x.Agent)
@@ -1574,7 +6737,7 @@ func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateAgentResponse)
+		x := input.Message.Interface().(*MsgExecResponse_PostActionResponses)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1606,10 +6769,10 @@ func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgentResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse_PostActionResponses: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse_PostActionResponses: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1644,6 +6807,40 @@ func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Metho
 				}
 				x.Agent = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Responses = append(x.Responses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Responses[len(x.Responses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1679,137 +6876,29 @@ func (x *fastReflection_MsgCreateAgentResponse) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_MsgSubmitProposal_3_list)(nil)
-
-type _MsgSubmitProposal_3_list struct {
-	list *[]*anypb.Any
-}
-
-func (x *_MsgSubmitProposal_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSubmitProposal_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSubmitProposal_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSubmitProposal_3_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSubmitProposal_3_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_MsgSubmitProposal_4_list)(nil)
-
-type _MsgSubmitProposal_4_list struct {
-	list *[]*anypb.Any
-}
-
-func (x *_MsgSubmitProposal_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSubmitProposal_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSubmitProposal_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSubmitProposal_4_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSubmitProposal_4_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgSubmitProposal              protoreflect.MessageDescriptor
-	fd_MsgSubmitProposal_proposer     protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_agent        protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_pre_actions  protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_post_actions protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_metadata     protoreflect.FieldDescriptor
+	md_MsgCancelProposal          protoreflect.MessageDescriptor
+	fd_MsgCancelProposal_proposer protoreflect.FieldDescriptor
+	fd_MsgCancelProposal_agent    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_andromeda_escrow_v1alpha1_tx_proto_init()
-	md_MsgSubmitProposal = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgSubmitProposal")
-	fd_MsgSubmitProposal_proposer = md_MsgSubmitProposal.Fields().ByName("proposer")
-	fd_MsgSubmitProposal_agent = md_MsgSubmitProposal.Fields().ByName("agent")
-	fd_MsgSubmitProposal_pre_actions = md_MsgSubmitProposal.Fields().ByName("pre_actions")
-	fd_MsgSubmitProposal_post_actions = md_MsgSubmitProposal.Fields().ByName("post_actions")
-	fd_MsgSubmitProposal_metadata = md_MsgSubmitProposal.Fields().ByName("metadata")
+	md_MsgCancelProposal = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgCancelProposal")
+	fd_MsgCancelProposal_proposer = md_MsgCancelProposal.Fields().ByName("proposer")
+	fd_MsgCancelProposal_agent = md_MsgCancelProposal.Fields().ByName("agent")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCancelProposal)(nil)
 
-type fastReflection_MsgSubmitProposal MsgSubmitProposal
+type fastReflection_MsgCancelProposal MsgCancelProposal
 
-func (x *MsgSubmitProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposal)(x)
+func (x *MsgCancelProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelProposal)(x)
 }
 
-func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[4]
+func (x *MsgCancelProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1820,43 +6909,43 @@ func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitProposal_messageType fastReflection_MsgSubmitProposal_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitProposal_messageType{}
+var _fastReflection_MsgCancelProposal_messageType fastReflection_MsgCancelProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelProposal_messageType{}
 
-type fastReflection_MsgSubmitProposal_messageType struct{}
+type fastReflection_MsgCancelProposal_messageType struct{}
 
-func (x fastReflection_MsgSubmitProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposal)(nil)
+func (x fastReflection_MsgCancelProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelProposal)(nil)
 }
-func (x fastReflection_MsgSubmitProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposal)
+func (x fastReflection_MsgCancelProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelProposal)
 }
-func (x fastReflection_MsgSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposal
+func (x fastReflection_MsgCancelProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposal
+func (x *fastReflection_MsgCancelProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitProposal) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitProposal_messageType
+func (x *fastReflection_MsgCancelProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitProposal) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposal)
+func (x *fastReflection_MsgCancelProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitProposal)(x)
+func (x *fastReflection_MsgCancelProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1864,34 +6953,16 @@ func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCancelProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Proposer != "" {
 		value := protoreflect.ValueOfString(x.Proposer)
-		if !f(fd_MsgSubmitProposal_proposer, value) {
+		if !f(fd_MsgCancelProposal_proposer, value) {
 			return
 		}
 	}
 	if x.Agent != "" {
 		value := protoreflect.ValueOfString(x.Agent)
-		if !f(fd_MsgSubmitProposal_agent, value) {
-			return
-		}
-	}
-	if len(x.PreActions) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitProposal_3_list{list: &x.PreActions})
-		if !f(fd_MsgSubmitProposal_pre_actions, value) {
-			return
-		}
-	}
-	if len(x.PostActions) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &x.PostActions})
-		if !f(fd_MsgSubmitProposal_post_actions, value) {
-			return
-		}
-	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_MsgSubmitProposal_metadata, value) {
+		if !f(fd_MsgCancelProposal_agent, value) {
 			return
 		}
 	}
@@ -1908,23 +6979,17 @@ func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCancelProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.proposer":
 		return x.Proposer != ""
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.agent":
 		return x.Agent != ""
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
-		return len(x.PreActions) != 0
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
-		return len(x.PostActions) != 0
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
-		return x.Metadata != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1934,23 +6999,17 @@ func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCancelProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.proposer":
 		x.Proposer = ""
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.agent":
 		x.Agent = ""
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
-		x.PreActions = nil
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
-		x.PostActions = nil
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
-		x.Metadata = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1960,34 +7019,19 @@ func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.proposer":
 		value := x.Proposer
 		return protoreflect.ValueOfString(value)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.agent":
 		value := x.Agent
 		return protoreflect.ValueOfString(value)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
-		if len(x.PreActions) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitProposal_3_list{})
-		}
-		listValue := &_MsgSubmitProposal_3_list{list: &x.PreActions}
-		return protoreflect.ValueOfList(listValue)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
-		if len(x.PostActions) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{})
-		}
-		listValue := &_MsgSubmitProposal_4_list{list: &x.PostActions}
-		return protoreflect.ValueOfList(listValue)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2001,27 +7045,17 @@ func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCancelProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.proposer":
 		x.Proposer = value.Interface().(string)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.agent":
 		x.Agent = value.Interface().(string)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
-		lv := value.List()
-		clv := lv.(*_MsgSubmitProposal_3_list)
-		x.PreActions = *clv.list
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
-		lv := value.List()
-		clv := lv.(*_MsgSubmitProposal_4_list)
-		x.PostActions = *clv.list
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
-		x.Metadata = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2035,66 +7069,44 @@ func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
-		if x.PreActions == nil {
-			x.PreActions = []*anypb.Any{}
-		}
-		value := &_MsgSubmitProposal_3_list{list: &x.PreActions}
-		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
-		if x.PostActions == nil {
-			x.PostActions = []*anypb.Any{}
-		}
-		value := &_MsgSubmitProposal_4_list{list: &x.PostActions}
-		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
-		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
-		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
-		panic(fmt.Errorf("field metadata of message andromeda.escrow.v1alpha1.MsgSubmitProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.MsgCancelProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.MsgCancelProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.proposer":
-		return protoreflect.ValueOfString("")
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.agent":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.proposer":
 		return protoreflect.ValueOfString("")
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_MsgSubmitProposal_3_list{list: &list})
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &list})
-	case "andromeda.escrow.v1alpha1.MsgSubmitProposal.metadata":
+	case "andromeda.escrow.v1alpha1.MsgCancelProposal.agent":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCancelProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgSubmitProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgCancelProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2102,7 +7114,7 @@ func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCancelProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2113,7 +7125,7 @@ func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCancelProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2125,7 +7137,7 @@ func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
+func (x *fastReflection_MsgCancelProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -2135,9 +7147,9 @@ func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCancelProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgCancelProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2157,22 +7169,6 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.PreActions) > 0 {
-			for _, e := range x.PreActions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PostActions) > 0 {
-			for _, e := range x.PostActions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2183,7 +7179,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgCancelProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2202,45 +7198,6 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.PostActions) > 0 {
-			for iNdEx := len(x.PostActions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PostActions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.PreActions) > 0 {
-			for iNdEx := len(x.PreActions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PreActions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
 		if len(x.Agent) > 0 {
 			i -= len(x.Agent)
 			copy(dAtA[i:], x.Agent)
@@ -2266,7 +7223,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgCancelProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2298,115 +7255,17 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Proposer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Agent = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PreActions = append(x.PreActions, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2416,29 +7275,27 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PostActions = append(x.PostActions, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActions[len(x.PostActions)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2466,7 +7323,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
+				x.Agent = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2504,24 +7361,24 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSubmitProposalResponse protoreflect.MessageDescriptor
+	md_MsgCancelProposalResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_andromeda_escrow_v1alpha1_tx_proto_init()
-	md_MsgSubmitProposalResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgSubmitProposalResponse")
+	md_MsgCancelProposalResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgCancelProposalResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCancelProposalResponse)(nil)
 
-type fastReflection_MsgSubmitProposalResponse MsgSubmitProposalResponse
+type fastReflection_MsgCancelProposalResponse MsgCancelProposalResponse
 
-func (x *MsgSubmitProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposalResponse)(x)
+func (x *MsgCancelProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelProposalResponse)(x)
 }
 
-func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[5]
+func (x *MsgCancelProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,43 +7389,43 @@ func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitProposalResponse_messageType fastReflection_MsgSubmitProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitProposalResponse_messageType{}
+var _fastReflection_MsgCancelProposalResponse_messageType fastReflection_MsgCancelProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelProposalResponse_messageType{}
 
-type fastReflection_MsgSubmitProposalResponse_messageType struct{}
+type fastReflection_MsgCancelProposalResponse_messageType struct{}
 
-func (x fastReflection_MsgSubmitProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposalResponse)(nil)
+func (x fastReflection_MsgCancelProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelProposalResponse)(nil)
 }
-func (x fastReflection_MsgSubmitProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposalResponse)
+func (x fastReflection_MsgCancelProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelProposalResponse)
 }
-func (x fastReflection_MsgSubmitProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposalResponse
+func (x fastReflection_MsgCancelProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposalResponse
+func (x *fastReflection_MsgCancelProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitProposalResponse_messageType
+func (x *fastReflection_MsgCancelProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposalResponse)
+func (x *fastReflection_MsgCancelProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitProposalResponse)(x)
+func (x *fastReflection_MsgCancelProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2576,7 +7433,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCancelProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2590,13 +7447,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCancelProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposalResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2606,13 +7463,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCancelProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposalResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2622,13 +7479,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposalResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2642,13 +7499,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCancelProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposalResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2662,36 +7519,36 @@ func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposalResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgCancelProposalResponse"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgCancelProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCancelProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgSubmitProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgCancelProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2699,7 +7556,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCancelProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2710,7 +7567,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCancelProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2722,7 +7579,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
+func (x *fastReflection_MsgCancelProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2732,9 +7589,9 @@ func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCancelProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgCancelProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2756,7 +7613,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgCancelProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2786,7 +7643,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgCancelProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2818,10 +7675,10 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -2859,128 +7716,190 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_MsgExec_2_list)(nil)
+var _ protoreflect.List = (*_MsgUpdateProposal_3_list)(nil)
 
-type _MsgExec_2_list struct {
-	list *[]string
+type _MsgUpdateProposal_3_list struct {
+	list *[]*anypb.Any
 }
 
-func (x *_MsgExec_2_list) Len() int {
+func (x *_MsgUpdateProposal_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgExec_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_MsgUpdateProposal_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgExec_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgUpdateProposal_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgExec_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgUpdateProposal_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgExec_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgExec at list field Agents as it is not of Message kind"))
+func (x *_MsgUpdateProposal_3_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExec_2_list) Truncate(n int) {
+func (x *_MsgUpdateProposal_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgExec_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_MsgUpdateProposal_3_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExec_2_list) IsValid() bool {
+func (x *_MsgUpdateProposal_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_MsgExec_3_list)(nil)
+var _ protoreflect.List = (*_MsgUpdateProposal_4_list)(nil)
 
-type _MsgExec_3_list struct {
+type _MsgUpdateProposal_4_list struct {
 	list *[]*anypb.Any
 }
 
-func (x *_MsgExec_3_list) Len() int {
+func (x *_MsgUpdateProposal_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgExec_3_list) Get(i int) protoreflect.Value {
+func (x *_MsgUpdateProposal_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgExec_3_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgUpdateProposal_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgExec_3_list) Append(value protoreflect.Value) {
+func (x *_MsgUpdateProposal_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgExec_3_list) AppendMutable() protoreflect.Value {
+func (x *_MsgUpdateProposal_4_list) AppendMutable() protoreflect.Value {
 	v := new(anypb.Any)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExec_3_list) Truncate(n int) {
+func (x *_MsgUpdateProposal_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgExec_3_list) NewElement() protoreflect.Value {
+func (x *_MsgUpdateProposal_4_list) NewElement() protoreflect.Value {
 	v := new(anypb.Any)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgExec_3_list) IsValid() bool {
+func (x *_MsgUpdateProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdateProposal_6_list)(nil)
+
+type _MsgUpdateProposal_6_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgUpdateProposal_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateProposal_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateProposal_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateProposal_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateProposal_6_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateProposal_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateProposal_6_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateProposal_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgExec          protoreflect.MessageDescriptor
-	fd_MsgExec_executor protoreflect.FieldDescriptor
-	fd_MsgExec_agents   protoreflect.FieldDescriptor
-	fd_MsgExec_actions  protoreflect.FieldDescriptor
+	md_MsgUpdateProposal                protoreflect.MessageDescriptor
+	fd_MsgUpdateProposal_proposer       protoreflect.FieldDescriptor
+	fd_MsgUpdateProposal_agent          protoreflect.FieldDescriptor
+	fd_MsgUpdateProposal_pre_actions    protoreflect.FieldDescriptor
+	fd_MsgUpdateProposal_post_actions   protoreflect.FieldDescriptor
+	fd_MsgUpdateProposal_metadata       protoreflect.FieldDescriptor
+	fd_MsgUpdateProposal_refund_actions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_andromeda_escrow_v1alpha1_tx_proto_init()
-	md_MsgExec = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgExec")
-	fd_MsgExec_executor = md_MsgExec.Fields().ByName("executor")
-	fd_MsgExec_agents = md_MsgExec.Fields().ByName("agents")
-	fd_MsgExec_actions = md_MsgExec.Fields().ByName("actions")
+	md_MsgUpdateProposal = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgUpdateProposal")
+	fd_MsgUpdateProposal_proposer = md_MsgUpdateProposal.Fields().ByName("proposer")
+	fd_MsgUpdateProposal_agent = md_MsgUpdateProposal.Fields().ByName("agent")
+	fd_MsgUpdateProposal_pre_actions = md_MsgUpdateProposal.Fields().ByName("pre_actions")
+	fd_MsgUpdateProposal_post_actions = md_MsgUpdateProposal.Fields().ByName("post_actions")
+	fd_MsgUpdateProposal_metadata = md_MsgUpdateProposal.Fields().ByName("metadata")
+	fd_MsgUpdateProposal_refund_actions = md_MsgUpdateProposal.Fields().ByName("refund_actions")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExec)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateProposal)(nil)
 
-type fastReflection_MsgExec MsgExec
+type fastReflection_MsgUpdateProposal MsgUpdateProposal
 
-func (x *MsgExec) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExec)(x)
+func (x *MsgUpdateProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposal)(x)
 }
 
-func (x *MsgExec) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[6]
+func (x *MsgUpdateProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2991,43 +7910,43 @@ func (x *MsgExec) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExec_messageType fastReflection_MsgExec_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExec_messageType{}
+var _fastReflection_MsgUpdateProposal_messageType fastReflection_MsgUpdateProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateProposal_messageType{}
 
-type fastReflection_MsgExec_messageType struct{}
+type fastReflection_MsgUpdateProposal_messageType struct{}
 
-func (x fastReflection_MsgExec_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExec)(nil)
+func (x fastReflection_MsgUpdateProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposal)(nil)
 }
-func (x fastReflection_MsgExec_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExec)
+func (x fastReflection_MsgUpdateProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposal)
 }
-func (x fastReflection_MsgExec_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExec
+func (x fastReflection_MsgUpdateProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExec) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExec
+func (x *fastReflection_MsgUpdateProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExec) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExec_messageType
+func (x *fastReflection_MsgUpdateProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExec) New() protoreflect.Message {
-	return new(fastReflection_MsgExec)
+func (x *fastReflection_MsgUpdateProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExec) Interface() protoreflect.ProtoMessage {
-	return (*MsgExec)(x)
+func (x *fastReflection_MsgUpdateProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3035,22 +7954,40 @@ func (x *fastReflection_MsgExec) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExec) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Executor != "" {
-		value := protoreflect.ValueOfString(x.Executor)
-		if !f(fd_MsgExec_executor, value) {
+func (x *fastReflection_MsgUpdateProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_MsgUpdateProposal_proposer, value) {
 			return
 		}
 	}
-	if len(x.Agents) != 0 {
-		value := protoreflect.ValueOfList(&_MsgExec_2_list{list: &x.Agents})
-		if !f(fd_MsgExec_agents, value) {
+	if x.Agent != "" {
+		value := protoreflect.ValueOfString(x.Agent)
+		if !f(fd_MsgUpdateProposal_agent, value) {
 			return
 		}
 	}
-	if len(x.Actions) != 0 {
-		value := protoreflect.ValueOfList(&_MsgExec_3_list{list: &x.Actions})
-		if !f(fd_MsgExec_actions, value) {
+	if len(x.PreActions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateProposal_3_list{list: &x.PreActions})
+		if !f(fd_MsgUpdateProposal_pre_actions, value) {
+			return
+		}
+	}
+	if len(x.PostActions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateProposal_4_list{list: &x.PostActions})
+		if !f(fd_MsgUpdateProposal_post_actions, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_MsgUpdateProposal_metadata, value) {
+			return
+		}
+	}
+	if len(x.RefundActions) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateProposal_6_list{list: &x.RefundActions})
+		if !f(fd_MsgUpdateProposal_refund_actions, value) {
 			return
 		}
 	}
@@ -3067,19 +8004,25 @@ func (x *fastReflection_MsgExec) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExec) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgExec.executor":
-		return x.Executor != ""
-	case "andromeda.escrow.v1alpha1.MsgExec.agents":
-		return len(x.Agents) != 0
-	case "andromeda.escrow.v1alpha1.MsgExec.actions":
-		return len(x.Actions) != 0
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.proposer":
+		return x.Proposer != ""
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.agent":
+		return x.Agent != ""
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.pre_actions":
+		return len(x.PreActions) != 0
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.post_actions":
+		return len(x.PostActions) != 0
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.metadata":
+		return x.Metadata != ""
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.refund_actions":
+		return len(x.RefundActions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3089,19 +8032,25 @@ func (x *fastReflection_MsgExec) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgExec.executor":
-		x.Executor = ""
-	case "andromeda.escrow.v1alpha1.MsgExec.agents":
-		x.Agents = nil
-	case "andromeda.escrow.v1alpha1.MsgExec.actions":
-		x.Actions = nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.proposer":
+		x.Proposer = ""
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.agent":
+		x.Agent = ""
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.pre_actions":
+		x.PreActions = nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.post_actions":
+		x.PostActions = nil
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.metadata":
+		x.Metadata = ""
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.refund_actions":
+		x.RefundActions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3111,28 +8060,40 @@ func (x *fastReflection_MsgExec) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExec) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgExec.executor":
-		value := x.Executor
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.proposer":
+		value := x.Proposer
 		return protoreflect.ValueOfString(value)
-	case "andromeda.escrow.v1alpha1.MsgExec.agents":
-		if len(x.Agents) == 0 {
-			return protoreflect.ValueOfList(&_MsgExec_2_list{})
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.agent":
+		value := x.Agent
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.pre_actions":
+		if len(x.PreActions) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateProposal_3_list{})
 		}
-		listValue := &_MsgExec_2_list{list: &x.Agents}
+		listValue := &_MsgUpdateProposal_3_list{list: &x.PreActions}
 		return protoreflect.ValueOfList(listValue)
-	case "andromeda.escrow.v1alpha1.MsgExec.actions":
-		if len(x.Actions) == 0 {
-			return protoreflect.ValueOfList(&_MsgExec_3_list{})
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.post_actions":
+		if len(x.PostActions) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateProposal_4_list{})
 		}
-		listValue := &_MsgExec_3_list{list: &x.Actions}
+		listValue := &_MsgUpdateProposal_4_list{list: &x.PostActions}
+		return protoreflect.ValueOfList(listValue)
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.refund_actions":
+		if len(x.RefundActions) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateProposal_6_list{})
+		}
+		listValue := &_MsgUpdateProposal_6_list{list: &x.RefundActions}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3146,23 +8107,31 @@ func (x *fastReflection_MsgExec) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgExec.executor":
-		x.Executor = value.Interface().(string)
-	case "andromeda.escrow.v1alpha1.MsgExec.agents":
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.agent":
+		x.Agent = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.pre_actions":
 		lv := value.List()
-		clv := lv.(*_MsgExec_2_list)
-		x.Agents = *clv.list
-	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+		clv := lv.(*_MsgUpdateProposal_3_list)
+		x.PreActions = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.post_actions":
 		lv := value.List()
-		clv := lv.(*_MsgExec_3_list)
-		x.Actions = *clv.list
+		clv := lv.(*_MsgUpdateProposal_4_list)
+		x.PostActions = *clv.list
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.refund_actions":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateProposal_6_list)
+		x.RefundActions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3176,58 +8145,75 @@ func (x *fastReflection_MsgExec) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgExec.agents":
-		if x.Agents == nil {
-			x.Agents = []string{}
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.pre_actions":
+		if x.PreActions == nil {
+			x.PreActions = []*anypb.Any{}
 		}
-		value := &_MsgExec_2_list{list: &x.Agents}
+		value := &_MsgUpdateProposal_3_list{list: &x.PreActions}
 		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.MsgExec.actions":
-		if x.Actions == nil {
-			x.Actions = []*anypb.Any{}
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.post_actions":
+		if x.PostActions == nil {
+			x.PostActions = []*anypb.Any{}
 		}
-		value := &_MsgExec_3_list{list: &x.Actions}
+		value := &_MsgUpdateProposal_4_list{list: &x.PostActions}
 		return protoreflect.ValueOfList(value)
-	case "andromeda.escrow.v1alpha1.MsgExec.executor":
-		panic(fmt.Errorf("field executor of message andromeda.escrow.v1alpha1.MsgExec is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.refund_actions":
+		if x.RefundActions == nil {
+			x.RefundActions = []*anypb.Any{}
+		}
+		value := &_MsgUpdateProposal_6_list{list: &x.RefundActions}
+		return protoreflect.ValueOfList(value)
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.proposer":
+		panic(fmt.Errorf("field proposer of message andromeda.escrow.v1alpha1.MsgUpdateProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.agent":
+		panic(fmt.Errorf("field agent of message andromeda.escrow.v1alpha1.MsgUpdateProposal is not mutable"))
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.metadata":
+		panic(fmt.Errorf("field metadata of message andromeda.escrow.v1alpha1.MsgUpdateProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExec) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "andromeda.escrow.v1alpha1.MsgExec.executor":
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.proposer":
 		return protoreflect.ValueOfString("")
-	case "andromeda.escrow.v1alpha1.MsgExec.agents":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgExec_2_list{list: &list})
-	case "andromeda.escrow.v1alpha1.MsgExec.actions":
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.agent":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.pre_actions":
 		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_MsgExec_3_list{list: &list})
+		return protoreflect.ValueOfList(&_MsgUpdateProposal_3_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.post_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgUpdateProposal_4_list{list: &list})
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.metadata":
+		return protoreflect.ValueOfString("")
+	case "andromeda.escrow.v1alpha1.MsgUpdateProposal.refund_actions":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgUpdateProposal_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposal"))
 		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExec) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgExec", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgUpdateProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3235,7 +8221,7 @@ func (x *fastReflection_MsgExec) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExec) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3246,7 +8232,7 @@ func (x *fastReflection_MsgExec) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3258,7 +8244,7 @@ func (x *fastReflection_MsgExec) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExec) IsValid() bool {
+func (x *fastReflection_MsgUpdateProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -3268,9 +8254,9 @@ func (x *fastReflection_MsgExec) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExec)
+		x := input.Message.Interface().(*MsgUpdateProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3282,18 +8268,32 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Executor)
+		l = len(x.Proposer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Agents) > 0 {
-			for _, s := range x.Agents {
-				l = len(s)
+		l = len(x.Agent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PreActions) > 0 {
+			for _, e := range x.PreActions {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.Actions) > 0 {
-			for _, e := range x.Actions {
+		if len(x.PostActions) > 0 {
+			for _, e := range x.PostActions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RefundActions) > 0 {
+			for _, e := range x.RefundActions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3308,7 +8308,7 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExec)
+		x := input.Message.Interface().(*MsgUpdateProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3327,9 +8327,9 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Actions) > 0 {
-			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Actions[iNdEx])
+		if len(x.RefundActions) > 0 {
+			for iNdEx := len(x.RefundActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundActions[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3340,22 +8340,59 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x1a
+				dAtA[i] = 0x32
 			}
 		}
-		if len(x.Agents) > 0 {
-			for iNdEx := len(x.Agents) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Agents[iNdEx])
-				copy(dAtA[i:], x.Agents[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agents[iNdEx])))
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.PostActions) > 0 {
+			for iNdEx := len(x.PostActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x22
 			}
 		}
-		if len(x.Executor) > 0 {
-			i -= len(x.Executor)
-			copy(dAtA[i:], x.Executor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+		if len(x.PreActions) > 0 {
+			for iNdEx := len(x.PreActions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PreActions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Agent) > 0 {
+			i -= len(x.Agent)
+			copy(dAtA[i:], x.Agent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Agent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3370,7 +8407,7 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExec)
+		x := input.Message.Interface().(*MsgUpdateProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3398,21 +8435,119 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Agent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreActions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreActions = append(x.PreActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreActions[len(x.PreActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostActions", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3422,27 +8557,29 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Executor = string(dAtA[iNdEx:postIndex])
+				x.PostActions = append(x.PostActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostActions[len(x.PostActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 2:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3470,11 +8607,11 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Agents = append(x.Agents, string(dAtA[iNdEx:postIndex]))
+				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundActions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3498,14 +8635,370 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundActions = append(x.RefundActions, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundActions[len(x.RefundActions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateProposalResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_andromeda_escrow_v1alpha1_tx_proto_init()
+	md_MsgUpdateProposalResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgUpdateProposalResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateProposalResponse)(nil)
+
+type fastReflection_MsgUpdateProposalResponse MsgUpdateProposalResponse
+
+func (x *MsgUpdateProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposalResponse)(x)
+}
+
+func (x *MsgUpdateProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateProposalResponse_messageType fastReflection_MsgUpdateProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateProposalResponse_messageType{}
+
+type fastReflection_MsgUpdateProposalResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProposalResponse)(nil)
+}
+func (x fastReflection_MsgUpdateProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposalResponse)
+}
+func (x fastReflection_MsgUpdateProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgUpdateProposalResponse"))
+		}
+		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgUpdateProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgUpdateProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Actions = append(x.Actions, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Actions[len(x.Actions)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3541,524 +9034,731 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_MsgExecResponse protoreflect.MessageDescriptor
-)
-
-func init() {
-	file_andromeda_escrow_v1alpha1_tx_proto_init()
-	md_MsgExecResponse = File_andromeda_escrow_v1alpha1_tx_proto.Messages().ByName("MsgExecResponse")
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: andromeda/escrow/v1alpha1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the address of the module authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the maximum length allowed for metadata
+	MaxMetadataLength uint64 `protobuf:"varint,2,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the flat fee charged to the executor on Msg/Exec
+	// Note: null or zero means no flat fee.
+	ExecFeeFlat *v1beta1.Coin `protobuf:"bytes,3,opt,name=exec_fee_flat,json=execFeeFlat,proto3" json:"exec_fee_flat,omitempty"`
+	// the fee charged to the executor on Msg/Exec, in basis points of the value
+	// declared by the executor on Msg/Exec, which is not checked against the
+	// actions
+	// Note: zero means no fee in basis points. At most one of exec_fee_flat and
+	// exec_fee_bps can be set.
+	ExecFeeBps uint32 `protobuf:"varint,4,opt,name=exec_fee_bps,json=execFeeBps,proto3" json:"exec_fee_bps,omitempty"`
+	// the minimum deposit required on Msg/SubmitProposal
+	// Note: the deposit would be refunded on the execution or the
+	// cancellation of the proposal.
+	MinProposalDeposit []*v1beta1.Coin `protobuf:"bytes,5,rep,name=min_proposal_deposit,json=minProposalDeposit,proto3" json:"min_proposal_deposit,omitempty"`
+	// whether to burn the deposits of the expired proposals
+	// Note: false means sending them to the community pool.
+	BurnExpiredDeposits bool `protobuf:"varint,6,opt,name=burn_expired_deposits,json=burnExpiredDeposits,proto3" json:"burn_expired_deposits,omitempty"`
+	// the maximum number of pre_actions of a proposal
+	MaxPreActions uint64 `protobuf:"varint,7,opt,name=max_pre_actions,json=maxPreActions,proto3" json:"max_pre_actions,omitempty"`
+	// the maximum number of post_actions of a proposal
+	MaxPostActions uint64 `protobuf:"varint,8,opt,name=max_post_actions,json=maxPostActions,proto3" json:"max_post_actions,omitempty"`
+	// the maximum number of actions of Msg/Exec
+	MaxActions uint64 `protobuf:"varint,9,opt,name=max_actions,json=maxActions,proto3" json:"max_actions,omitempty"`
+	// the maximum number of agents of Msg/Exec
+	MaxAgentsPerExec uint64 `protobuf:"varint,10,opt,name=max_agents_per_exec,json=maxAgentsPerExec,proto3" json:"max_agents_per_exec,omitempty"`
+	// the maximum encoded size of an action, in bytes
+	MaxActionSize uint64 `protobuf:"varint,11,opt,name=max_action_size,json=maxActionSize,proto3" json:"max_action_size,omitempty"`
+	// the type urls of the messages allowed in the actions
+	// Note: empty means all the messages are allowed. An entry ending with "*"
+	// matches the type urls having the preceding prefix.
+	AllowedMessageTypes []string `protobuf:"bytes,12,rep,name=allowed_message_types,json=allowedMessageTypes,proto3" json:"allowed_message_types,omitempty"`
+	// the type urls of the messages denied in the actions, which takes precedence
+	// over allowed_message_types
+	// Note: an entry ending with "*" matches the type urls having the preceding
+	// prefix.
+	DeniedMessageTypes []string `protobuf:"bytes,13,rep,name=denied_message_types,json=deniedMessageTypes,proto3" json:"denied_message_types,omitempty"`
+	// the maximum depth of the escrow messages nested in the actions
+	// Note: zero means no escrow message is allowed in the actions.
+	MaxNestingDepth uint64 `protobuf:"varint,14,opt,name=max_nesting_depth,json=maxNestingDepth,proto3" json:"max_nesting_depth,omitempty"`
+	// the gas charged per agent on Msg/Exec
+	GasPerAgent uint64 `protobuf:"varint,15,opt,name=gas_per_agent,json=gasPerAgent,proto3" json:"gas_per_agent,omitempty"`
+	// the gas charged per action executed
+	GasPerAction uint64 `protobuf:"varint,16,opt,name=gas_per_action,json=gasPerAction,proto3" json:"gas_per_action,omitempty"`
+	// the gas charged per byte of the actions stored in a proposal
+	GasPerActionByte uint64 `protobuf:"varint,17,opt,name=gas_per_action_byte,json=gasPerActionByte,proto3" json:"gas_per_action_byte,omitempty"`
+	// the maximum number of the expired proposals pruned per block
+	// Note: the rest are pruned in the following blocks.
+	MaxPrunesPerBlock uint64 `protobuf:"varint,18,opt,name=max_prunes_per_block,json=maxPrunesPerBlock,proto3" json:"max_prunes_per_block,omitempty"`
+	// the maximum number of refund_actions of a proposal
+	MaxRefundActions uint64 `protobuf:"varint,19,opt,name=max_refund_actions,json=maxRefundActions,proto3" json:"max_refund_actions,omitempty"`
+	// the maximum gas consumed by a simulation of Query/SimulateExec or
+	// Query/SimulateSubmit
+	// Note: the gas limit of the queries on the node applies if it is lower.
+	MaxSimulateGas uint64 `protobuf:"varint,20,opt,name=max_simulate_gas,json=maxSimulateGas,proto3" json:"max_simulate_gas,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetMaxMetadataLength() uint64 {
+	if x != nil {
+		return x.MaxMetadataLength
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetExecFeeFlat() *v1beta1.Coin {
+	if x != nil {
+		return x.ExecFeeFlat
+	}
+	return nil
+}
+
+func (x *MsgUpdateParams) GetExecFeeBps() uint32 {
+	if x != nil {
+		return x.ExecFeeBps
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMinProposalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinProposalDeposit
+	}
+	return nil
+}
+
+func (x *MsgUpdateParams) GetBurnExpiredDeposits() bool {
+	if x != nil {
+		return x.BurnExpiredDeposits
+	}
+	return false
+}
+
+func (x *MsgUpdateParams) GetMaxPreActions() uint64 {
+	if x != nil {
+		return x.MaxPreActions
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMaxPostActions() uint64 {
+	if x != nil {
+		return x.MaxPostActions
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMaxActions() uint64 {
+	if x != nil {
+		return x.MaxActions
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMaxAgentsPerExec() uint64 {
+	if x != nil {
+		return x.MaxAgentsPerExec
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMaxActionSize() uint64 {
+	if x != nil {
+		return x.MaxActionSize
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetAllowedMessageTypes() []string {
+	if x != nil {
+		return x.AllowedMessageTypes
+	}
+	return nil
+}
+
+func (x *MsgUpdateParams) GetDeniedMessageTypes() []string {
+	if x != nil {
+		return x.DeniedMessageTypes
+	}
+	return nil
+}
+
+func (x *MsgUpdateParams) GetMaxNestingDepth() uint64 {
+	if x != nil {
+		return x.MaxNestingDepth
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetGasPerAgent() uint64 {
+	if x != nil {
+		return x.GasPerAgent
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetGasPerAction() uint64 {
+	if x != nil {
+		return x.GasPerAction
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetGasPerActionByte() uint64 {
+	if x != nil {
+		return x.GasPerActionByte
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMaxPrunesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunesPerBlock
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMaxRefundActions() uint64 {
+	if x != nil {
+		return x.MaxRefundActions
+	}
+	return 0
+}
+
+func (x *MsgUpdateParams) GetMaxSimulateGas() uint64 {
+	if x != nil {
+		return x.MaxSimulateGas
+	}
+	return 0
+}
+
+// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExecResponse)(nil)
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
-type fastReflection_MsgExecResponse MsgExecResponse
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{1}
+}
 
-func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExecResponse)(x)
+// MsgCreateAgent is the Msg/CreateAgent request type.
+type MsgCreateAgent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the address of the account creating the agent
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *MsgCreateAgent) Reset() {
+	*x = MsgCreateAgent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExecResponse_messageType fastReflection_MsgExecResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExecResponse_messageType{}
+func (x *MsgCreateAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_MsgExecResponse_messageType struct{}
+func (*MsgCreateAgent) ProtoMessage() {}
 
-func (x fastReflection_MsgExecResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExecResponse)(nil)
+// Deprecated: Use MsgCreateAgent.ProtoReflect.Descriptor instead.
+func (*MsgCreateAgent) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{2}
 }
-func (x fastReflection_MsgExecResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExecResponse)
+
+func (x *MsgCreateAgent) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
 }
-func (x fastReflection_MsgExecResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecResponse
+
+// MsgCreateAgentResponse is the Msg/CreateAgent response type.
+type MsgCreateAgentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the address of the created agent
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MsgExecResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecResponse
+func (x *MsgCreateAgentResponse) Reset() {
+	*x = MsgCreateAgentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExecResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExecResponse_messageType
+func (x *MsgCreateAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExecResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgExecResponse)
+func (*MsgCreateAgentResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateAgentResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateAgentResponse) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{3}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgExecResponse)(x)
+func (x *MsgCreateAgentResponse) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+// MsgSubmitProposal is the Msg/SubmitProposal request type.
+type MsgSubmitProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	// Note: it must be created by the proposer.
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the messages which will be executed on the submission
+	// Note: the signer of each message must be either the proposer or the agent.
+	PreActions []*anypb.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
+	// the messages which will be executed after the actions included in Msg/Exec
+	// Note: the signer of each message must be either the proposer or the agent.
+	PostActions []*anypb.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
+	// any arbitrary metadata attached to the proposal
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will be executed on the cancellation
+	// Note: the signer of each message must be the agent.
+	RefundActions []*anypb.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
+	// the block height from which the proposal is considered expired
+	// Note: zero means no expiry by height.
+	ExpireHeight uint64 `protobuf:"varint,7,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	// the block time from which the proposal is considered expired
+	// Note: null means no expiry by time.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// the total quantity of the units
+	// Note: zero means the proposal is not fillable partially. Otherwise, the
+	// post_actions and the refund_actions are per unit.
+	Quantity uint64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// the addresses of the accounts eligible to execute the proposal
+	// Note: empty means no restriction by addresses.
+	Executors []string `protobuf:"bytes,10,rep,name=executors,proto3" json:"executors,omitempty"`
+	// the id of the x/group group whose members are eligible to execute the
+	// proposal
+	// Note: zero means no restriction by group.
+	ExecutorGroupId uint64 `protobuf:"varint,11,opt,name=executor_group_id,json=executorGroupId,proto3" json:"executor_group_id,omitempty"`
+	// the maximum gas the post-actions may consume on Msg/Exec
+	// Note: zero means no limit.
+	MaxExecGas uint64 `protobuf:"varint,12,opt,name=max_exec_gas,json=maxExecGas,proto3" json:"max_exec_gas,omitempty"`
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
+func (x *MsgSubmitProposal) Reset() {
+	*x = MsgSubmitProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
+func (x *MsgSubmitProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitProposal) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitProposal.ProtoReflect.Descriptor instead.
+func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgSubmitProposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
 	}
+	return ""
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", descriptor.FullName()))
+func (x *MsgSubmitProposal) GetAgent() string {
+	if x != nil {
+		return x.Agent
 	}
+	return ""
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
+func (x *MsgSubmitProposal) GetPreActions() []*anypb.Any {
+	if x != nil {
+		return x.PreActions
 	}
+	return nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
+func (x *MsgSubmitProposal) GetPostActions() []*anypb.Any {
+	if x != nil {
+		return x.PostActions
 	}
+	return nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExecResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: andromeda.escrow.v1alpha1.MsgExecResponse"))
-		}
-		panic(fmt.Errorf("message andromeda.escrow.v1alpha1.MsgExecResponse does not contain field %s", fd.FullName()))
+func (x *MsgSubmitProposal) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
 	}
+	return ""
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in andromeda.escrow.v1alpha1.MsgExecResponse", d.FullName()))
+func (x *MsgSubmitProposal) GetRefundActions() []*anypb.Any {
+	if x != nil {
+		return x.RefundActions
 	}
-	panic("unreachable")
+	return nil
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *MsgSubmitProposal) GetExpireHeight() uint64 {
+	if x != nil {
+		return x.ExpireHeight
+	}
+	return 0
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *MsgSubmitProposal) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExecResponse) IsValid() bool {
-	return x != nil
+func (x *MsgSubmitProposal) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExecResponse)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *MsgSubmitProposal) GetExecutors() []string {
+	if x != nil {
+		return x.Executors
 	}
+	return nil
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecResponse)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *MsgSubmitProposal) GetExecutorGroupId() uint64 {
+	if x != nil {
+		return x.ExecutorGroupId
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecResponse)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return 0
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *MsgSubmitProposal) GetMaxExecGas() uint64 {
+	if x != nil {
+		return x.MaxExecGas
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+// MsgSubmitProposalResponse is the Msg/SubmitProposal response type.
+type MsgSubmitProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the responses of the pre-actions, in the same order of the pre-actions
+	PreActionResponses []*anypb.Any `protobuf:"bytes,1,rep,name=pre_action_responses,json=preActionResponses,proto3" json:"pre_action_responses,omitempty"`
+}
+
+func (x *MsgSubmitProposalResponse) Reset() {
+	*x = MsgSubmitProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: andromeda/escrow/v1alpha1/tx.proto
+func (x *MsgSubmitProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*MsgSubmitProposalResponse) ProtoMessage() {}
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
+// Deprecated: Use MsgSubmitProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgSubmitProposalResponse) GetPreActionResponses() []*anypb.Any {
+	if x != nil {
+		return x.PreActionResponses
+	}
+	return nil
+}
+
+// MsgExec is the Msg/Exec request type.
+type MsgExec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the address of the module authority
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// the maximum length allowed for metadata
-	MaxMetadataLength uint64 `protobuf:"varint,2,opt,name=max_metadata_length,json=maxMetadataLength,proto3" json:"max_metadata_length,omitempty"`
+	// the address of the account executing the proposal
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	// the addresses of the agents in charge
+	Agents []string `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
+	// the messages which will be executed on the execution
+	// Note: the signer of each message must be either the executor or one of the
+	// agents.
+	Actions []*anypb.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// the quantities of the units to fill, in the same order of the agents
+	// Note: empty means filling all the remaining units of each proposal. Zero
+	// quantity of a fillable proposal also means filling all the remaining
+	// units.
+	Quantities []uint64 `protobuf:"varint,4,rep,packed,name=quantities,proto3" json:"quantities,omitempty"`
+	// the value of the execution declared by the executor, on which the fee in
+	// basis points is charged
+	DeclaredValue []*v1beta1.Coin `protobuf:"bytes,5,rep,name=declared_value,json=declaredValue,proto3" json:"declared_value,omitempty"`
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgExec) Reset() {
+	*x = MsgExec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[0]
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MsgExec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgExec) ProtoMessage() {}
+
+// Deprecated: Use MsgExec.ProtoReflect.Descriptor instead.
+func (*MsgExec) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgExec) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *MsgExec) GetAgents() []string {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
-
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{0}
+func (x *MsgExec) GetActions() []*anypb.Any {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgExec) GetQuantities() []uint64 {
 	if x != nil {
-		return x.Authority
+		return x.Quantities
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgUpdateParams) GetMaxMetadataLength() uint64 {
+func (x *MsgExec) GetDeclaredValue() []*v1beta1.Coin {
 	if x != nil {
-		return x.MaxMetadataLength
+		return x.DeclaredValue
 	}
-	return 0
+	return nil
 }
 
-// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
-type MsgUpdateParamsResponse struct {
+// MsgExecResponse is the Msg/Exec response type.
+type MsgExecResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the responses of the actions, in the same order of the actions
+	ActionResponses []*anypb.Any `protobuf:"bytes,1,rep,name=action_responses,json=actionResponses,proto3" json:"action_responses,omitempty"`
+	// the responses of the post-actions of each proposal, in the same order of
+	// the agents
+	PostActionResponses []*MsgExecResponse_PostActionResponses `protobuf:"bytes,2,rep,name=post_action_responses,json=postActionResponses,proto3" json:"post_action_responses,omitempty"`
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgExecResponse) Reset() {
+	*x = MsgExecResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[1]
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgExecResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgExecResponse.ProtoReflect.Descriptor instead.
+func (*MsgExecResponse) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{7}
 }
 
-// MsgCreateAgent is the Msg/CreateAgent request type.
-type MsgCreateAgent struct {
+func (x *MsgExecResponse) GetActionResponses() []*anypb.Any {
+	if x != nil {
+		return x.ActionResponses
+	}
+	return nil
+}
+
+func (x *MsgExecResponse) GetPostActionResponses() []*MsgExecResponse_PostActionResponses {
+	if x != nil {
+		return x.PostActionResponses
+	}
+	return nil
+}
+
+// MsgCancelProposal is the Msg/CancelProposal request type.
+type MsgCancelProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the address of the account creating the agent
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// the address of the proposer
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
 }
 
-func (x *MsgCreateAgent) Reset() {
-	*x = MsgCreateAgent{}
+func (x *MsgCancelProposal) Reset() {
+	*x = MsgCancelProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[2]
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateAgent) String() string {
+func (x *MsgCancelProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateAgent) ProtoMessage() {}
+func (*MsgCancelProposal) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateAgent.ProtoReflect.Descriptor instead.
-func (*MsgCreateAgent) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgCancelProposal.ProtoReflect.Descriptor instead.
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MsgCreateAgent) GetCreator() string {
+func (x *MsgCancelProposal) GetProposer() string {
 	if x != nil {
-		return x.Creator
+		return x.Proposer
 	}
 	return ""
 }
 
-// MsgCreateAgentResponse is the Msg/CreateAgent response type.
-type MsgCreateAgentResponse struct {
+func (x *MsgCancelProposal) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+// MsgCancelProposalResponse is the Msg/CancelProposal response type.
+type MsgCancelProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// the address of the created agent
-	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
 }
 
-func (x *MsgCreateAgentResponse) Reset() {
-	*x = MsgCreateAgentResponse{}
+func (x *MsgCancelProposalResponse) Reset() {
+	*x = MsgCancelProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[3]
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateAgentResponse) String() string {
+func (x *MsgCancelProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateAgentResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgCreateAgentResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{3}
-}
+func (*MsgCancelProposalResponse) ProtoMessage() {}
 
-func (x *MsgCreateAgentResponse) GetAgent() string {
-	if x != nil {
-		return x.Agent
-	}
-	return ""
+// Deprecated: Use MsgCancelProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{9}
 }
 
-// MsgSubmitProposal is the Msg/SubmitProposal request type.
-type MsgSubmitProposal struct {
+// MsgUpdateProposal is the Msg/UpdateProposal request type.
+type MsgUpdateProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -4066,184 +9766,158 @@ type MsgSubmitProposal struct {
 	// the address of the proposer
 	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// the address of the agent in charge
-	// Note: it must be created by the proposer.
 	Agent string `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`
-	// the messages which will be executed on the submission
+	// the additional messages which will be executed on the update
 	// Note: the signer of each message must be either the proposer or the agent.
 	PreActions []*anypb.Any `protobuf:"bytes,3,rep,name=pre_actions,json=preActions,proto3" json:"pre_actions,omitempty"`
-	// the messages which will be executed after the actions included in Msg/Exec
+	// the messages which will replace the post_actions of the proposal
 	// Note: the signer of each message must be either the proposer or the agent.
+	// Note: empty means no change.
 	PostActions []*anypb.Any `protobuf:"bytes,4,rep,name=post_actions,json=postActions,proto3" json:"post_actions,omitempty"`
-	// any arbitrary metadata attached to the proposal
+	// the metadata which will replace the metadata of the proposal
+	// Note: empty means no change.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the messages which will replace the refund_actions of the proposal
+	// Note: the signer of each message must be the agent.
+	// Note: empty means no change.
+	RefundActions []*anypb.Any `protobuf:"bytes,6,rep,name=refund_actions,json=refundActions,proto3" json:"refund_actions,omitempty"`
 }
 
-func (x *MsgSubmitProposal) Reset() {
-	*x = MsgSubmitProposal{}
+func (x *MsgUpdateProposal) Reset() {
+	*x = MsgUpdateProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[4]
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitProposal) String() string {
+func (x *MsgUpdateProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitProposal) ProtoMessage() {}
+func (*MsgUpdateProposal) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitProposal.ProtoReflect.Descriptor instead.
-func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgUpdateProposal.ProtoReflect.Descriptor instead.
+func (*MsgUpdateProposal) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgSubmitProposal) GetProposer() string {
+func (x *MsgUpdateProposal) GetProposer() string {
 	if x != nil {
 		return x.Proposer
 	}
 	return ""
 }
 
-func (x *MsgSubmitProposal) GetAgent() string {
+func (x *MsgUpdateProposal) GetAgent() string {
 	if x != nil {
 		return x.Agent
 	}
 	return ""
 }
 
-func (x *MsgSubmitProposal) GetPreActions() []*anypb.Any {
+func (x *MsgUpdateProposal) GetPreActions() []*anypb.Any {
 	if x != nil {
 		return x.PreActions
 	}
 	return nil
 }
 
-func (x *MsgSubmitProposal) GetPostActions() []*anypb.Any {
+func (x *MsgUpdateProposal) GetPostActions() []*anypb.Any {
 	if x != nil {
 		return x.PostActions
 	}
 	return nil
 }
 
-func (x *MsgSubmitProposal) GetMetadata() string {
+func (x *MsgUpdateProposal) GetMetadata() string {
 	if x != nil {
 		return x.Metadata
 	}
 	return ""
 }
 
-// MsgSubmitProposalResponse is the Msg/SubmitProposal response type.
-type MsgSubmitProposalResponse struct {
+func (x *MsgUpdateProposal) GetRefundActions() []*anypb.Any {
+	if x != nil {
+		return x.RefundActions
+	}
+	return nil
+}
+
+// MsgUpdateProposalResponse is the Msg/UpdateProposal response type.
+type MsgUpdateProposalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSubmitProposalResponse) Reset() {
-	*x = MsgSubmitProposalResponse{}
+func (x *MsgUpdateProposalResponse) Reset() {
+	*x = MsgUpdateProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[5]
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitProposalResponse) String() string {
+func (x *MsgUpdateProposalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitProposalResponse) ProtoMessage() {}
+func (*MsgUpdateProposalResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitProposalResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgUpdateProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateProposalResponse) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-// MsgExec is the Msg/Exec request type.
-type MsgExec struct {
+// PostActionResponses is the responses of the post-actions of a proposal.
+type MsgExecResponse_PostActionResponses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the address of the account executing the proposal
-	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
-	// the addresses of the agents in charge
-	Agents []string `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
-	// the messages which will be executed on the execution
-	// Note: the signer of each message must be either the executor or one of the
-	// agents.
-	Actions []*anypb.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// the address of the agent in charge
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// the responses of the post-actions, in the same order of the post-actions
+	Responses []*anypb.Any `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (x *MsgExec) Reset() {
-	*x = MsgExec{}
+func (x *MsgExecResponse_PostActionResponses) Reset() {
+	*x = MsgExecResponse_PostActionResponses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[6]
+		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgExec) String() string {
+func (x *MsgExecResponse_PostActionResponses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgExec) ProtoMessage() {}
+func (*MsgExecResponse_PostActionResponses) ProtoMessage() {}
 
-// Deprecated: Use MsgExec.ProtoReflect.Descriptor instead.
-func (*MsgExec) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgExecResponse_PostActionResponses.ProtoReflect.Descriptor instead.
+func (*MsgExecResponse_PostActionResponses) Descriptor() ([]byte, []int) {
+	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *MsgExec) GetExecutor() string {
+func (x *MsgExecResponse_PostActionResponses) GetAgent() string {
 	if x != nil {
-		return x.Executor
+		return x.Agent
 	}
 	return ""
 }
 
-func (x *MsgExec) GetAgents() []string {
-	if x != nil {
-		return x.Agents
-	}
-	return nil
-}
-
-func (x *MsgExec) GetActions() []*anypb.Any {
+func (x *MsgExecResponse_PostActionResponses) GetResponses() []*anypb.Any {
 	if x != nil {
-		return x.Actions
+		return x.Responses
 	}
 	return nil
 }
 
-// MsgExecResponse is the Msg/Exec response type.
-type MsgExecResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *MsgExecResponse) Reset() {
-	*x = MsgExecResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *MsgExecResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgExecResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgExecResponse.ProtoReflect.Descriptor instead.
-func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP(), []int{7}
-}
-
 var File_andromeda_escrow_v1alpha1_tx_proto protoreflect.FileDescriptor
 
 var file_andromeda_escrow_v1alpha1_tx_proto_rawDesc = []byte{
@@ -4252,104 +9926,266 @@ var file_andromeda_escrow_v1alpha1_tx_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e,
 	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a,
 	0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89,
-	0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61,
-	0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x16, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x07, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x3d, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x66, 0x6c,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x46, 0x65, 0x65, 0x46, 0x6c, 0x61, 0x74,
+	0x12, 0x20, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x46, 0x65, 0x65, 0x42,
+	0x70, 0x73, 0x12, 0x7d, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x12, 0x6d,
+	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x62, 0x75, 0x72, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x32, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x12, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x67,
+	0x61, 0x73, 0x50, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x67, 0x61, 0x73, 0x50, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52,
+	0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x48, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12,
-	0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12,
-	0x35, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x22, 0xdb, 0x04, 0x0a,
+	0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x70, 0x6f, 0x73,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
-	0x6e, 0x79, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x0d, 0x82, 0xe7, 0xb0,
-	0x2a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73,
+	0x6e, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x47, 0x61, 0x73, 0x3a, 0x0d, 0x82, 0xe7, 0xb0,
+	0x2a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x19, 0x4d, 0x73,
 	0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x45,
-	0x78, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x12, 0x70, 0x72, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22,
+	0xc4, 0x02, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x08, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x0d, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x22, 0xc1, 0x02, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x45, 0x78,
+	0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x15, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x61, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a,
+	0x79, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
-	0x6e, 0x79, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0d, 0x82, 0xe7, 0xb0,
-	0x2a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73,
-	0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb7, 0x03,
-	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x4d,
+	0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x3a, 0x0d, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xd1, 0x02, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x35,
+	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0e, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x0d, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xa3, 0x05, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6e, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x6e,
+	0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x32, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d,
+	0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64,
 	0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x1a, 0x32, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73,
-	0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61,
-	0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x1a,
-	0x31, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72,
-	0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61,
-	0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x1a, 0x34, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65,
-	0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63,
-	0x12, 0x22, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63,
-	0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x78, 0x65, 0x63, 0x1a, 0x2a, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61,
-	0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xdc, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e,
-	0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d,
-	0x65, 0x64, 0x61, 0x2f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x3b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f,
-	0x6d, 0x65, 0x64, 0x61, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x56, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61,
-	0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0xe2, 0x02, 0x25, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c, 0x45, 0x73, 0x63,
-	0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x41, 0x6e, 0x64, 0x72, 0x6f,
-	0x6d, 0x65, 0x64, 0x61, 0x3a, 0x3a, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x3a, 0x3a, 0x56, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x61, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x34, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f,
+	0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x22, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65,
+	0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x2a, 0x2e, 0x61, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f,
+	0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x34, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65,
+	0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c,
+	0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x34, 0x2e, 0x61,
+	0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xdc, 0x01, 0x0a, 0x1d, 0x63, 0x6f,
+	0x6d, 0x2e, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x64, 0x72,
+	0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x41, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x19, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65,
+	0x64, 0x61, 0x5c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xe2, 0x02, 0x25, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x5c, 0x45,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x41, 0x6e, 0x64,
+	0x72, 0x6f, 0x6d, 0x65, 0x64, 0x61, 0x3a, 0x3a, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x3a, 0x3a,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4364,35 +10200,58 @@ func file_andromeda_escrow_v1alpha1_tx_proto_rawDescGZIP() []byte {
 	return file_andromeda_escrow_v1alpha1_tx_proto_rawDescData
 }
 
-var file_andromeda_escrow_v1alpha1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_andromeda_escrow_v1alpha1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_andromeda_escrow_v1alpha1_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),           // 0: andromeda.escrow.v1alpha1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),   // 1: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse
-	(*MsgCreateAgent)(nil),            // 2: andromeda.escrow.v1alpha1.MsgCreateAgent
-	(*MsgCreateAgentResponse)(nil),    // 3: andromeda.escrow.v1alpha1.MsgCreateAgentResponse
-	(*MsgSubmitProposal)(nil),         // 4: andromeda.escrow.v1alpha1.MsgSubmitProposal
-	(*MsgSubmitProposalResponse)(nil), // 5: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse
-	(*MsgExec)(nil),                   // 6: andromeda.escrow.v1alpha1.MsgExec
-	(*MsgExecResponse)(nil),           // 7: andromeda.escrow.v1alpha1.MsgExecResponse
-	(*anypb.Any)(nil),                 // 8: google.protobuf.Any
+	(*MsgUpdateParams)(nil),                     // 0: andromeda.escrow.v1alpha1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),             // 1: andromeda.escrow.v1alpha1.MsgUpdateParamsResponse
+	(*MsgCreateAgent)(nil),                      // 2: andromeda.escrow.v1alpha1.MsgCreateAgent
+	(*MsgCreateAgentResponse)(nil),              // 3: andromeda.escrow.v1alpha1.MsgCreateAgentResponse
+	(*MsgSubmitProposal)(nil),                   // 4: andromeda.escrow.v1alpha1.MsgSubmitProposal
+	(*MsgSubmitProposalResponse)(nil),           // 5: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse
+	(*MsgExec)(nil),                             // 6: andromeda.escrow.v1alpha1.MsgExec
+	(*MsgExecResponse)(nil),                     // 7: andromeda.escrow.v1alpha1.MsgExecResponse
+	(*MsgCancelProposal)(nil),                   // 8: andromeda.escrow.v1alpha1.MsgCancelProposal
+	(*MsgCancelProposalResponse)(nil),           // 9: andromeda.escrow.v1alpha1.MsgCancelProposalResponse
+	(*MsgUpdateProposal)(nil),                   // 10: andromeda.escrow.v1alpha1.MsgUpdateProposal
+	(*MsgUpdateProposalResponse)(nil),           // 11: andromeda.escrow.v1alpha1.MsgUpdateProposalResponse
+	(*MsgExecResponse_PostActionResponses)(nil), // 12: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses
+	(*v1beta1.Coin)(nil),                        // 13: cosmos.base.v1beta1.Coin
+	(*anypb.Any)(nil),                           // 14: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),               // 15: google.protobuf.Timestamp
 }
 var file_andromeda_escrow_v1alpha1_tx_proto_depIdxs = []int32{
-	8, // 0: andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions:type_name -> google.protobuf.Any
-	8, // 1: andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions:type_name -> google.protobuf.Any
-	8, // 2: andromeda.escrow.v1alpha1.MsgExec.actions:type_name -> google.protobuf.Any
-	0, // 3: andromeda.escrow.v1alpha1.Msg.UpdateParams:input_type -> andromeda.escrow.v1alpha1.MsgUpdateParams
-	2, // 4: andromeda.escrow.v1alpha1.Msg.CreateAgent:input_type -> andromeda.escrow.v1alpha1.MsgCreateAgent
-	4, // 5: andromeda.escrow.v1alpha1.Msg.SubmitProposal:input_type -> andromeda.escrow.v1alpha1.MsgSubmitProposal
-	6, // 6: andromeda.escrow.v1alpha1.Msg.Exec:input_type -> andromeda.escrow.v1alpha1.MsgExec
-	1, // 7: andromeda.escrow.v1alpha1.Msg.UpdateParams:output_type -> andromeda.escrow.v1alpha1.MsgUpdateParamsResponse
-	3, // 8: andromeda.escrow.v1alpha1.Msg.CreateAgent:output_type -> andromeda.escrow.v1alpha1.MsgCreateAgentResponse
-	5, // 9: andromeda.escrow.v1alpha1.Msg.SubmitProposal:output_type -> andromeda.escrow.v1alpha1.MsgSubmitProposalResponse
-	7, // 10: andromeda.escrow.v1alpha1.Msg.Exec:output_type -> andromeda.escrow.v1alpha1.MsgExecResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	13, // 0: andromeda.escrow.v1alpha1.MsgUpdateParams.exec_fee_flat:type_name -> cosmos.base.v1beta1.Coin
+	13, // 1: andromeda.escrow.v1alpha1.MsgUpdateParams.min_proposal_deposit:type_name -> cosmos.base.v1beta1.Coin
+	14, // 2: andromeda.escrow.v1alpha1.MsgSubmitProposal.pre_actions:type_name -> google.protobuf.Any
+	14, // 3: andromeda.escrow.v1alpha1.MsgSubmitProposal.post_actions:type_name -> google.protobuf.Any
+	14, // 4: andromeda.escrow.v1alpha1.MsgSubmitProposal.refund_actions:type_name -> google.protobuf.Any
+	15, // 5: andromeda.escrow.v1alpha1.MsgSubmitProposal.expire_time:type_name -> google.protobuf.Timestamp
+	14, // 6: andromeda.escrow.v1alpha1.MsgSubmitProposalResponse.pre_action_responses:type_name -> google.protobuf.Any
+	14, // 7: andromeda.escrow.v1alpha1.MsgExec.actions:type_name -> google.protobuf.Any
+	13, // 8: andromeda.escrow.v1alpha1.MsgExec.declared_value:type_name -> cosmos.base.v1beta1.Coin
+	14, // 9: andromeda.escrow.v1alpha1.MsgExecResponse.action_responses:type_name -> google.protobuf.Any
+	12, // 10: andromeda.escrow.v1alpha1.MsgExecResponse.post_action_responses:type_name -> andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses
+	14, // 11: andromeda.escrow.v1alpha1.MsgUpdateProposal.pre_actions:type_name -> google.protobuf.Any
+	14, // 12: andromeda.escrow.v1alpha1.MsgUpdateProposal.post_actions:type_name -> google.protobuf.Any
+	14, // 13: andromeda.escrow.v1alpha1.MsgUpdateProposal.refund_actions:type_name -> google.protobuf.Any
+	14, // 14: andromeda.escrow.v1alpha1.MsgExecResponse.PostActionResponses.responses:type_name -> google.protobuf.Any
+	0,  // 15: andromeda.escrow.v1alpha1.Msg.UpdateParams:input_type -> andromeda.escrow.v1alpha1.MsgUpdateParams
+	2,  // 16: andromeda.escrow.v1alpha1.Msg.CreateAgent:input_type -> andromeda.escrow.v1alpha1.MsgCreateAgent
+	4,  // 17: andromeda.escrow.v1alpha1.Msg.SubmitProposal:input_type -> andromeda.escrow.v1alpha1.MsgSubmitProposal
+	6,  // 18: andromeda.escrow.v1alpha1.Msg.Exec:input_type -> andromeda.escrow.v1alpha1.MsgExec
+	8,  // 19: andromeda.escrow.v1alpha1.Msg.CancelProposal:input_type -> andromeda.escrow.v1alpha1.MsgCancelProposal
+	10, // 20: andromeda.escrow.v1alpha1.Msg.UpdateProposal:input_type -> andromeda.escrow.v1alpha1.MsgUpdateProposal
+	1,  // 21: andromeda.escrow.v1alpha1.Msg.UpdateParams:output_type -> andromeda.escrow.v1alpha1.MsgUpdateParamsResponse
+	3,  // 22: andromeda.escrow.v1alpha1.Msg.CreateAgent:output_type -> andromeda.escrow.v1alpha1.MsgCreateAgentResponse
+	5,  // 23: andromeda.escrow.v1alpha1.Msg.SubmitProposal:output_type -> andromeda.escrow.v1alpha1.MsgSubmitProposalResponse
+	7,  // 24: andromeda.escrow.v1alpha1.Msg.Exec:output_type -> andromeda.escrow.v1alpha1.MsgExecResponse
+	9,  // 25: andromeda.escrow.v1alpha1.Msg.CancelProposal:output_type -> andromeda.escrow.v1alpha1.MsgCancelProposalResponse
+	11, // 26: andromeda.escrow.v1alpha1.Msg.UpdateProposal:output_type -> andromeda.escrow.v1alpha1.MsgUpdateProposalResponse
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_andromeda_escrow_v1alpha1_tx_proto_init() }
@@ -4497,6 +10356,66 @@ func file_andromeda_escrow_v1alpha1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_andromeda_escrow_v1alpha1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExecResponse_PostActionResponses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4504,7 +10423,7 @@ func file_andromeda_escrow_v1alpha1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_andromeda_escrow_v1alpha1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
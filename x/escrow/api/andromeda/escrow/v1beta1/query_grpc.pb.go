@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: andromeda/escrow/v1beta1/query.proto
+
+package escrowv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName                  = "/andromeda.escrow.v1beta1.Query/Params"
+	Query_Agent_FullMethodName                   = "/andromeda.escrow.v1beta1.Query/Agent"
+	Query_AgentsByCreator_FullMethodName         = "/andromeda.escrow.v1beta1.Query/AgentsByCreator"
+	Query_Agents_FullMethodName                  = "/andromeda.escrow.v1beta1.Query/Agents"
+	Query_Proposal_FullMethodName                = "/andromeda.escrow.v1beta1.Query/Proposal"
+	Query_ProposalsByProposer_FullMethodName     = "/andromeda.escrow.v1beta1.Query/ProposalsByProposer"
+	Query_ProposalsByMessageType_FullMethodName  = "/andromeda.escrow.v1beta1.Query/ProposalsByMessageType"
+	Query_ProposalsByOfferedAsset_FullMethodName = "/andromeda.escrow.v1beta1.Query/ProposalsByOfferedAsset"
+	Query_ProposalsByAskedAsset_FullMethodName   = "/andromeda.escrow.v1beta1.Query/ProposalsByAskedAsset"
+	Query_Proposals_FullMethodName               = "/andromeda.escrow.v1beta1.Query/Proposals"
+	Query_SimulateExec_FullMethodName            = "/andromeda.escrow.v1beta1.Query/SimulateExec"
+	Query_SimulateSubmit_FullMethodName          = "/andromeda.escrow.v1beta1.Query/SimulateSubmit"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Agent queries an agent.
+	Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error)
+	// AgentsByCreator queries all the agents by its creator.
+	AgentsByCreator(ctx context.Context, in *QueryAgentsByCreatorRequest, opts ...grpc.CallOption) (*QueryAgentsByCreatorResponse, error)
+	// Agents queries all the agents.
+	Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error)
+	// Proposal queries a proposal.
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// ProposalsByProposer queries all the proposals by its proposer.
+	ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error)
+	// ProposalsByMessageType queries all the proposals by the type of the
+	// messages in their actions.
+	ProposalsByMessageType(ctx context.Context, in *QueryProposalsByMessageTypeRequest, opts ...grpc.CallOption) (*QueryProposalsByMessageTypeResponse, error)
+	// ProposalsByOfferedAsset queries all the proposals offering an asset in
+	// their pre-actions.
+	ProposalsByOfferedAsset(ctx context.Context, in *QueryProposalsByOfferedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByOfferedAssetResponse, error)
+	// ProposalsByAskedAsset queries all the proposals asking an asset in their
+	// post-actions.
+	ProposalsByAskedAsset(ctx context.Context, in *QueryProposalsByAskedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByAskedAssetResponse, error)
+	// Proposals queries all the proposals.
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// SimulateExec simulates Msg/Exec without committing its result.
+	SimulateExec(ctx context.Context, in *QuerySimulateExecRequest, opts ...grpc.CallOption) (*QuerySimulateExecResponse, error)
+	// SimulateSubmit simulates Msg/SubmitProposal without committing its
+	// result.
+	SimulateSubmit(ctx context.Context, in *QuerySimulateSubmitRequest, opts ...grpc.CallOption) (*QuerySimulateSubmitResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error) {
+	out := new(QueryAgentResponse)
+	err := c.cc.Invoke(ctx, Query_Agent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AgentsByCreator(ctx context.Context, in *QueryAgentsByCreatorRequest, opts ...grpc.CallOption) (*QueryAgentsByCreatorResponse, error) {
+	out := new(QueryAgentsByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_AgentsByCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error) {
+	out := new(QueryAgentsResponse)
+	err := c.cc.Invoke(ctx, Query_Agents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, Query_Proposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error) {
+	out := new(QueryProposalsByProposerResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByProposer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByMessageType(ctx context.Context, in *QueryProposalsByMessageTypeRequest, opts ...grpc.CallOption) (*QueryProposalsByMessageTypeResponse, error) {
+	out := new(QueryProposalsByMessageTypeResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByMessageType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByOfferedAsset(ctx context.Context, in *QueryProposalsByOfferedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByOfferedAssetResponse, error) {
+	out := new(QueryProposalsByOfferedAssetResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByOfferedAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByAskedAsset(ctx context.Context, in *QueryProposalsByAskedAssetRequest, opts ...grpc.CallOption) (*QueryProposalsByAskedAssetResponse, error) {
+	out := new(QueryProposalsByAskedAssetResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByAskedAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_Proposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateExec(ctx context.Context, in *QuerySimulateExecRequest, opts ...grpc.CallOption) (*QuerySimulateExecResponse, error) {
+	out := new(QuerySimulateExecResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateExec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSubmit(ctx context.Context, in *QuerySimulateSubmitRequest, opts ...grpc.CallOption) (*QuerySimulateSubmitResponse, error) {
+	out := new(QuerySimulateSubmitResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateSubmit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params queries the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Agent queries an agent.
+	Agent(context.Context, *QueryAgentRequest) (*QueryAgentResponse, error)
+	// AgentsByCreator queries all the agents by its creator.
+	AgentsByCreator(context.Context, *QueryAgentsByCreatorRequest) (*QueryAgentsByCreatorResponse, error)
+	// Agents queries all the agents.
+	Agents(context.Context, *QueryAgentsRequest) (*QueryAgentsResponse, error)
+	// Proposal queries a proposal.
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// ProposalsByProposer queries all the proposals by its proposer.
+	ProposalsByProposer(context.Context, *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error)
+	// ProposalsByMessageType queries all the proposals by the type of the
+	// messages in their actions.
+	ProposalsByMessageType(context.Context, *QueryProposalsByMessageTypeRequest) (*QueryProposalsByMessageTypeResponse, error)
+	// ProposalsByOfferedAsset queries all the proposals offering an asset in
+	// their pre-actions.
+	ProposalsByOfferedAsset(context.Context, *QueryProposalsByOfferedAssetRequest) (*QueryProposalsByOfferedAssetResponse, error)
+	// ProposalsByAskedAsset queries all the proposals asking an asset in their
+	// post-actions.
+	ProposalsByAskedAsset(context.Context, *QueryProposalsByAskedAssetRequest) (*QueryProposalsByAskedAssetResponse, error)
+	// Proposals queries all the proposals.
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// SimulateExec simulates Msg/Exec without committing its result.
+	SimulateExec(context.Context, *QuerySimulateExecRequest) (*QuerySimulateExecResponse, error)
+	// SimulateSubmit simulates Msg/SubmitProposal without committing its
+	// result.
+	SimulateSubmit(context.Context, *QuerySimulateSubmitRequest) (*QuerySimulateSubmitResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Agent(context.Context, *QueryAgentRequest) (*QueryAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Agent not implemented")
+}
+func (UnimplementedQueryServer) AgentsByCreator(context.Context, *QueryAgentsByCreatorRequest) (*QueryAgentsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentsByCreator not implemented")
+}
+func (UnimplementedQueryServer) Agents(context.Context, *QueryAgentsRequest) (*QueryAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Agents not implemented")
+}
+func (UnimplementedQueryServer) Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByProposer(context.Context, *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByProposer not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByMessageType(context.Context, *QueryProposalsByMessageTypeRequest) (*QueryProposalsByMessageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByMessageType not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByOfferedAsset(context.Context, *QueryProposalsByOfferedAssetRequest) (*QueryProposalsByOfferedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByOfferedAsset not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByAskedAsset(context.Context, *QueryProposalsByAskedAssetRequest) (*QueryProposalsByAskedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByAskedAsset not implemented")
+}
+func (UnimplementedQueryServer) Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (UnimplementedQueryServer) SimulateExec(context.Context, *QuerySimulateExecRequest) (*QuerySimulateExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateExec not implemented")
+}
+func (UnimplementedQueryServer) SimulateSubmit(context.Context, *QuerySimulateSubmitRequest) (*QuerySimulateSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSubmit not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Agent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Agent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Agent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Agent(ctx, req.(*QueryAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AgentsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AgentsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AgentsByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgentsByCreator(ctx, req.(*QueryAgentsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Agents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Agents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Agents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Agents(ctx, req.(*QueryAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Proposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByProposerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByProposer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByProposer(ctx, req.(*QueryProposalsByProposerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByMessageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByMessageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByMessageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByMessageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByMessageType(ctx, req.(*QueryProposalsByMessageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByOfferedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByOfferedAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByOfferedAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByOfferedAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByOfferedAsset(ctx, req.(*QueryProposalsByOfferedAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByAskedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByAskedAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByAskedAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByAskedAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByAskedAsset(ctx, req.(*QueryProposalsByAskedAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Proposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateExec(ctx, req.(*QuerySimulateExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateSubmit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSubmit(ctx, req.(*QuerySimulateSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "andromeda.escrow.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Agent",
+			Handler:    _Query_Agent_Handler,
+		},
+		{
+			MethodName: "AgentsByCreator",
+			Handler:    _Query_AgentsByCreator_Handler,
+		},
+		{
+			MethodName: "Agents",
+			Handler:    _Query_Agents_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "ProposalsByProposer",
+			Handler:    _Query_ProposalsByProposer_Handler,
+		},
+		{
+			MethodName: "ProposalsByMessageType",
+			Handler:    _Query_ProposalsByMessageType_Handler,
+		},
+		{
+			MethodName: "ProposalsByOfferedAsset",
+			Handler:    _Query_ProposalsByOfferedAsset_Handler,
+		},
+		{
+			MethodName: "ProposalsByAskedAsset",
+			Handler:    _Query_ProposalsByAskedAsset_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "SimulateExec",
+			Handler:    _Query_SimulateExec_Handler,
+		},
+		{
+			MethodName: "SimulateSubmit",
+			Handler:    _Query_SimulateSubmit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "andromeda/escrow/v1beta1/query.proto",
+}
@@ -0,0 +1,32 @@
+package expected
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// EscrowHooks is the hooks of the lifecycle of the agents and the proposals.
+// An error returned by the Before hooks vetoes the operation, and an error
+// returned by the After hooks also fails the operation.
+type EscrowHooks interface {
+	// AfterAgentCreated is called after the creation of an agent.
+	AfterAgentCreated(ctx context.Context, creator, agent sdk.AccAddress) error
+
+	// BeforeProposalSubmitted is called before the execution of the
+	// pre_actions of a proposal.
+	BeforeProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error
+
+	// AfterProposalSubmitted is called after the submission of a proposal.
+	AfterProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error
+
+	// BeforeExec is called before the execution of proposals.
+	BeforeExec(ctx context.Context, executor sdk.AccAddress, agents []sdk.AccAddress) error
+
+	// AfterProposalExecuted is called after the execution of the post_actions
+	// of each proposal. The quantity is the units filled, where zero means the
+	// proposal is not fillable partially.
+	AfterProposalExecuted(ctx context.Context, executor, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal, quantity uint64) error
+}
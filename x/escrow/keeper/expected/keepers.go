@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 )
 
 type (
@@ -13,8 +14,24 @@ type (
 	MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
 	AuthKeeper interface {
+		GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
 		HasAccount(context.Context, sdk.AccAddress) bool
 		NewAccount(context.Context, sdk.AccountI) sdk.AccountI
 		SetAccount(context.Context, sdk.AccountI)
 	}
+
+	BankKeeper interface {
+		SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+		SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+		BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+	}
+
+	DistributionKeeper interface {
+		FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	}
+
+	GroupKeeper interface {
+		GroupInfo(context.Context, *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error)
+		GroupsByMember(context.Context, *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error)
+	}
 )
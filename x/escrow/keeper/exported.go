@@ -3,21 +3,36 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	escrowv1beta1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1beta1"
 	"github.com/0tech/andromeda/x/escrow/keeper/expected"
 	"github.com/0tech/andromeda/x/escrow/keeper/internal"
 )
 
 type (
-	MessageRouter = expected.MessageRouter
-	AuthKeeper    = expected.AuthKeeper
+	MessageRouter      = expected.MessageRouter
+	AuthKeeper         = expected.AuthKeeper
+	BankKeeper         = expected.BankKeeper
+	DistributionKeeper = expected.DistributionKeeper
+	GroupKeeper        = expected.GroupKeeper
+
+	EscrowHooks = expected.EscrowHooks
+
+	Config = internal.Config
 )
 
+// DefaultConfig returns the default configuration of the keeper.
+func DefaultConfig() Config {
+	return internal.DefaultConfig()
+}
+
 type Keeper struct {
 	impl internal.Keeper
 }
@@ -28,6 +43,10 @@ func NewKeeper(
 	authority sdk.AccAddress,
 	router expected.MessageRouter,
 	authKeeper expected.AuthKeeper,
+	bankKeeper expected.BankKeeper,
+	distrKeeper expected.DistributionKeeper,
+	groupKeeper expected.GroupKeeper,
+	config Config,
 ) (*Keeper, error) {
 	impl, err := internal.NewKeeper(
 		cdc,
@@ -35,6 +54,10 @@ func NewKeeper(
 		authority,
 		router,
 		authKeeper,
+		bankKeeper,
+		distrKeeper,
+		groupKeeper,
+		config,
 	)
 	if err != nil {
 		return nil, err
@@ -43,6 +66,12 @@ func NewKeeper(
 	return &Keeper{impl: *impl}, nil
 }
 
+// SetHooks sets the hooks on the keeper. It panics if the hooks have already
+// been set.
+func (k Keeper) SetHooks(hooks EscrowHooks) {
+	k.impl.SetHooks(hooks)
+}
+
 func NewMsgServer(keeper Keeper) escrowv1alpha1.MsgServer {
 	return internal.NewMsgServer(keeper.impl)
 }
@@ -51,6 +80,14 @@ func NewQueryServer(keeper Keeper) escrowv1alpha1.QueryServer {
 	return internal.NewQueryServer(keeper.impl)
 }
 
+func NewMsgServerV1Beta1(keeper Keeper) escrowv1beta1.MsgServer {
+	return internal.NewMsgServerV1Beta1(keeper.impl)
+}
+
+func NewQueryServerV1Beta1(keeper Keeper) escrowv1beta1.QueryServer {
+	return internal.NewQueryServerV1Beta1(keeper.impl)
+}
+
 func (k Keeper) DefaultGenesis() *escrowv1alpha1.GenesisState {
 	return k.impl.DefaultGenesis()
 }
@@ -59,6 +96,10 @@ func (k Keeper) ValidateGenesis(gs *escrowv1alpha1.GenesisState) error {
 	return k.impl.ValidateGenesis(gs)
 }
 
+func (k Keeper) ValidateGenesisAccounts(gs *escrowv1alpha1.GenesisState, accounts []sdk.AccountI) error {
+	return k.impl.ValidateGenesisAccounts(gs, accounts)
+}
+
 func (k Keeper) InitGenesis(ctx context.Context, gs *escrowv1alpha1.GenesisState) error {
 	return k.impl.InitGenesis(ctx, gs)
 }
@@ -66,3 +107,94 @@ func (k Keeper) InitGenesis(ctx context.Context, gs *escrowv1alpha1.GenesisState
 func (k Keeper) ExportGenesis(ctx context.Context) (*escrowv1alpha1.GenesisState, error) {
 	return k.impl.ExportGenesis(ctx)
 }
+
+// RegisterInvariants registers the invariants of the module.
+func (k Keeper) RegisterInvariants(ir sdk.InvariantRegistry) {
+	k.impl.RegisterInvariants(ir)
+}
+
+// Schema returns the schema of the collections in the store.
+func (k Keeper) Schema() collections.Schema {
+	return k.impl.Schema()
+}
+
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	return k.impl.PruneExpiredProposals(ctx)
+}
+
+// The following methods are for the other modules to use the escrow without
+// sending messages through the router. Each method runs the same validation
+// and emits the same events as the corresponding message, except the
+// signatures: it fails with ErrPermissionDenied unless the signer field of the
+// message refers to the given signer, and the caller is responsible for
+// authorizing the signer (e.g. by signing with its own module account only).
+// The signer may not be taken from untrusted input.
+
+// UpdateParams updates the params as Msg/UpdateParams, signed by the authority.
+func (k Keeper) UpdateParams(ctx context.Context, authority sdk.AccAddress, msg *escrowv1alpha1.MsgUpdateParams) (*escrowv1alpha1.MsgUpdateParamsResponse, error) {
+	if err := k.impl.ValidateSigner(authority, msg.Authority); err != nil {
+		return nil, errors.Wrap(err, "authority")
+	}
+
+	return k.impl.HandleUpdateParams(ctx, msg)
+}
+
+// CreateAgent creates an agent as Msg/CreateAgent, signed by the creator.
+func (k Keeper) CreateAgent(ctx context.Context, creator sdk.AccAddress, msg *escrowv1alpha1.MsgCreateAgent) (*escrowv1alpha1.MsgCreateAgentResponse, error) {
+	if err := k.impl.ValidateSigner(creator, msg.Creator); err != nil {
+		return nil, errors.Wrap(err, "creator")
+	}
+
+	return k.impl.HandleCreateAgent(ctx, msg)
+}
+
+// SubmitProposal submits a proposal as Msg/SubmitProposal, signed by the
+// proposer.
+func (k Keeper) SubmitProposal(ctx context.Context, proposer sdk.AccAddress, msg *escrowv1alpha1.MsgSubmitProposal) (*escrowv1alpha1.MsgSubmitProposalResponse, error) {
+	if err := k.impl.ValidateSigner(proposer, msg.Proposer); err != nil {
+		return nil, errors.Wrap(err, "proposer")
+	}
+
+	return k.impl.HandleSubmitProposal(ctx, msg)
+}
+
+// Exec executes proposals as Msg/Exec, signed by the executor.
+func (k Keeper) Exec(ctx context.Context, executor sdk.AccAddress, msg *escrowv1alpha1.MsgExec) (*escrowv1alpha1.MsgExecResponse, error) {
+	if err := k.impl.ValidateSigner(executor, msg.Executor); err != nil {
+		return nil, errors.Wrap(err, "executor")
+	}
+
+	return k.impl.HandleExec(ctx, msg)
+}
+
+// CancelProposal cancels a proposal as Msg/CancelProposal, signed by the
+// proposer.
+func (k Keeper) CancelProposal(ctx context.Context, proposer sdk.AccAddress, msg *escrowv1alpha1.MsgCancelProposal) (*escrowv1alpha1.MsgCancelProposalResponse, error) {
+	if err := k.impl.ValidateSigner(proposer, msg.Proposer); err != nil {
+		return nil, errors.Wrap(err, "proposer")
+	}
+
+	return k.impl.HandleCancelProposal(ctx, msg)
+}
+
+// UpdateProposal updates a proposal as Msg/UpdateProposal, signed by the
+// proposer.
+func (k Keeper) UpdateProposal(ctx context.Context, proposer sdk.AccAddress, msg *escrowv1alpha1.MsgUpdateProposal) (*escrowv1alpha1.MsgUpdateProposalResponse, error) {
+	if err := k.impl.ValidateSigner(proposer, msg.Proposer); err != nil {
+		return nil, errors.Wrap(err, "proposer")
+	}
+
+	return k.impl.HandleUpdateProposal(ctx, msg)
+}
+
+// GetAgent returns the agent of the address. It returns ErrAgentNotFound if
+// the agent does not exist.
+func (k Keeper) GetAgent(ctx context.Context, agent sdk.AccAddress) (*escrowv1alpha1.Agent, error) {
+	return k.impl.GetAgent(ctx, agent)
+}
+
+// GetProposal returns the proposal of the agent. It returns
+// ErrProposalNotFound if the proposal does not exist.
+func (k Keeper) GetProposal(ctx context.Context, agent sdk.AccAddress) (*escrowv1alpha1.Proposal, error) {
+	return k.impl.GetProposal(ctx, agent)
+}
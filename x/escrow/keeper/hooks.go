@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// EscrowHooksWrapper is a wrapper for modules to inject EscrowHooks using
+// depinject.
+type EscrowHooksWrapper struct{ EscrowHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EscrowHooksWrapper) IsOnePerModuleType() {}
+
+var _ EscrowHooks = MultiEscrowHooks{}
+
+// MultiEscrowHooks combines multiple hooks, all hook functions are run in
+// array sequence.
+type MultiEscrowHooks []EscrowHooks
+
+func NewMultiEscrowHooks(hooks ...EscrowHooks) MultiEscrowHooks {
+	return hooks
+}
+
+func (h MultiEscrowHooks) AfterAgentCreated(ctx context.Context, creator, agent sdk.AccAddress) error {
+	for _, hooks := range h {
+		if err := hooks.AfterAgentCreated(ctx, creator, agent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiEscrowHooks) BeforeProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error {
+	for _, hooks := range h {
+		if err := hooks.BeforeProposalSubmitted(ctx, agent, proposal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiEscrowHooks) AfterProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error {
+	for _, hooks := range h {
+		if err := hooks.AfterProposalSubmitted(ctx, agent, proposal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiEscrowHooks) BeforeExec(ctx context.Context, executor sdk.AccAddress, agents []sdk.AccAddress) error {
+	for _, hooks := range h {
+		if err := hooks.BeforeExec(ctx, executor, agents); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiEscrowHooks) AfterProposalExecuted(ctx context.Context, executor, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal, quantity uint64) error {
+	for _, hooks := range h {
+		if err := hooks.AfterProposalExecuted(ctx, executor, agent, proposal, quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 
@@ -20,10 +21,7 @@ func (k Keeper) CreateAgent(ctx context.Context, creator sdk.AccAddress) (sdk.Ac
 			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
 		}
 
-		derivationKey := make([]byte, 8)
-		binary.BigEndian.PutUint64(derivationKey, agentNum)
-
-		ac, err := authtypes.NewModuleCredential(escrowv1alpha1.ModuleName, []byte("agent"), derivationKey)
+		ac, err := agentCredential(agentNum)
 		if err != nil {
 			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
 		}
@@ -34,31 +32,108 @@ func (k Keeper) CreateAgent(ctx context.Context, creator sdk.AccAddress) (sdk.Ac
 			continue
 		}
 
-		addressStr, err := k.addressBytesToString(address)
-		if err != nil {
-			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
-		}
-		bac := &authtypes.BaseAccount{
-			Address: addressStr,
-		}
-
-		if err := bac.SetPubKey(ac); err != nil {
-			return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "failed to set a pubkey")
+		if err := k.setAgentAccount(ctx, address, ac); err != nil {
+			return nil, err
 		}
 
-		acc := k.authKeeper.NewAccount(ctx, bac)
-		k.authKeeper.SetAccount(ctx, acc)
-
 		if err := k.setAgent(ctx, address, &escrowv1alpha1.Agent{
 			Creator: creator,
 		}); err != nil {
 			return nil, err
 		}
 
+		if err := k.afterAgentCreated(ctx, creator, address); err != nil {
+			return nil, err
+		}
+
 		return address, nil
 	}
 }
 
+// agentCredential returns the credential of the agent derived from the
+// sequence.
+func agentCredential(agentNum uint64) (*authtypes.ModuleCredential, error) {
+	derivationKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(derivationKey, agentNum)
+
+	return authtypes.NewModuleCredential(escrowv1alpha1.ModuleName, []byte("agent"), derivationKey)
+}
+
+// agentNumber returns the sequence which the agent of the account is derived
+// from, checking the credential of the account against the address.
+func agentNumber(address sdk.AccAddress, acc sdk.AccountI) (uint64, error) {
+	if acc == nil {
+		return 0, escrowv1alpha1.ErrAccountNotFound
+	}
+
+	ac, ok := acc.GetPubKey().(*authtypes.ModuleCredential)
+	if !ok || ac.ModuleName != escrowv1alpha1.ModuleName || len(ac.DerivationKeys) != 2 || string(ac.DerivationKeys[0]) != "agent" || len(ac.DerivationKeys[1]) != 8 {
+		return 0, escrowv1alpha1.ErrInvalidAddress.Wrap("not an account of an agent")
+	}
+
+	agentNum := binary.BigEndian.Uint64(ac.DerivationKeys[1])
+	expected, err := agentCredential(agentNum)
+	if err != nil {
+		return 0, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+
+	if !bytes.Equal(expected.Address(), address) {
+		return 0, escrowv1alpha1.ErrInvalidAddress.Wrap("credential of another address")
+	}
+
+	return agentNum, nil
+}
+
+// setAgentAccount sets the account of the agent on x/auth.
+func (k Keeper) setAgentAccount(ctx context.Context, address sdk.AccAddress, ac *authtypes.ModuleCredential) error {
+	addressStr, err := k.addressBytesToString(address)
+	if err != nil {
+		return escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+	bac := &authtypes.BaseAccount{
+		Address: addressStr,
+	}
+
+	if err := bac.SetPubKey(ac); err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "failed to set a pubkey")
+	}
+
+	acc := k.authKeeper.NewAccount(ctx, bac)
+	k.authKeeper.SetAccount(ctx, acc)
+
+	return nil
+}
+
+// agentCredentials returns the credentials of the given agents, which are
+// derived from the sequences below nextAgent, keyed by their addresses. It
+// stops deriving on finding all of them, and the agents not derivable are left
+// out.
+func agentCredentials(nextAgent uint64, agents map[string]bool) (map[string]*authtypes.ModuleCredential, error) {
+	credentials := map[string]*authtypes.ModuleCredential{}
+	for agentNum := uint64(0); agentNum < nextAgent && len(credentials) < len(agents); agentNum++ {
+		ac, err := agentCredential(agentNum)
+		if err != nil {
+			return nil, err
+		}
+
+		if address := string(ac.Address()); agents[address] {
+			credentials[address] = ac
+		}
+	}
+
+	return credentials, nil
+}
+
+// validateAgentAccount checks that the account is of an agent.
+func validateAgentAccount(acc sdk.AccountI) error {
+	ac, ok := acc.GetPubKey().(*authtypes.ModuleCredential)
+	if !ok || ac.ModuleName != escrowv1alpha1.ModuleName {
+		return escrowv1alpha1.ErrInvalidAddress.Wrap("not an account of an agent")
+	}
+
+	return nil
+}
+
 func (k Keeper) GetAgent(ctx context.Context, address sdk.AccAddress) (*escrowv1alpha1.Agent, error) {
 	agent, err := k.agents.Get(ctx, address)
 	if err != nil {
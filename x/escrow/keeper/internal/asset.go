@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// the kinds of the assets in the asset indexes.
+const (
+	assetKindCoin = "coin"
+	assetKindNFT  = "nft"
+)
+
+// assetRefKeys returns the assets moved by the actions, as the pairs of the
+// kind and the name (i.e. the denom of coins or the class id of nfts),
+// without duplicates. The actions of the other messages are ignored.
+func assetRefKeys(cdc codec.Codec, actions []*codectypes.Any) ([]collections.Pair[string, string], error) {
+	var refKeys []collections.Pair[string, string]
+	seen := map[[2]string]bool{}
+	add := func(kind, name string) {
+		key := [2]string{kind, name}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		refKeys = append(refKeys, collections.Join(kind, name))
+	}
+
+	for _, action := range actions {
+		// unpack a copy not to cache the value into the stored proposal
+		action := *action
+
+		var msg sdk.Msg
+		if err := cdc.UnpackAny(&action, &msg); err != nil {
+			return nil, err
+		}
+
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			for _, coin := range msg.Amount {
+				add(assetKindCoin, coin.Denom)
+			}
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				for _, coin := range input.Coins {
+					add(assetKindCoin, coin.Denom)
+				}
+			}
+		case *nft.MsgSend:
+			add(assetKindNFT, msg.ClassId)
+		}
+	}
+
+	return refKeys, nil
+}
+
+// assetRefKey returns the key of the asset in the asset indexes, given
+// exactly one of the denom and the class id.
+func assetRefKey(denom, classID string) (collections.Pair[string, string], error) {
+	switch {
+	case denom != "" && classID != "":
+		return collections.Pair[string, string]{}, escrowv1alpha1.ErrInvalidMessage.Wrap("both of denom and class_id provided")
+	case denom != "":
+		return collections.Join(assetKindCoin, denom), nil
+	case classID != "":
+		return collections.Join(assetKindNFT, classID), nil
+	default:
+		return collections.Pair[string, string]{}, escrowv1alpha1.ErrUnimplemented.Wrap("nil denom and class_id")
+	}
+}
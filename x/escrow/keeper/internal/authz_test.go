@@ -0,0 +1,534 @@
+package internal_test
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+// authzMsgServer is a minimal implementation of Msg/Exec of x/authz, which is
+// used for testing the messages of this module wrapped in the other messages.
+// It supports the messages signed by the grantee only.
+type authzMsgServer struct {
+	authz.UnimplementedMsgServer
+
+	cdc    codec.Codec
+	router baseapp.MessageRouter
+}
+
+var _ authz.MsgServer = (*authzMsgServer)(nil)
+
+func newAuthzMsgServer(bapp *baseapp.BaseApp, cdc codec.Codec) *authzMsgServer {
+	server := &authzMsgServer{
+		cdc:    cdc,
+		router: bapp.MsgServiceRouter(),
+	}
+
+	authz.RegisterInterfaces(cdc.InterfaceRegistry())
+	authz.RegisterMsgServer(bapp.MsgServiceRouter(), server)
+
+	return server
+}
+
+func (s authzMsgServer) Exec(ctx context.Context, req *authz.MsgExec) (*authz.MsgExecResponse, error) {
+	grantee, err := s.cdc.InterfaceRegistry().SigningContext().AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	msgs, err := req.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		signers, _, err := s.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, signer := range signers {
+			if !sdk.AccAddress(grantee).Equals(sdk.AccAddress(signer)) {
+				return nil, sdkerrors.ErrUnauthorized.Wrap("authorization not supported")
+			}
+		}
+
+		handler := s.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		result, err := handler(sdk.UnwrapSDKContext(ctx), msg)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result.Data
+	}
+
+	return &authz.MsgExecResponse{Results: results}, nil
+}
+
+func (s *KeeperTestSuite) TestEscrowAuthorizationValidateBasic() {
+	tester := func(subject escrowv1alpha1.EscrowAuthorization) error {
+		return subject.ValidateBasic()
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.EscrowAuthorization]{
+		{
+			"nil msg_type_url": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"msg_type_url of submit proposal": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.MsgTypeUrl = sdk.MsgTypeURL(&escrowv1alpha1.MsgSubmitProposal{})
+				},
+			},
+			"msg_type_url of exec": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.MsgTypeUrl = sdk.MsgTypeURL(&escrowv1alpha1.MsgExec{})
+				},
+			},
+			"unsupported msg_type_url": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.MsgTypeUrl = sdk.MsgTypeURL(&escrowv1alpha1.MsgCancelProposal{})
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"no agents": {},
+			"valid agents": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.Agents = []string{
+						s.addressBytesToString(s.agentAny),
+						s.addressBytesToString(s.agentIdle),
+					}
+				},
+			},
+			"duplicate agents": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.Agents = []string{
+						s.addressBytesToString(s.agentAny),
+						s.addressBytesToString(s.agentAny),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrDuplicateEntry
+				},
+			},
+		},
+		{
+			"nil spend_limit": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid spend_limit": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+				},
+			},
+			"invalid spend_limit": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.SpendLimit = sdk.Coins{
+						sdk.Coin{
+							Denom:  "stake",
+							Amount: math.NewInt(-1),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"no charge_per_use": {},
+			"valid charge_per_use": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.ChargePerUse = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+				},
+			},
+			"invalid charge_per_use": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.ChargePerUse = sdk.Coins{
+						sdk.Coin{
+							Denom:  "stake",
+							Amount: math.NewInt(-1),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"no allowed_action_types": {},
+			"valid allowed_action_types": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.AllowedActionTypes = []string{
+						sdk.MsgTypeURL(&testv1alpha1.MsgSend{}),
+						sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					}
+				},
+			},
+			"duplicate allowed_action_types": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.AllowedActionTypes = []string{
+						sdk.MsgTypeURL(&testv1alpha1.MsgSend{}),
+						sdk.MsgTypeURL(&testv1alpha1.MsgSend{}),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrDuplicateEntry
+				},
+			},
+			"escrow message in allowed_action_types": {
+				Malleate: func(subject *escrowv1alpha1.EscrowAuthorization) {
+					subject.AllowedActionTypes = []string{
+						sdk.MsgTypeURL(&escrowv1alpha1.MsgExec{}),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrMessageNotAllowed
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestEscrowAuthorizationAcceptSubmitProposal() {
+	type acceptSubmitProposal struct {
+		agents      []string
+		msg         escrowv1alpha1.MsgSubmitProposal
+		spendLimit  sdk.Coins
+		preActions  []sdk.Msg
+		postActions []sdk.Msg
+		deposit     sdk.Coins
+	}
+
+	tester := func(subject acceptSubmitProposal) error {
+		authorization := escrowv1alpha1.EscrowAuthorization{
+			MsgTypeUrl: sdk.MsgTypeURL(&escrowv1alpha1.MsgSubmitProposal{}),
+			Agents:     subject.agents,
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			AllowedActionTypes: []string{
+				sdk.MsgTypeURL(&testv1alpha1.MsgSend{}),
+				sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			},
+			ChargePerUse: subject.deposit,
+		}
+		s.Require().NoError(authorization.ValidateBasic())
+
+		msg := subject.msg
+		msg.Proposer = s.addressBytesToString(s.seller)
+		msg.Agent = s.addressBytesToString(s.agentIdle)
+		msg.PreActions = s.encodeMsgs(subject.preActions)
+		msg.PostActions = s.encodeMsgs(subject.postActions)
+
+		res, err := authorization.Accept(s.ctx, &msg)
+		if err != nil {
+			return err
+		}
+		s.Require().True(res.Accept)
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[acceptSubmitProposal]{
+		{
+			"no restriction by agents": {},
+			"agent allowed": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.agents = []string{
+						s.addressBytesToString(s.agentIdle),
+					}
+				},
+			},
+			"agent allowed in upper case": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.agents = []string{
+						strings.ToUpper(s.addressBytesToString(s.agentIdle)),
+					}
+				},
+			},
+			"agent not allowed": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.agents = []string{
+						s.addressBytesToString(s.agentAny),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+			"invalid agent allowed": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.agents = []string{
+						"invalid",
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+		},
+		{
+			"action allowed": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.preActions = []sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.seller),
+							Recipient: s.addressBytesToString(s.agentIdle),
+							Asset:     "snake",
+						},
+					}
+				},
+			},
+			"action not allowed": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.preActions = []sdk.Msg{
+						&testv1alpha1.MsgCreate{
+							Creator: s.addressBytesToString(s.seller),
+							Asset:   "snake",
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrMessageNotAllowed
+				},
+			},
+			"escrow message in actions": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.preActions = []sdk.Msg{
+						&escrowv1alpha1.MsgCancelProposal{
+							Proposer: s.addressBytesToString(s.seller),
+							Agent:    s.addressBytesToString(s.agentAny),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrMessageNotAllowed
+				},
+			},
+		},
+		{
+			"value within spend_limit": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.postActions = []sdk.Msg{
+						&banktypes.MsgSend{
+							FromAddress: s.addressBytesToString(s.seller),
+							ToAddress:   s.addressBytesToString(s.buyer),
+							Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+						},
+					}
+				},
+			},
+			"value of post_actions times quantity over spend_limit": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.postActions = []sdk.Msg{
+						&banktypes.MsgSend{
+							FromAddress: s.addressBytesToString(s.seller),
+							ToAddress:   s.addressBytesToString(s.buyer),
+							Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+						},
+					}
+					subject.msg.Quantity = 2
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+			"value sent by granter in upper case over spend_limit": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.postActions = []sdk.Msg{
+						&banktypes.MsgSend{
+							FromAddress: strings.ToUpper(s.addressBytesToString(s.seller)),
+							ToAddress:   s.addressBytesToString(s.buyer),
+							Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 101)),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+			"value sent by invalid address": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.postActions = []sdk.Msg{
+						&banktypes.MsgSend{
+							FromAddress: "invalid",
+							ToAddress:   s.addressBytesToString(s.buyer),
+							Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+			"value sent by agent": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.postActions = []sdk.Msg{
+						&banktypes.MsgSend{
+							FromAddress: s.addressBytesToString(s.agentIdle),
+							ToAddress:   s.addressBytesToString(s.seller),
+							Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+						},
+					}
+				},
+			},
+			"value of denom not in spend_limit": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.postActions = []sdk.Msg{
+						&banktypes.MsgSend{
+							FromAddress: s.addressBytesToString(s.seller),
+							ToAddress:   s.addressBytesToString(s.buyer),
+							Amount:      sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"no charge_per_use": {},
+			"deposit within spend_limit": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.deposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+				},
+			},
+			"deposit over spend_limit": {
+				Malleate: func(subject *acceptSubmitProposal) {
+					subject.deposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 101))
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestEscrowAuthorizationAcceptExec() {
+	authorization := &escrowv1alpha1.EscrowAuthorization{
+		MsgTypeUrl: sdk.MsgTypeURL(&escrowv1alpha1.MsgExec{}),
+		Agents: []string{
+			s.addressBytesToString(s.agentAny),
+		},
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		AllowedActionTypes: []string{
+			sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		},
+		UsesLeft: 2,
+	}
+	s.Require().NoError(authorization.ValidateBasic())
+
+	msg := &escrowv1alpha1.MsgExec{
+		Executor: s.addressBytesToString(s.buyer),
+		Agents: []string{
+			s.addressBytesToString(s.agentAny),
+		},
+		Actions: s.encodeMsgs([]sdk.Msg{
+			&banktypes.MsgSend{
+				FromAddress: s.addressBytesToString(s.buyer),
+				ToAddress:   s.addressBytesToString(s.agentAny),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			},
+		}),
+	}
+
+	// the message of another type
+	_, err := authorization.Accept(s.ctx, &escrowv1alpha1.MsgSubmitProposal{})
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrInvalidMessage)
+
+	// the agent not allowed
+	_, err = authorization.Accept(s.ctx, &escrowv1alpha1.MsgExec{
+		Executor: msg.Executor,
+		Agents: []string{
+			s.addressBytesToString(s.agentDedicated),
+		},
+	})
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrPermissionDenied)
+
+	// the first use updates the limits
+	res, err := authorization.Accept(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().True(res.Accept)
+	s.Require().False(res.Delete)
+	s.Require().Equal(&escrowv1alpha1.EscrowAuthorization{
+		MsgTypeUrl:         authorization.MsgTypeUrl,
+		Agents:             authorization.Agents,
+		SpendLimit:         sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+		AllowedActionTypes: authorization.AllowedActionTypes,
+		UsesLeft:           1,
+	}, res.Updated)
+
+	// the last use deletes the authorization
+	res, err = res.Updated.Accept(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().True(res.Accept)
+	s.Require().True(res.Delete)
+
+	// the exhausted spend_limit deletes the authorization
+	authorization.UsesLeft = 0
+	authorization.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+	res, err = authorization.Accept(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().True(res.Accept)
+	s.Require().True(res.Delete)
+
+	// the executor in upper case spends the granter's coins as well
+	upperMsg := *msg
+	upperMsg.Executor = strings.ToUpper(msg.Executor)
+	authorization.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 39))
+	_, err = authorization.Accept(s.ctx, &upperMsg)
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrPermissionDenied)
+
+	// the fee in charge_per_use is counted against spend_limit
+	authorization.ChargePerUse = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	authorization.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+	_, err = authorization.Accept(s.ctx, msg)
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrPermissionDenied)
+
+	authorization.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 42))
+	res, err = authorization.Accept(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().True(res.Accept)
+	s.Require().False(res.Delete)
+	s.Require().Equal(authorization.ChargePerUse, res.Updated.(*escrowv1alpha1.EscrowAuthorization).ChargePerUse)
+
+	// the params of the keeper are not read
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	feeFlat := sdk.NewInt64Coin("stake", 100)
+	params.ExecFeeFlat = &feeFlat
+	ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+	err = s.keeper.UpdateParams(ctx, params)
+	s.Require().NoError(err)
+
+	_, err = authorization.Accept(ctx, msg)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,157 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/0tech/andromeda/x/escrow/keeper/expected"
+)
+
+// bankMsgServer is a minimal implementation of Msg/Send of x/bank, which is
+// used for testing the messages having fungible amounts. It also serves as
+// the bank keeper of the module.
+type bankMsgServer struct {
+	banktypes.UnimplementedMsgServer
+
+	addressCodec address.Codec
+
+	balances collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+}
+
+var (
+	_ banktypes.MsgServer = (*bankMsgServer)(nil)
+	_ expected.BankKeeper = (*bankMsgServer)(nil)
+)
+
+func newBankMsgServer(t *testing.T, bapp *baseapp.BaseApp, cdc codec.Codec, key *storetypes.KVStoreKey) *bankMsgServer {
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	server := &bankMsgServer{
+		addressCodec: cdc.InterfaceRegistry().SigningContext().AddressCodec(),
+		balances: collections.NewMap(sb, collections.NewPrefix([]byte{0xfe, 0x00}), "balances",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+			sdk.IntValue,
+		),
+	}
+
+	_, err := sb.Build()
+	assert.NoError(t, err)
+
+	banktypes.RegisterInterfaces(cdc.InterfaceRegistry())
+	banktypes.RegisterMsgServer(bapp.MsgServiceRouter(), server)
+
+	return server
+}
+
+func (s bankMsgServer) Send(ctx context.Context, req *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	from, err := s.addressCodec.StringToBytes(req.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	to, err := s.addressCodec.StringToBytes(req.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	if err := s.send(ctx, from, to, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+func (s bankMsgServer) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return s.send(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (s bankMsgServer) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return s.send(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (s bankMsgServer) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	return s.burn(ctx, authtypes.NewModuleAddress(moduleName), amounts)
+}
+
+func (s bankMsgServer) send(ctx context.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	if err := s.burn(ctx, from, coins); err != nil {
+		return err
+	}
+
+	return s.Mint(ctx, to, coins)
+}
+
+func (s bankMsgServer) burn(ctx context.Context, address sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		balance, err := s.Balance(ctx, address, coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		if balance.LT(coin.Amount) {
+			return sdkerrors.ErrInsufficientFunds.Wrapf("%s%s < %s", balance, coin.Denom, coin)
+		}
+
+		if err := s.balances.Set(ctx, collections.Join(address, coin.Denom), balance.Sub(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s bankMsgServer) Mint(ctx context.Context, address sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		balance, err := s.Balance(ctx, address, coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		if err := s.balances.Set(ctx, collections.Join(address, coin.Denom), balance.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s bankMsgServer) Balance(ctx context.Context, address sdk.AccAddress, denom string) (math.Int, error) {
+	balance, err := s.balances.Get(ctx, collections.Join(address, denom))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return math.Int{}, err
+		}
+
+		return math.ZeroInt(), nil
+	}
+
+	return balance, nil
+}
+
+// distrKeeper is a minimal implementation of the community pool of
+// x/distribution.
+type distrKeeper struct {
+	bank *bankMsgServer
+}
+
+var _ expected.DistributionKeeper = (*distrKeeper)(nil)
+
+func (k distrKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return k.bank.send(ctx, sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+func validateMinProposalDeposit(deposit sdk.Coins) error {
+	if err := deposit.Validate(); err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvalidMessage.Wrap(err.Error()), "min_proposal_deposit")
+	}
+
+	return nil
+}
+
+// collectDeposit moves the minimum deposit from the proposer into the module
+// account, returning the collected deposit.
+func (k Keeper) collectDeposit(ctx context.Context, proposer sdk.AccAddress) (sdk.Coins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := params.MinProposalDeposit
+	if deposit.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, proposer, escrowv1alpha1.ModuleName, deposit); err != nil {
+		return nil, errors.Wrap(err, "deposit")
+	}
+
+	return deposit, nil
+}
+
+// refundDeposit returns the deposit of the proposal back to the proposer.
+func (k Keeper) refundDeposit(ctx context.Context, proposal *escrowv1alpha1.Proposal) error {
+	if proposal.Deposit.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, escrowv1alpha1.ModuleName, proposal.Proposer, proposal.Deposit); err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "deposit")
+	}
+
+	return nil
+}
+
+// forfeitDeposit burns the deposit of the proposal, or sends it to the
+// community pool, according to the params. It returns whether the deposit
+// has been burned.
+func (k Keeper) forfeitDeposit(ctx context.Context, proposal *escrowv1alpha1.Proposal) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if proposal.Deposit.IsZero() {
+		return params.BurnExpiredDeposits, nil
+	}
+
+	if params.BurnExpiredDeposits {
+		if err := k.bankKeeper.BurnCoins(ctx, escrowv1alpha1.ModuleName, proposal.Deposit); err != nil {
+			return false, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "deposit")
+		}
+
+		return true, nil
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, proposal.Deposit, k.moduleAddress()); err != nil {
+		return false, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "deposit")
+	}
+
+	return false, nil
+}
@@ -0,0 +1,204 @@
+package internal_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestProposalDeposit() {
+	type proposalDeposit struct {
+		deposit  sdk.Coins
+		burn     bool
+		end      func(ctx sdk.Context) error
+		refunded bool
+	}
+
+	const balance = 100
+
+	blockHeight := sdk.UnwrapSDKContext(s.ctx).BlockHeight()
+
+	tester := func(subject proposalDeposit) error {
+		s.Require().NotNil(subject.end)
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.MinProposalDeposit = subject.deposit
+		params.BurnExpiredDeposits = subject.burn
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		err = s.bank.Mint(ctx, s.seller, sdk.NewCoins(sdk.NewInt64Coin("stake", balance)))
+		s.Require().NoError(err)
+
+		deposit, _, err := s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			"sell a snake for a voucher",
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+			uint64(blockHeight)+1,
+			nil,
+			0,
+			nil,
+			0,
+			0,
+		)
+		if err != nil {
+			return err
+		}
+		s.Require().Equal(subject.deposit, deposit)
+
+		proposal, err := s.keeper.GetProposal(ctx, s.agentIdle)
+		s.Require().NoError(err)
+		s.Require().Equal(subject.deposit, proposal.Deposit)
+
+		moduleAddress := authtypes.NewModuleAddress(escrowv1alpha1.ModuleName)
+		escrowed, err := s.bank.Balance(ctx, moduleAddress, "stake")
+		s.Require().NoError(err)
+		s.Require().Equal(subject.deposit.AmountOf("stake"), escrowed)
+
+		err = subject.end(ctx)
+		s.Require().NoError(err)
+
+		_, err = s.keeper.GetProposal(ctx, s.agentIdle)
+		s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+
+		escrowed, err = s.bank.Balance(ctx, moduleAddress, "stake")
+		s.Require().NoError(err)
+		s.Require().True(escrowed.IsZero())
+
+		remaining, err := s.bank.Balance(ctx, s.seller, "stake")
+		s.Require().NoError(err)
+		pool, err := s.bank.Balance(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName), "stake")
+		s.Require().NoError(err)
+
+		forfeited := subject.deposit.AmountOf("stake")
+		if subject.refunded {
+			forfeited = math.ZeroInt()
+		}
+		s.Require().Equal(math.NewInt(balance).Sub(forfeited), remaining)
+
+		if subject.burn {
+			s.Require().True(pool.IsZero())
+		} else {
+			s.Require().Equal(forfeited, pool)
+		}
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[proposalDeposit]{
+		{
+			"no deposit": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.deposit = nil
+				},
+			},
+			"deposit": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.deposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+				},
+			},
+			"deposit over balance": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.deposit = sdk.NewCoins(sdk.NewInt64Coin("stake", balance+1))
+				},
+				Error: func() error {
+					return sdkerrors.ErrInsufficientFunds
+				},
+			},
+		},
+		{
+			"cancel": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.end = func(ctx sdk.Context) error {
+						_, _, err := s.keeper.CancelProposal(ctx, s.seller, s.agentIdle)
+						return err
+					}
+					subject.refunded = true
+				},
+			},
+			"update and cancel": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.end = func(ctx sdk.Context) error {
+						if _, _, err := s.keeper.UpdateProposal(ctx, s.seller, s.agentIdle, nil, nil, "sell a snake for a voucher, updated", nil); err != nil {
+							return err
+						}
+
+						_, _, err := s.keeper.CancelProposal(ctx, s.seller, s.agentIdle)
+						return err
+					}
+					subject.refunded = true
+				},
+			},
+			"exec": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.end = func(ctx sdk.Context) error {
+						_, _, _, _, err := s.keeper.Exec(ctx, s.stranger, []sdk.AccAddress{s.agentIdle},
+							s.encodeMsgs([]sdk.Msg{
+								&testv1alpha1.MsgSend{
+									Sender:    s.addressBytesToString(s.stranger),
+									Recipient: s.addressBytesToString(s.agentIdle),
+									Asset:     "voucher",
+								},
+								&testv1alpha1.MsgSend{
+									Sender:    s.addressBytesToString(s.agentIdle),
+									Recipient: s.addressBytesToString(s.stranger),
+									Asset:     "snake",
+								},
+							}),
+							nil,
+							nil,
+						)
+						return err
+					}
+					subject.refunded = true
+				},
+			},
+			"expire to community pool": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.end = func(ctx sdk.Context) error {
+						return s.keeper.PruneExpiredProposals(ctx.WithBlockHeight(blockHeight + 1))
+					}
+				},
+			},
+			"expire with burn": {
+				Malleate: func(subject *proposalDeposit) {
+					subject.burn = true
+					subject.end = func(ctx sdk.Context) error {
+						return s.keeper.PruneExpiredProposals(ctx.WithBlockHeight(blockHeight + 1))
+					}
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 
@@ -11,65 +12,178 @@ import (
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
 )
 
-func (k Keeper) Exec(ctx context.Context, agents []sdk.AccAddress, actions []*codectypes.Any) error {
-	var postActions []*codectypes.Any
-	for _, agent := range agents {
+func (k Keeper) Exec(ctx context.Context, executor sdk.AccAddress, agents []sdk.AccAddress, actions []*codectypes.Any, quantities []uint64, declaredValue sdk.Coins) (filled []uint64, fee sdk.Coins, actionResponses []*codectypes.Any, postActionResponses [][]*codectypes.Any, err error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := k.beforeExec(ctx, executor, agents); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	filled = make([]uint64, len(agents))
+	proposals := make([]*escrowv1alpha1.Proposal, len(agents))
+	postActions := make([][]*codectypes.Any, len(agents))
+	maxExecGas := make([]uint64, len(agents))
+	var remainders []*codectypes.Any
+	for i, agent := range agents {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(params.GasPerAgent, "escrow agent")
+
 		proposal, err := k.GetProposal(ctx, agent)
 		if err != nil {
-			return err
+			return nil, nil, nil, nil, err
+		}
+
+		if k.isExpired(ctx, proposal) {
+			return nil, nil, nil, nil, escrowv1alpha1.ErrProposalExpired
+		}
+
+		if err := k.validateExecutor(ctx, proposal, executor); err != nil {
+			return nil, nil, nil, nil, indexedError(err, i)
 		}
 
-		postActions = append(postActions, proposal.PostActions...)
+		var quantity uint64
+		if len(quantities) != 0 {
+			quantity = quantities[i]
+		}
 
-		if err := k.removeProposal(ctx, agent); err != nil {
-			return err
+		proposalFilled, proposalPostActions, remainder, err := k.fillProposal(ctx, agent, proposal, quantity)
+		if err != nil {
+			return nil, nil, nil, nil, err
 		}
+		filled[i] = proposalFilled
+
+		proposals[i] = proposal
+		postActions[i] = proposalPostActions
+		maxExecGas[i] = proposal.MaxExecGas
+		remainders = append(remainders, remainder...)
 	}
 
-	for _, phase := range []struct {
-		name    string
-		actions []*codectypes.Any
-	}{
-		{
-			name:    "actions",
-			actions: actions,
-		},
-		{
-			name:    "post_actions",
-			actions: postActions,
-		},
-	} {
-		if err := k.executeActions(ctx, phase.actions); err != nil {
-			return errors.Wrap(err, phase.name)
+	fee, err = k.chargeExecFee(ctx, executor, declaredValue)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "fee")
+	}
+
+	actionResponses, err = k.executeActions(ctx, nil, "actions", actions)
+	if err != nil {
+		return nil, nil, nil, nil, newPhaseError(err, "actions")
+	}
+
+	// the post_actions of each proposal are subject to its own gas limit
+	postActionResponses = make([][]*codectypes.Any, len(agents))
+	for i, agent := range agents {
+		postActionResponses[i], err = k.executeActionsWithGasLimit(ctx, agent, "post_actions", postActions[i], maxExecGas[i])
+		if err != nil {
+			return nil, nil, nil, nil, newPhaseError(newAgentError(err, i), "post_actions")
 		}
+
+		if err := k.emitExecProposal(ctx, executor, proposals[i].Proposer, agent, postActions[i], filled[i]); err != nil {
+			return nil, nil, nil, nil, err
+		}
+
+		if err := k.afterProposalExecuted(ctx, executor, agent, proposals[i], filled[i]); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
+	// the agents of the partially filled proposals must be able to refund the
+	// remaining units, which prevents the executor from taking more than filled.
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if _, err := k.executeActions(cacheCtx, nil, "remainders", remainders); err != nil {
+		return nil, nil, nil, nil, newPhaseError(err, "remainders")
+	}
+
+	return filled, fee, actionResponses, postActionResponses, nil
+}
+
+// emitExecProposal emits the event of the proposal executed.
+func (k Keeper) emitExecProposal(ctx context.Context, executor, proposer, agent sdk.AccAddress, postActions []*codectypes.Any, quantity uint64) error {
+	executorStr, err := k.addressBytesToString(executor)
+	if err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "executor")
+	}
+
+	proposerStr, err := k.addressBytesToString(proposer)
+	if err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "proposer")
+	}
+
+	agentStr, err := k.addressBytesToString(agent)
+	if err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "agent")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&escrowv1alpha1.EventExecProposal{
+		Executor:    executorStr,
+		Proposer:    proposerStr,
+		Agent:       agentStr,
+		PostActions: postActions,
+		Quantity:    quantity,
+	}); err != nil {
+		return escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
 	}
 
 	return nil
 }
 
-func (k Keeper) executeActions(ctx context.Context, actions []*codectypes.Any) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+// executeActions executes the actions in order, charging the gas per action,
+// and returns the responses of the actions. The escrow messages in the actions
+// are subject to the maximum nesting depth in the params.
+// The events emitted by each action are tagged with the agent in charge (if
+// any), the phase and the index of the action. The tags of the nested escrow
+// messages precede those of the outer ones.
+func (k Keeper) executeActions(ctx context.Context, agent sdk.AccAddress, phase string, actions []*codectypes.Any) ([]*codectypes.Any, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []sdk.Attribute
+	if agent != nil {
+		agentStr, err := k.addressBytesToString(agent)
+		if err != nil {
+			return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "agent")
+		}
+
+		tags = append(tags, sdk.NewAttribute(escrowv1alpha1.AttributeKeyAgent, agentStr))
+	}
+	tags = append(tags, sdk.NewAttribute(escrowv1alpha1.AttributeKeyPhase, phase))
+
+	depth := nestingDepth(ctx) + 1
+	sdkCtx := withNestingDepth(sdk.UnwrapSDKContext(ctx), depth)
 
+	var responses []*codectypes.Any
 	for i, action := range actions {
 		msg, err := k.anyToMsg(*action)
 		if err != nil {
-			return indexedError(err, i)
+			return nil, newActionError(err, i)
 		}
 
+		if isEscrowMessage(msg) && depth > params.MaxNestingDepth {
+			return nil, newActionError(errors.Wrapf(escrowv1alpha1.ErrNestingTooDeep.Wrapf("over limit of %d", params.MaxNestingDepth), "%d", depth), i)
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(params.GasPerAction, "escrow action")
+
 		handler := k.router.Handler(msg)
 		if handler == nil {
-			return indexedError(escrowv1alpha1.ErrInvalidMessage.Wrap("handler not found"), i)
+			return nil, newActionError(escrowv1alpha1.ErrInvalidMessage.Wrap("handler not found"), i)
 		}
 
 		result, err := handler(sdkCtx, msg)
 		if err != nil {
-			return indexedError(err, i)
+			return nil, newActionError(err, i)
 		}
 
-		sdkCtx.EventManager().EmitEvents(result.GetEvents())
+		indexTag := sdk.NewAttribute(escrowv1alpha1.AttributeKeyActionIndex, strconv.Itoa(i))
+		for _, event := range result.GetEvents() {
+			sdkCtx.EventManager().EmitEvent(sdk.Event(event).AppendAttributes(append(tags, indexTag)...))
+		}
+		responses = append(responses, result.MsgResponses...)
 	}
 
-	return nil
+	return responses, nil
 }
 
 func (k Keeper) anyToMsg(any codectypes.Any) (sdk.Msg, error) {
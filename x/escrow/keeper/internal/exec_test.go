@@ -1,8 +1,11 @@
 package internal_test
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
 	"github.com/0tech/andromeda/x/escrow/testutil"
@@ -11,8 +14,9 @@ import (
 
 func (s *KeeperTestSuite) TestExec() {
 	type exec struct {
-		agents  []sdk.AccAddress
-		actions []*codectypes.Any
+		agents    []sdk.AccAddress
+		actions   []*codectypes.Any
+		blockTime time.Time
 	}
 
 	tester := func(subject exec) error {
@@ -20,10 +24,29 @@ func (s *KeeperTestSuite) TestExec() {
 		s.NotNil(subject.actions)
 
 		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
-		err := s.keeper.Exec(ctx, subject.agents, subject.actions)
+		ctx = ctx.WithBlockTime(subject.blockTime)
+		filled, _, _, _, err := s.keeper.Exec(ctx, s.stranger, subject.agents, subject.actions, nil, nil)
 		if err != nil {
 			return err
 		}
+		s.Require().Len(filled, len(subject.agents))
+
+		// every event emitted by the actions must be tagged
+		var numExecProposals int
+		for _, event := range ctx.EventManager().Events() {
+			switch event.Type {
+			case proto.MessageName(&escrowv1alpha1.EventExecProposal{}):
+				numExecProposals++
+			case proto.MessageName(&testv1alpha1.EventSend{}):
+				tags := map[string]bool{}
+				for _, attribute := range event.Attributes {
+					tags[attribute.Key] = true
+				}
+				s.Require().True(tags[escrowv1alpha1.AttributeKeyPhase])
+				s.Require().True(tags[escrowv1alpha1.AttributeKeyActionIndex])
+			}
+		}
+		s.Require().Equal(len(subject.agents), numExecProposals)
 
 		for i, agent := range subject.agents {
 			_, err = s.keeper.GetProposal(s.ctx, agent)
@@ -88,6 +111,21 @@ func (s *KeeperTestSuite) TestExec() {
 				},
 			},
 		},
+		{
+			"before expiry": {
+				Malleate: func(subject *exec) {
+					subject.blockTime = sdk.UnwrapSDKContext(s.ctx).BlockTime()
+				},
+			},
+			"after expiry": {
+				Malleate: func(subject *exec) {
+					subject.blockTime = sdk.UnwrapSDKContext(s.ctx).BlockTime().Add(2 * time.Hour)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalExpired
+				},
+			},
+		},
 	}
 
 	testutil.DoTest(s.T(), tester, cases)
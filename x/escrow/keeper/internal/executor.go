@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+func (k Keeper) validateExecutorPolicy(ctx context.Context, executors []sdk.AccAddress, executorGroupID uint64) error {
+	if len(executors) != 0 && executorGroupID != 0 {
+		return escrowv1alpha1.ErrInvalidMessage.Wrap("both of executors and executor_group_id provided")
+	}
+
+	if executorGroupID != 0 {
+		if _, err := k.groupKeeper.GroupInfo(ctx, &group.QueryGroupInfoRequest{
+			GroupId: executorGroupID,
+		}); err != nil {
+			return errors.Wrap(escrowv1alpha1.ErrInvalidMessage.Wrap(err.Error()), "executor_group_id")
+		}
+	}
+
+	return nil
+}
+
+// validateExecutor checks whether the executor is eligible to execute the
+// proposal. A proposal without any executor policy allows anyone.
+func (k Keeper) validateExecutor(ctx context.Context, proposal *escrowv1alpha1.Proposal, executor sdk.AccAddress) error {
+	if len(proposal.Executors) == 0 && proposal.ExecutorGroupId == 0 {
+		return nil
+	}
+
+	for _, candidate := range proposal.Executors {
+		if executor.Equals(sdk.AccAddress(candidate)) {
+			return nil
+		}
+	}
+
+	if proposal.ExecutorGroupId != 0 {
+		member, err := k.isGroupMember(ctx, proposal.ExecutorGroupId, executor)
+		if err != nil {
+			return err
+		}
+
+		if member {
+			return nil
+		}
+	}
+
+	return escrowv1alpha1.ErrPermissionDenied.Wrap("not eligible executor")
+}
+
+// isGroupMember checks whether the address is a member of the group, by the
+// groups of the address. So its cost depends on the memberships of the address,
+// not on the size of the group.
+func (k Keeper) isGroupMember(ctx context.Context, groupID uint64, address sdk.AccAddress) (bool, error) {
+	addressStr, err := k.addressBytesToString(address)
+	if err != nil {
+		return false, err
+	}
+
+	var pageKey []byte
+	for {
+		res, err := k.groupKeeper.GroupsByMember(ctx, &group.QueryGroupsByMemberRequest{
+			Address: addressStr,
+			Pagination: &query.PageRequest{
+				Key: pageKey,
+			},
+		})
+		if err != nil {
+			return false, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "executor_group_id")
+		}
+
+		for _, info := range res.Groups {
+			if info != nil && info.Id == groupID {
+				return true, nil
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return false, nil
+		}
+		pageKey = res.Pagination.NextKey
+	}
+}
+
+func (k Keeper) executorsStringToBytes(executors []string) ([]sdk.AccAddress, error) {
+	if len(executors) == 0 {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	res := make([]sdk.AccAddress, len(executors))
+	for i, executor := range executors {
+		var err error
+		res[i], err = k.addressStringToBytes(executor)
+		if err != nil {
+			return nil, indexedError(err, i)
+		}
+
+		if seen[string(res[i])] {
+			return nil, indexedError(escrowv1alpha1.ErrDuplicateEntry, i)
+		}
+		seen[string(res[i])] = true
+	}
+
+	return res, nil
+}
+
+func (k Keeper) executorsBytesToString(executors [][]byte) ([]string, error) {
+	if len(executors) == 0 {
+		return nil, nil
+	}
+
+	res := make([]string, len(executors))
+	for i, executor := range executors {
+		var err error
+		res[i], err = k.addressBytesToString(executor)
+		if err != nil {
+			return nil, indexedError(err, i)
+		}
+	}
+
+	return res, nil
+}
+
+func executorsToBytes(executors []sdk.AccAddress) [][]byte {
+	if len(executors) == 0 {
+		return nil
+	}
+
+	res := make([][]byte, len(executors))
+	for i, executor := range executors {
+		res[i] = executor
+	}
+
+	return res
+}
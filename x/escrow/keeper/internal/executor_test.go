@@ -0,0 +1,183 @@
+package internal_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestExecWithExecutorPolicy() {
+	type execWithExecutorPolicy struct {
+		executors       []sdk.AccAddress
+		executorGroupID uint64
+	}
+
+	executor := s.buyer
+
+	tester := func(subject execWithExecutorPolicy) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		_, _, err := s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			"sell a snake for a voucher",
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+			0,
+			nil,
+			0,
+			subject.executors,
+			subject.executorGroupID,
+			0,
+		)
+		s.Require().NoError(err)
+
+		_, _, _, _, err = s.keeper.Exec(ctx, executor, []sdk.AccAddress{s.agentIdle},
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(executor),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(executor),
+					Asset:     "snake",
+				},
+			}),
+			nil,
+			nil,
+		)
+
+		return err
+	}
+
+	cases := []map[string]testutil.Case[execWithExecutorPolicy]{
+		{
+			"no executor policy": {},
+			"executor listed": {
+				Malleate: func(subject *execWithExecutorPolicy) {
+					subject.executors = []sdk.AccAddress{s.stranger, executor}
+				},
+			},
+			"executor not listed": {
+				Malleate: func(subject *execWithExecutorPolicy) {
+					subject.executors = []sdk.AccAddress{s.stranger}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+			"executor in group": {
+				Malleate: func(subject *execWithExecutorPolicy) {
+					subject.executorGroupID = s.executorGroup
+				},
+			},
+			"executor not in group": {
+				Malleate: func(subject *execWithExecutorPolicy) {
+					groupID, err := s.group.CreateGroup(s.ctx, []sdk.AccAddress{s.seller, s.stranger})
+					s.Require().NoError(err)
+
+					subject.executorGroupID = groupID
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestExecutorGroupGas() {
+	executor := s.buyer
+
+	// the gas of Msg/Exec does not depend on the size of the executor group
+	execGas := func(size int) uint64 {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		members := []sdk.AccAddress{executor}
+		for len(members) < size {
+			members = append(members, createRandomAddress())
+		}
+		groupID, err := s.group.CreateGroup(ctx, members)
+		s.Require().NoError(err)
+
+		_, _, err = s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			"sell a snake for a voucher",
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+			0,
+			nil,
+			0,
+			nil,
+			groupID,
+			0,
+		)
+		s.Require().NoError(err)
+
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, _, _, _, err = s.keeper.Exec(ctx, executor, []sdk.AccAddress{s.agentIdle},
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(executor),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(executor),
+					Asset:     "snake",
+				},
+			}),
+			nil,
+			nil,
+		)
+		s.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	s.Require().Equal(execGas(1), execGas(100))
+}
@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// PruneExpiredProposals removes the proposals expired at the current block,
+// executing their refund_actions. At most max_prunes_per_block proposals are
+// pruned, leaving the rest to the following blocks. The deposits of the
+// proposals are forfeited.
+// A proposal whose refund_actions fail is kept with refund_failed set instead,
+// which is left out of the following prunings, so that its proposer may
+// replace the refund_actions and cancel it. The failure is reported by the
+// event.
+// A proposal failing on its pruning otherwise is logged and kept, which would
+// be pruned again in the following blocks.
+func (k Keeper) PruneExpiredProposals(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	agents, err := k.expiredAgents(ctx, params.MaxPrunesPerBlock)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, agent := range agents {
+		// the writes of a failed pruning are discarded
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if err := k.pruneExpiredProposal(cacheCtx, agent); err != nil {
+			agentStr, _ := k.addressBytesToString(agent)
+			sdkCtx.Logger().Error("failed to prune expired proposal", "module", "x/"+escrowv1alpha1.ModuleName, "agent", agentStr, "error", err)
+			continue
+		}
+		writeCache()
+	}
+
+	return nil
+}
+
+// expiredAgents returns the agents of the proposals expired at the current
+// block, up to the given limit.
+func (k Keeper) expiredAgents(ctx context.Context, limit uint64) ([]sdk.AccAddress, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// a proposal may have expired on both of the height and the time
+	var agents []sdk.AccAddress
+	seen := map[string]bool{}
+	collect := func(agent sdk.AccAddress) bool {
+		if !seen[string(agent)] {
+			seen[string(agent)] = true
+			agents = append(agents, agent)
+		}
+
+		return uint64(len(agents)) >= limit
+	}
+
+	heightIter, err := k.proposals.Indexes.expireHeight.Iterate(ctx,
+		pairRangeUntil[uint64, sdk.AccAddress]{end: uint64(sdkCtx.BlockHeight())})
+	if err != nil {
+		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+	defer heightIter.Close()
+
+	for ; heightIter.Valid(); heightIter.Next() {
+		agent, err := heightIter.PrimaryKey()
+		if err != nil {
+			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+		}
+
+		if collect(agent) {
+			return agents, nil
+		}
+	}
+
+	timeIter, err := k.proposals.Indexes.expireTime.Iterate(ctx,
+		pairRangeUntil[time.Time, sdk.AccAddress]{end: sdkCtx.BlockTime()})
+	if err != nil {
+		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+	defer timeIter.Close()
+
+	for ; timeIter.Valid(); timeIter.Next() {
+		agent, err := timeIter.PrimaryKey()
+		if err != nil {
+			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+		}
+
+		if collect(agent) {
+			return agents, nil
+		}
+	}
+
+	return agents, nil
+}
+
+// pairRangeUntil ranges over the pairs whose first key is less than or equal to
+// the given key.
+type pairRangeUntil[K1, K2 any] struct {
+	end K1
+}
+
+var _ collections.Ranger[collections.Pair[uint64, sdk.AccAddress]] = pairRangeUntil[uint64, sdk.AccAddress]{}
+
+func (r pairRangeUntil[K1, K2]) RangeValues() (start, end *collections.RangeKey[collections.Pair[K1, K2]], order collections.Order, err error) {
+	return nil, collections.RangeKeyPrefixEnd(collections.PairPrefix[K1, K2](r.end)), collections.OrderAscending, nil
+}
+
+func (k Keeper) pruneExpiredProposal(ctx context.Context, agent sdk.AccAddress) error {
+	proposal, err := k.GetProposal(ctx, agent)
+	if err != nil {
+		return err
+	}
+
+	refundActions, err := k.refundActions(proposal)
+	if err != nil {
+		return errors.Wrap(err, "refund_actions")
+	}
+
+	// the refund_actions are executed in a separated context, so that their
+	// failure would keep the proposal instead of stranding the assets of the
+	// agent.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	var refundErr string
+	if err := validateRefundable(proposal); err != nil {
+		refundErr = newPhaseError(err, "refund_actions").Error()
+	} else if _, err := k.executeActions(cacheCtx, agent, "refund_actions", refundActions); err != nil {
+		refundErr = newPhaseError(err, "refund_actions").Error()
+	} else {
+		writeCache()
+	}
+
+	burned, err := k.forfeitDeposit(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	if refundErr == "" {
+		if err := k.removeProposal(ctx, agent); err != nil {
+			return err
+		}
+	} else {
+		kept := *proposal
+		kept.Deposit = nil
+		kept.RefundFailed = true
+		if err := k.setProposal(ctx, agent, &kept); err != nil {
+			return err
+		}
+	}
+
+	proposerStr, err := k.addressBytesToString(proposal.Proposer)
+	if err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "proposer")
+	}
+
+	agentStr, err := k.addressBytesToString(agent)
+	if err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "agent")
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&escrowv1alpha1.EventExpireProposal{
+		Proposer:      proposerStr,
+		Agent:         agentStr,
+		RefundActions: refundActions,
+		Error:         refundErr,
+		Deposit:       proposal.Deposit,
+		DepositBurned: burned,
+	}); err != nil {
+		return escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func (k Keeper) validateExpiry(ctx context.Context, expireHeight uint64, expireTime *time.Time) error {
+	if k.expired(ctx, expireHeight, expireTime) {
+		return escrowv1alpha1.ErrProposalExpired.Wrap("already expired")
+	}
+
+	return nil
+}
+
+func (k Keeper) isExpired(ctx context.Context, proposal *escrowv1alpha1.Proposal) bool {
+	return k.expired(ctx, proposal.ExpireHeight, proposal.ExpireTime)
+}
+
+func (k Keeper) expired(ctx context.Context, expireHeight uint64, expireTime *time.Time) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if expireHeight != 0 && expireHeight <= uint64(sdkCtx.BlockHeight()) {
+		return true
+	}
+
+	if expireTime != nil && !expireTime.After(sdkCtx.BlockTime()) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,352 @@
+package internal_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestPruneExpiredProposals() {
+	type pruneExpiredProposals struct {
+		blockHeight int64
+		blockTime   time.Time
+		refundAsset string
+	}
+
+	blockHeight := sdk.UnwrapSDKContext(s.ctx).BlockHeight()
+	blockTime := sdk.UnwrapSDKContext(s.ctx).BlockTime()
+
+	tester := func(subject pruneExpiredProposals) error {
+		s.NotZero(subject.blockHeight)
+		s.NotZero(subject.blockTime)
+
+		var refundActions []sdk.Msg
+		if subject.refundAsset != "" {
+			refundActions = []sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     subject.refundAsset,
+				},
+			}
+		}
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		// submit a proposal expiring by height
+		_, _, err := s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			"sell a snake for a voucher",
+			s.encodeMsgs(refundActions),
+			uint64(blockHeight)+1,
+			nil,
+			0,
+			nil,
+			0,
+			0,
+		)
+		s.Require().NoError(err)
+
+		ctx = ctx.WithBlockHeight(subject.blockHeight).WithBlockTime(subject.blockTime).WithEventManager(sdk.NewEventManager())
+		if err := s.keeper.PruneExpiredProposals(ctx); err != nil {
+			return err
+		}
+
+		numExpired := 0
+		for _, tc := range []struct {
+			agent    sdk.AccAddress
+			asset    string
+			expired  bool
+			refunded bool
+		}{
+			{
+				agent:    s.agentDedicated,
+				asset:    "cat",
+				expired:  false,
+				refunded: false,
+			},
+			{
+				agent:    s.agentAny,
+				asset:    "dog",
+				expired:  !subject.blockTime.Before(blockTime.Add(time.Hour)),
+				refunded: true,
+			},
+			{
+				agent:    s.agentIdle,
+				asset:    "snake",
+				expired:  subject.blockHeight > blockHeight,
+				refunded: subject.refundAsset == "snake",
+			},
+		} {
+			proposal, err := s.keeper.GetProposal(ctx, tc.agent)
+			_, assetErr := s.testQueryServer.Asset(ctx, &testv1alpha1.QueryAssetRequest{
+				Account: s.addressBytesToString(s.seller),
+				Asset:   tc.asset,
+			})
+
+			if !tc.expired {
+				s.Require().NoError(err)
+				s.Require().False(proposal.RefundFailed)
+				s.Require().Error(assetErr)
+				continue
+			}
+			numExpired++
+
+			// the proposal whose refund_actions failed is kept
+			if !tc.refunded {
+				s.Require().NoError(err)
+				s.Require().True(proposal.RefundFailed)
+				s.Require().Empty(proposal.Deposit)
+				s.Require().Error(assetErr)
+				continue
+			}
+
+			s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+			s.Require().NoError(assetErr)
+		}
+
+		numEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&escrowv1alpha1.EventExpireProposal{}) {
+				numEvents++
+			}
+		}
+		s.Require().Equal(numExpired, numEvents)
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[pruneExpiredProposals]{
+		{
+			"before height expiry": {
+				Malleate: func(subject *pruneExpiredProposals) {
+					subject.blockHeight = blockHeight
+				},
+			},
+			"after height expiry": {
+				Malleate: func(subject *pruneExpiredProposals) {
+					subject.blockHeight = blockHeight + 1
+				},
+			},
+		},
+		{
+			"before time expiry": {
+				Malleate: func(subject *pruneExpiredProposals) {
+					subject.blockTime = blockTime
+				},
+			},
+			"after time expiry": {
+				Malleate: func(subject *pruneExpiredProposals) {
+					subject.blockTime = blockTime.Add(time.Hour)
+				},
+			},
+		},
+		{
+			"valid refund_actions": {
+				Malleate: func(subject *pruneExpiredProposals) {
+					subject.refundAsset = "snake"
+				},
+			},
+			"refund_actions failing": {
+				Malleate: func(subject *pruneExpiredProposals) {
+					subject.refundAsset = "whale"
+				},
+			},
+			"no refund_actions": {
+				Malleate: func(subject *pruneExpiredProposals) {
+					subject.refundAsset = ""
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredProposalsCap() {
+	blockHeight := sdk.UnwrapSDKContext(s.ctx).BlockHeight()
+	blockTime := sdk.UnwrapSDKContext(s.ctx).BlockTime()
+
+	ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.MaxPrunesPerBlock = 1
+	err = s.keeper.UpdateParams(ctx, params)
+	s.Require().NoError(err)
+
+	// submit a proposal expiring by height
+	_, _, err = s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.seller),
+				Recipient: s.addressBytesToString(s.agentIdle),
+				Asset:     "snake",
+			},
+		}),
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "voucher",
+			},
+		}),
+		"sell a snake for a voucher",
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "snake",
+			},
+		}),
+		uint64(blockHeight)+1,
+		nil,
+		0,
+		nil,
+		0,
+		0,
+	)
+	s.Require().NoError(err)
+
+	// the proposals of agentAny and agentIdle expire
+	ctx = ctx.WithBlockHeight(blockHeight + 1).WithBlockTime(blockTime.Add(time.Hour))
+
+	numPruned := func() int {
+		num := 0
+		for _, agent := range []sdk.AccAddress{s.agentAny, s.agentIdle} {
+			if _, err := s.keeper.GetProposal(ctx, agent); err != nil {
+				s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+				num++
+			}
+		}
+		return num
+	}
+
+	err = s.keeper.PruneExpiredProposals(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, numPruned())
+
+	// the rest is pruned in the following block
+	err = s.keeper.PruneExpiredProposals(ctx.WithBlockHeight(blockHeight + 2))
+	s.Require().NoError(err)
+	s.Require().Equal(2, numPruned())
+
+	// the proposal not expired is kept
+	_, err = s.keeper.GetProposal(ctx, s.agentDedicated)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRecoverRefundFailedProposal() {
+	ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+	blockHeight := ctx.BlockHeight()
+
+	// submit a proposal whose refund_actions would fail
+	_, _, err := s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.seller),
+				Recipient: s.addressBytesToString(s.agentIdle),
+				Asset:     "snake",
+			},
+		}),
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "voucher",
+			},
+		}),
+		"sell a snake for a voucher",
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "whale",
+			},
+		}),
+		uint64(blockHeight)+1,
+		nil,
+		0,
+		nil,
+		0,
+		0,
+	)
+	s.Require().NoError(err)
+
+	numExpireEvents := func(ctx sdk.Context) int {
+		num := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&escrowv1alpha1.EventExpireProposal{}) {
+				num++
+			}
+		}
+		return num
+	}
+
+	// the failure of the refund_actions keeps the proposal
+	ctx = ctx.WithBlockHeight(blockHeight + 1).WithEventManager(sdk.NewEventManager())
+	err = s.keeper.PruneExpiredProposals(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, numExpireEvents(ctx))
+
+	proposal, err := s.keeper.GetProposal(ctx, s.agentIdle)
+	s.Require().NoError(err)
+	s.Require().True(proposal.RefundFailed)
+
+	// the proposal is left out of the following prunings
+	ctx = ctx.WithBlockHeight(blockHeight + 2).WithEventManager(sdk.NewEventManager())
+	err = s.keeper.PruneExpiredProposals(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(numExpireEvents(ctx))
+
+	// the expired proposal may not be executed
+	_, _, _, _, err = s.keeper.Exec(ctx, s.stranger, []sdk.AccAddress{s.agentIdle}, nil, nil, nil)
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalExpired)
+
+	// the same refund_actions fail on the cancellation
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, err = s.keeper.CancelProposal(cacheCtx, s.seller, s.agentIdle)
+	s.Require().ErrorIs(err, testv1alpha1.ErrAssetNotFound)
+
+	// the proposer recovers the assets by replacing the refund_actions
+	_, _, err = s.keeper.UpdateProposal(ctx, s.seller, s.agentIdle, nil, nil, "",
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "snake",
+			},
+		}),
+	)
+	s.Require().NoError(err)
+
+	_, _, err = s.keeper.CancelProposal(ctx, s.seller, s.agentIdle)
+	s.Require().NoError(err)
+
+	_, err = s.keeper.GetProposal(ctx, s.agentIdle)
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+
+	_, err = s.testQueryServer.Asset(ctx, &testv1alpha1.QueryAssetRequest{
+		Account: s.addressBytesToString(s.seller),
+		Asset:   "snake",
+	})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+func validateExecFee(flat *sdk.Coin, bps uint32) error {
+	if flat != nil {
+		if err := flat.Validate(); err != nil {
+			return errors.Wrap(escrowv1alpha1.ErrInvalidMessage.Wrap(err.Error()), "exec_fee_flat")
+		}
+
+		if !flat.IsZero() && bps != 0 {
+			return escrowv1alpha1.ErrInvalidMessage.Wrap("both of exec_fee_flat and exec_fee_bps provided")
+		}
+	}
+
+	if bps > escrowv1alpha1.MaxExecFeeBps {
+		return errors.Wrap(escrowv1alpha1.ErrInvalidMessage.Wrapf("over limit of %d", escrowv1alpha1.MaxExecFeeBps), "exec_fee_bps")
+	}
+
+	return nil
+}
+
+// chargeExecFee charges the fee of Msg/Exec to the executor, sending it to the
+// community pool.
+func (k Keeper) chargeExecFee(ctx context.Context, executor sdk.AccAddress, declaredValue sdk.Coins) (sdk.Coins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := params.ExecFee(declaredValue)
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, executor, escrowv1alpha1.ModuleName, fee); err != nil {
+		return nil, err
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, fee, k.moduleAddress()); err != nil {
+		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+
+	return fee, nil
+}
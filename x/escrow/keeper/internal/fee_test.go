@@ -0,0 +1,120 @@
+package internal_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestExecFee() {
+	type execFee struct {
+		feeFlat       *sdk.Coin
+		feeBps        uint32
+		declaredValue sdk.Coins
+		fee           sdk.Coins
+	}
+
+	const balance = 100
+
+	tester := func(subject execFee) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.ExecFeeFlat = subject.feeFlat
+		params.ExecFeeBps = subject.feeBps
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		err = s.bank.Mint(ctx, s.stranger, sdk.NewCoins(sdk.NewInt64Coin("stake", balance)))
+		s.Require().NoError(err)
+
+		_, fee, _, _, err := s.keeper.Exec(ctx, s.stranger, []sdk.AccAddress{s.agentAny},
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.stranger),
+					Recipient: s.addressBytesToString(s.agentAny),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentAny),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "dog",
+				},
+			}),
+			nil,
+			subject.declaredValue,
+		)
+		if err != nil {
+			return err
+		}
+		s.Require().Equal(subject.fee, fee)
+
+		pool, err := s.bank.Balance(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName), "stake")
+		s.Require().NoError(err)
+		s.Require().Equal(subject.fee.AmountOf("stake"), pool)
+
+		remaining, err := s.bank.Balance(ctx, s.stranger, "stake")
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewInt(balance).Sub(subject.fee.AmountOf("stake")), remaining)
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[execFee]{
+		{
+			"no fee": {
+				Malleate: func(subject *execFee) {
+					subject.fee = sdk.NewCoins()
+				},
+			},
+			"flat fee": {
+				Malleate: func(subject *execFee) {
+					feeFlat := sdk.NewInt64Coin("stake", 10)
+					subject.feeFlat = &feeFlat
+					subject.fee = sdk.NewCoins(feeFlat)
+				},
+			},
+			"flat fee over balance": {
+				Malleate: func(subject *execFee) {
+					feeFlat := sdk.NewInt64Coin("stake", balance+1)
+					subject.feeFlat = &feeFlat
+				},
+				Error: func() error {
+					return sdkerrors.ErrInsufficientFunds
+				},
+			},
+			"fee in basis points": {
+				Malleate: func(subject *execFee) {
+					subject.feeBps = 250
+					subject.declaredValue = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+					subject.fee = sdk.NewCoins(sdk.NewInt64Coin("stake", 25))
+				},
+			},
+			"zero flat fee with fee in basis points": {
+				Malleate: func(subject *execFee) {
+					feeFlat := sdk.NewInt64Coin("stake", 0)
+					subject.feeFlat = &feeFlat
+					subject.feeBps = 250
+					subject.declaredValue = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+					subject.fee = sdk.NewCoins(sdk.NewInt64Coin("stake", 25))
+				},
+			},
+			"fee in basis points truncated": {
+				Malleate: func(subject *execFee) {
+					subject.feeBps = 1
+					subject.declaredValue = sdk.NewCoins(sdk.NewInt64Coin("stake", 9999))
+					subject.fee = sdk.NewCoins()
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// fillProposal fills the given quantity of the units of the proposal, and
+// returns the filled quantity, the post_actions to execute and the
+// refund_actions which the agent must be able to execute after the fill.
+// The deposit is refunded once the proposal has been filled completely.
+func (k Keeper) fillProposal(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal, quantity uint64) (filled uint64, postActions, remainder []*codectypes.Any, err error) {
+	if proposal.Quantity == 0 {
+		if quantity != 0 {
+			return 0, nil, nil, escrowv1alpha1.ErrInsufficientQuantity.Wrap("not fillable")
+		}
+
+		if err := k.removeProposal(ctx, agent); err != nil {
+			return 0, nil, nil, err
+		}
+
+		if err := k.refundDeposit(ctx, proposal); err != nil {
+			return 0, nil, nil, err
+		}
+
+		return 0, proposal.PostActions, nil, nil
+	}
+
+	if quantity == 0 {
+		quantity = proposal.Quantity
+	}
+
+	if quantity > proposal.Quantity {
+		return 0, nil, nil, escrowv1alpha1.ErrInsufficientQuantity.Wrapf("%d remaining", proposal.Quantity)
+	}
+
+	postActions, err = k.scaleActions(proposal.PostActions, quantity)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	remaining := proposal.Quantity - quantity
+	if remaining == 0 {
+		if err := k.removeProposal(ctx, agent); err != nil {
+			return 0, nil, nil, err
+		}
+
+		if err := k.refundDeposit(ctx, proposal); err != nil {
+			return 0, nil, nil, err
+		}
+
+		return quantity, postActions, nil, nil
+	}
+
+	proposal.Quantity = remaining
+	if err := k.setProposal(ctx, agent, proposal); err != nil {
+		return 0, nil, nil, err
+	}
+
+	remainder, err = k.scaleActions(proposal.RefundActions, remaining)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return quantity, postActions, remainder, nil
+}
+
+// refundActions returns the refund_actions of the proposal, scaled by the
+// remaining quantity if the proposal is fillable partially.
+func (k Keeper) refundActions(proposal *escrowv1alpha1.Proposal) ([]*codectypes.Any, error) {
+	if proposal.Quantity == 0 {
+		return proposal.RefundActions, nil
+	}
+
+	return k.scaleActions(proposal.RefundActions, proposal.Quantity)
+}
+
+// validateFillableRefundActions checks the refund_actions of a partially
+// fillable proposal, which must not be empty as they secure the remaining units
+// on each fill.
+func (k Keeper) validateFillableRefundActions(actions []*codectypes.Any) error {
+	if len(actions) == 0 {
+		return escrowv1alpha1.ErrInvalidMessage.Wrap("empty on a fillable proposal")
+	}
+
+	return k.validateScalableActions(actions)
+}
+
+func (k Keeper) validateScalableActions(actions []*codectypes.Any) error {
+	_, err := k.scaleActions(actions, 1)
+	return err
+}
+
+func (k Keeper) scaleActions(actions []*codectypes.Any, multiplier uint64) ([]*codectypes.Any, error) {
+	scaled := make([]*codectypes.Any, len(actions))
+	for i, action := range actions {
+		msg, err := k.anyToMsg(*action)
+		if err != nil {
+			return nil, indexedError(err, i)
+		}
+
+		scaledMsg, err := scaleMsg(msg, multiplier)
+		if err != nil {
+			return nil, indexedError(err, i)
+		}
+
+		scaled[i], err = codectypes.NewAnyWithValue(scaledMsg)
+		if err != nil {
+			return nil, indexedError(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), i)
+		}
+	}
+
+	return scaled, nil
+}
+
+// scaleMsg multiplies the amounts of the message by the multiplier.
+// Only the messages having fungible amounts are scalable.
+func scaleMsg(msg sdk.Msg, multiplier uint64) (sdk.Msg, error) {
+	if multiplier == 0 {
+		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap("zero multiplier")
+	}
+	factor := math.NewIntFromUint64(multiplier)
+
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		return &banktypes.MsgSend{
+			FromAddress: msg.FromAddress,
+			ToAddress:   msg.ToAddress,
+			Amount:      msg.Amount.MulInt(factor),
+		}, nil
+	case *banktypes.MsgMultiSend:
+		inputs := make([]banktypes.Input, len(msg.Inputs))
+		for i, input := range msg.Inputs {
+			inputs[i] = banktypes.Input{
+				Address: input.Address,
+				Coins:   input.Coins.MulInt(factor),
+			}
+		}
+
+		outputs := make([]banktypes.Output, len(msg.Outputs))
+		for i, output := range msg.Outputs {
+			outputs[i] = banktypes.Output{
+				Address: output.Address,
+				Coins:   output.Coins.MulInt(factor),
+			}
+		}
+
+		return &banktypes.MsgMultiSend{
+			Inputs:  inputs,
+			Outputs: outputs,
+		}, nil
+	default:
+		return nil, escrowv1alpha1.ErrInvalidMessage.Wrapf("not scalable: %s", sdk.MsgTypeURL(msg))
+	}
+}
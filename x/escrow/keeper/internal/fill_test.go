@@ -0,0 +1,216 @@
+package internal_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+)
+
+func (s *KeeperTestSuite) TestExecPartially() {
+	type execPartially struct {
+		quantity uint64
+		take     int64
+		pay      int64
+	}
+
+	const (
+		total     = 10
+		unitPrice = 3
+	)
+
+	send := func(from, to sdk.AccAddress, amount int64, denom string) sdk.Msg {
+		return &banktypes.MsgSend{
+			FromAddress: s.addressBytesToString(from),
+			ToAddress:   s.addressBytesToString(to),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, amount)),
+		}
+	}
+
+	tester := func(subject execPartially) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		err := s.bank.Mint(ctx, s.seller, sdk.NewCoins(sdk.NewInt64Coin("apple", total)))
+		s.Require().NoError(err)
+		err = s.bank.Mint(ctx, s.buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", total*unitPrice)))
+		s.Require().NoError(err)
+
+		// sell apples for stake
+		_, _, err = s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{send(s.seller, s.agentIdle, total, "apple")}),
+			s.encodeMsgs([]sdk.Msg{send(s.agentIdle, s.seller, unitPrice, "stake")}),
+			"sell apples for 3stake each",
+			s.encodeMsgs([]sdk.Msg{send(s.agentIdle, s.seller, 1, "apple")}),
+			0,
+			nil,
+			total,
+			nil,
+			0,
+			0,
+		)
+		s.Require().NoError(err)
+
+		filled, _, _, _, err := s.keeper.Exec(ctx, s.buyer, []sdk.AccAddress{s.agentIdle},
+			s.encodeMsgs([]sdk.Msg{
+				send(s.buyer, s.agentIdle, subject.pay, "stake"),
+				send(s.agentIdle, s.buyer, subject.take, "apple"),
+			}),
+			[]uint64{subject.quantity},
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		s.Require().Len(filled, 1)
+		s.Require().NotZero(filled[0])
+
+		balance, err := s.bank.Balance(ctx, s.seller, "stake")
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewIntFromUint64(filled[0]*unitPrice), balance)
+
+		balance, err = s.bank.Balance(ctx, s.buyer, "apple")
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewIntFromUint64(filled[0]), balance)
+
+		remaining := total - filled[0]
+		if remaining == 0 {
+			_, err := s.keeper.GetProposal(ctx, s.agentIdle)
+			s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+
+			return nil
+		}
+
+		proposal, err := s.keeper.GetProposal(ctx, s.agentIdle)
+		s.Require().NoError(err)
+		s.Require().Equal(remaining, proposal.Quantity)
+
+		// the cancellation refunds the remaining units
+		_, _, err = s.keeper.CancelProposal(ctx, s.seller, s.agentIdle)
+		s.Require().NoError(err)
+
+		balance, err = s.bank.Balance(ctx, s.seller, "apple")
+		s.Require().NoError(err)
+		s.Require().Equal(math.NewIntFromUint64(remaining), balance)
+
+		return nil
+	}
+
+	filledOf := func(subject execPartially) int64 {
+		if subject.quantity == 0 {
+			return total
+		}
+		return int64(subject.quantity)
+	}
+
+	cases := []map[string]testutil.Case[execPartially]{
+		{
+			"fill partially": {
+				Malleate: func(subject *execPartially) {
+					subject.quantity = 4
+				},
+			},
+			"fill all the remaining": {
+				Malleate: func(subject *execPartially) {
+					subject.quantity = 0
+				},
+			},
+			"fill over the remaining": {
+				Malleate: func(subject *execPartially) {
+					subject.quantity = total + 1
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInsufficientQuantity
+				},
+			},
+		},
+		{
+			"take as filled": {
+				Malleate: func(subject *execPartially) {
+					subject.take = filledOf(*subject)
+				},
+			},
+			"take more than filled": {
+				Malleate: func(subject *execPartially) {
+					subject.take = filledOf(*subject) + 1
+				},
+				Error: func() error {
+					return sdkerrors.ErrInsufficientFunds
+				},
+			},
+		},
+		{
+			"pay the scaled price": {
+				Malleate: func(subject *execPartially) {
+					subject.pay = filledOf(*subject) * unitPrice
+				},
+			},
+			"pay less than the scaled price": {
+				Malleate: func(subject *execPartially) {
+					subject.pay = filledOf(*subject)*unitPrice - 1
+				},
+				Error: func() error {
+					return sdkerrors.ErrInsufficientFunds
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestSubmitFillableProposal() {
+	type submitFillableProposal struct {
+		refundActions []sdk.Msg
+	}
+
+	send := func(from, to sdk.AccAddress, amount int64, denom string) sdk.Msg {
+		return &banktypes.MsgSend{
+			FromAddress: s.addressBytesToString(from),
+			ToAddress:   s.addressBytesToString(to),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, amount)),
+		}
+	}
+
+	tester := func(subject submitFillableProposal) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		err := s.bank.Mint(ctx, s.seller, sdk.NewCoins(sdk.NewInt64Coin("apple", 10)))
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.SubmitProposal(ctx, &escrowv1alpha1.MsgSubmitProposal{
+			Proposer:      s.addressBytesToString(s.seller),
+			Agent:         s.addressBytesToString(s.agentIdle),
+			PreActions:    s.encodeMsgs([]sdk.Msg{send(s.seller, s.agentIdle, 10, "apple")}),
+			PostActions:   s.encodeMsgs([]sdk.Msg{send(s.agentIdle, s.seller, 3, "stake")}),
+			Metadata:      "sell apples for 3stake each",
+			RefundActions: s.encodeMsgs(subject.refundActions),
+			Quantity:      10,
+		})
+
+		return err
+	}
+
+	cases := []map[string]testutil.Case[submitFillableProposal]{
+		{
+			"scalable refund_actions": {
+				Malleate: func(subject *submitFillableProposal) {
+					subject.refundActions = []sdk.Msg{send(s.agentIdle, s.seller, 1, "apple")}
+				},
+			},
+			"empty refund_actions": {
+				Malleate: func(subject *submitFillableProposal) {
+					subject.refundActions = []sdk.Msg{}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
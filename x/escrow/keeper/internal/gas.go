@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// consumeStoredActionsGas charges the gas per byte of the actions stored in
+// the proposal.
+func (k Keeper) consumeStoredActionsGas(ctx context.Context, proposal *escrowv1alpha1.Proposal) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var size uint64
+	for _, actions := range [][]*codectypes.Any{
+		proposal.PreActions,
+		proposal.PostActions,
+		proposal.RefundActions,
+	} {
+		for _, action := range actions {
+			size += uint64(action.Size())
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(size*params.GasPerActionByte, "escrow stored actions")
+
+	return nil
+}
+
+// executeActionsWithGasLimit executes the actions under a child gas meter
+// limited by the given gas, whose consumption is charged to the parent gas
+// meter. Zero gas limit means no limit. The child gas meter is also limited by
+// the gas remaining on the parent one, on which running out of gas fails the
+// transaction instead.
+func (k Keeper) executeActionsWithGasLimit(ctx context.Context, agent sdk.AccAddress, phase string, actions []*codectypes.Any, gasLimit uint64) (responses []*codectypes.Any, err error) {
+	if gasLimit == 0 {
+		return k.executeActions(ctx, agent, phase, actions)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	childLimit := gasLimit
+	if remaining := sdkCtx.GasMeter().GasRemaining(); remaining < childLimit {
+		childLimit = remaining
+	}
+	gasMeter := storetypes.NewGasMeter(childLimit)
+
+	defer func() {
+		sdkCtx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "escrow post_actions")
+
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok || childLimit < gasLimit {
+				panic(r)
+			}
+
+			err = errors.Wrap(escrowv1alpha1.ErrExecGasExceeded.Wrapf("over limit of %d", gasLimit), outOfGas.Descriptor)
+		}
+	}()
+
+	return k.executeActions(sdkCtx.WithGasMeter(gasMeter), agent, phase, actions)
+}
@@ -0,0 +1,200 @@
+package internal_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestExecGas() {
+	type execGas struct {
+		gasPerAgent  uint64
+		gasPerAction uint64
+		maxExecGas   uint64
+	}
+
+	tester := func(subject execGas) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.GasPerAgent = subject.gasPerAgent
+		params.GasPerAction = subject.gasPerAction
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		_, _, err = s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			"sell a snake for a voucher",
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+			0,
+			nil,
+			0,
+			nil,
+			0,
+			subject.maxExecGas,
+		)
+		s.Require().NoError(err)
+
+		actions := s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.stranger),
+				Recipient: s.addressBytesToString(s.agentIdle),
+				Asset:     "voucher",
+			},
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.stranger),
+				Asset:     "snake",
+			},
+		})
+
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		if _, _, _, _, err := s.keeper.Exec(ctx, s.stranger, []sdk.AccAddress{s.agentIdle}, actions, nil, nil); err != nil {
+			return err
+		}
+
+		// one agent, two actions and one post-action
+		s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), subject.gasPerAgent+3*subject.gasPerAction)
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[execGas]{
+		{
+			"no gas per agent": {
+				Malleate: func(subject *execGas) {
+					subject.gasPerAgent = 0
+				},
+			},
+			"gas per agent": {
+				Malleate: func(subject *execGas) {
+					subject.gasPerAgent = s.keeper.DefaultGenesis().Params.GasPerAgent
+				},
+			},
+		},
+		{
+			"no gas per action": {
+				Malleate: func(subject *execGas) {
+					subject.gasPerAction = 0
+				},
+			},
+			"gas per action": {
+				Malleate: func(subject *execGas) {
+					subject.gasPerAction = s.keeper.DefaultGenesis().Params.GasPerAction
+				},
+			},
+		},
+		{
+			"no max_exec_gas": {
+				Malleate: func(subject *execGas) {
+					subject.maxExecGas = 0
+				},
+			},
+			"post_actions within max_exec_gas": {
+				Malleate: func(subject *execGas) {
+					subject.maxExecGas = 1_000_000
+				},
+			},
+			"post_actions over max_exec_gas": {
+				Malleate: func(subject *execGas) {
+					subject.maxExecGas = 1
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrExecGasExceeded
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestExecGasOverTransaction() {
+	const gasPerAction = 1_000_000
+
+	ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+	params, err := s.keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	params.GasPerAction = gasPerAction
+	err = s.keeper.UpdateParams(ctx, params)
+	s.Require().NoError(err)
+
+	_, _, err = s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.seller),
+				Recipient: s.addressBytesToString(s.agentIdle),
+				Asset:     "snake",
+			},
+		}),
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "voucher",
+			},
+		}),
+		"sell a snake for a voucher",
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "snake",
+			},
+		}),
+		0,
+		nil,
+		0,
+		nil,
+		0,
+		10*gasPerAction,
+	)
+	s.Require().NoError(err)
+
+	actions := s.encodeMsgs([]sdk.Msg{
+		&testv1alpha1.MsgSend{
+			Sender:    s.addressBytesToString(s.stranger),
+			Recipient: s.addressBytesToString(s.agentIdle),
+			Asset:     "voucher",
+		},
+		&testv1alpha1.MsgSend{
+			Sender:    s.addressBytesToString(s.agentIdle),
+			Recipient: s.addressBytesToString(s.stranger),
+			Asset:     "snake",
+		},
+	})
+
+	// the transaction runs out of gas in the post-action within max_exec_gas,
+	// not after it
+	const gasLimit = 2*gasPerAction + gasPerAction/2
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	s.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "escrow action"}, func() {
+		_, _, _, _, _ = s.keeper.Exec(ctx, s.stranger, []sdk.AccAddress{s.agentIdle}, actions, nil, nil)
+	})
+	s.Require().Equal(uint64(gasLimit), ctx.GasMeter().GasConsumedToLimit())
+}
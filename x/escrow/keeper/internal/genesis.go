@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
 )
@@ -22,6 +23,18 @@ func (k Keeper) DefaultGenesis() *escrowv1alpha1.GenesisState {
 func DefaultGenesisParams() *escrowv1alpha1.GenesisState_Params {
 	return &escrowv1alpha1.GenesisState_Params{
 		MaxMetadataLength: 255,
+		MaxPreActions:     16,
+		MaxPostActions:    16,
+		MaxActions:        32,
+		MaxAgentsPerExec:  16,
+		MaxActionSize:     4096,
+		MaxNestingDepth:   1,
+		GasPerAgent:       20000,
+		GasPerAction:      10000,
+		GasPerActionByte:  10,
+		MaxPrunesPerBlock: 100,
+		MaxRefundActions:  16,
+		MaxSimulateGas:    10000000,
 	}
 }
 
@@ -50,18 +63,94 @@ func (k Keeper) ValidateGenesis(gs *escrowv1alpha1.GenesisState) error {
 		return errors.Wrap(err, "agents")
 	}
 
-	if err := k.validateGenesisProposals(gs.Proposals); err != nil {
+	if err := k.validateGenesisProposals(genesisParamsToParams(gs.Params), gs.Proposals); err != nil {
+		return errors.Wrap(err, "proposals")
+	}
+
+	if err := validateGenesisIdleAgents(gs.Agents, gs.Proposals); err != nil {
 		return errors.Wrap(err, "proposals")
 	}
 
 	return nil
 }
 
+func genesisParamsToParams(params *escrowv1alpha1.GenesisState_Params) *escrowv1alpha1.Params {
+	return &escrowv1alpha1.Params{
+		MaxMetadataLength:   params.MaxMetadataLength,
+		ExecFeeFlat:         params.ExecFeeFlat,
+		ExecFeeBps:          params.ExecFeeBps,
+		MinProposalDeposit:  params.MinProposalDeposit,
+		BurnExpiredDeposits: params.BurnExpiredDeposits,
+		MaxPreActions:       params.MaxPreActions,
+		MaxPostActions:      params.MaxPostActions,
+		MaxActions:          params.MaxActions,
+		MaxAgentsPerExec:    params.MaxAgentsPerExec,
+		MaxActionSize:       params.MaxActionSize,
+		AllowedMessageTypes: params.AllowedMessageTypes,
+		DeniedMessageTypes:  params.DeniedMessageTypes,
+		MaxNestingDepth:     params.MaxNestingDepth,
+		GasPerAgent:         params.GasPerAgent,
+		GasPerAction:        params.GasPerAction,
+		GasPerActionByte:    params.GasPerActionByte,
+		MaxPrunesPerBlock:   params.MaxPrunesPerBlock,
+		MaxRefundActions:    params.MaxRefundActions,
+		MaxSimulateGas:      params.MaxSimulateGas,
+	}
+}
+
 func (k Keeper) validateGenesisParams(params *escrowv1alpha1.GenesisState_Params) error {
 	if params.MaxMetadataLength == 0 {
 		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_metadata_length")
 	}
 
+	if params.MaxPreActions == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_pre_actions")
+	}
+
+	if params.MaxPostActions == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_post_actions")
+	}
+
+	if params.MaxActions == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_actions")
+	}
+
+	if params.MaxAgentsPerExec == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_agents_per_exec")
+	}
+
+	if params.MaxActionSize == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_action_size")
+	}
+
+	if params.MaxPrunesPerBlock == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_prunes_per_block")
+	}
+
+	if params.MaxRefundActions == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_refund_actions")
+	}
+
+	if params.MaxSimulateGas == 0 {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil max_simulate_gas")
+	}
+
+	if err := validateExecFee(params.ExecFeeFlat, params.ExecFeeBps); err != nil {
+		return err
+	}
+
+	if err := validateMinProposalDeposit(params.MinProposalDeposit); err != nil {
+		return err
+	}
+
+	if err := validateMessageTypeFilters(params.AllowedMessageTypes); err != nil {
+		return errors.Wrap(err, "allowed_message_types")
+	}
+
+	if err := validateMessageTypeFilters(params.DeniedMessageTypes); err != nil {
+		return errors.Wrap(err, "denied_message_types")
+	}
+
 	return nil
 }
 
@@ -105,14 +194,14 @@ func (k Keeper) validateGenesisAgent(agent *escrowv1alpha1.GenesisState_Agent) e
 	return nil
 }
 
-func (k Keeper) validateGenesisProposals(proposals []*escrowv1alpha1.GenesisState_Proposal) error {
+func (k Keeper) validateGenesisProposals(params *escrowv1alpha1.Params, proposals []*escrowv1alpha1.GenesisState_Proposal) error {
 	seen := map[string]bool{}
 	for i, proposal := range proposals {
 		if proposal == nil {
 			return indexedError(escrowv1alpha1.ErrUnimplemented.Wrap("nil proposal"), i)
 		}
 
-		if err := k.validateGenesisProposal(proposal); err != nil {
+		if err := k.validateGenesisProposal(params, proposal); err != nil {
 			return indexedError(err, i)
 		}
 
@@ -125,7 +214,9 @@ func (k Keeper) validateGenesisProposals(proposals []*escrowv1alpha1.GenesisStat
 	return nil
 }
 
-func (k Keeper) validateGenesisProposal(proposal *escrowv1alpha1.GenesisState_Proposal) error {
+// validateGenesisProposal runs the stateless rules which Msg/SubmitProposal
+// enforces, against the params of the genesis state.
+func (k Keeper) validateGenesisProposal(params *escrowv1alpha1.Params, proposal *escrowv1alpha1.GenesisState_Proposal) error {
 	if proposal.Agent == "" {
 		return escrowv1alpha1.ErrUnimplemented.Wrap("nil agent")
 	}
@@ -146,18 +237,205 @@ func (k Keeper) validateGenesisProposal(proposal *escrowv1alpha1.GenesisState_Pr
 		return escrowv1alpha1.ErrUnimplemented.Wrap("nil metadata")
 	}
 
-	if _, err := k.addressStringToBytes(proposal.Agent); err != nil {
+	if proposal.RefundActions == nil {
+		return escrowv1alpha1.ErrUnimplemented.Wrap("nil refund_actions")
+	}
+
+	agent, err := k.addressStringToBytes(proposal.Agent)
+	if err != nil {
 		return errors.Wrap(err, "agent")
 	}
 
-	if _, err := k.addressStringToBytes(proposal.Proposer); err != nil {
+	proposer, err := k.addressStringToBytes(proposal.Proposer)
+	if err != nil {
 		return errors.Wrap(err, "proposer")
 	}
 
+	if _, err := k.executorsStringToBytes(proposal.Executors); err != nil {
+		return errors.Wrap(err, "executors")
+	}
+
+	if len(proposal.Executors) != 0 && proposal.ExecutorGroupId != 0 {
+		return escrowv1alpha1.ErrInvalidMessage.Wrap("both of executors and executor_group_id provided")
+	}
+
+	if err := proposal.Deposit.Validate(); err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvalidMessage.Wrap(err.Error()), "deposit")
+	}
+
+	if err := validateMetadataLength(proposal.Metadata, params.MaxMetadataLength); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+
+	if err := validateProposalLimitsWithParams(params, proposal.PreActions, proposal.PostActions, proposal.RefundActions); err != nil {
+		return err
+	}
+
+	signers := []sdk.AccAddress{proposer, agent}
+
+	if err := k.validateActionSigners(proposal.PreActions, signers); err != nil {
+		return errors.Wrap(err, "pre_actions")
+	}
+
+	if err := k.validateActionSigners(proposal.PostActions, signers); err != nil {
+		return errors.Wrap(err, "post_actions")
+	}
+
+	if err := k.validateActionSigners(proposal.RefundActions, []sdk.AccAddress{agent}); err != nil {
+		return errors.Wrap(err, "refund_actions")
+	}
+
+	if proposal.Quantity != 0 {
+		if err := k.validateScalableActions(proposal.PostActions); err != nil {
+			return errors.Wrap(err, "post_actions")
+		}
+
+		if err := k.validateFillableRefundActions(proposal.RefundActions); err != nil {
+			return errors.Wrap(err, "refund_actions")
+		}
+	}
+
 	return nil
 }
 
+// validateGenesisIdleAgents checks that no agent is both idle and in charge of
+// a proposal, as an agent is removed on the submission of its proposal.
+func validateGenesisIdleAgents(agents []*escrowv1alpha1.GenesisState_Agent, proposals []*escrowv1alpha1.GenesisState_Proposal) error {
+	idle := map[string]bool{}
+	for _, agent := range agents {
+		idle[agent.Address] = true
+	}
+
+	for i, proposal := range proposals {
+		if idle[proposal.Agent] {
+			return indexedError(errors.Wrap(escrowv1alpha1.ErrDuplicateEntry.Wrap("idle agent"), "agent"), i)
+		}
+	}
+
+	return nil
+}
+
+// ValidateGenesisAccounts checks the accounts of the agents and the proposers
+// in the genesis state against the accounts in the genesis state of x/auth.
+func (k Keeper) ValidateGenesisAccounts(gs *escrowv1alpha1.GenesisState, accounts []sdk.AccountI) error {
+	accountsByAddress := map[string]sdk.AccountI{}
+	for _, acc := range accounts {
+		accountsByAddress[string(acc.GetAddress())] = acc
+	}
+
+	return k.checkGenesisAccounts(gs,
+		func(address sdk.AccAddress) sdk.AccountI {
+			return accountsByAddress[string(address)]
+		},
+		func(sdk.AccAddress, *authtypes.ModuleCredential) error {
+			return nil
+		},
+	)
+}
+
+// checkGenesisAccounts checks that the accounts of the agents and the
+// proposers exist, where getAccount returns nil on a missing account. If
+// configured so, the missing accounts of the agents are passed to recreate
+// with their credentials. Otherwise, it fails on them.
+func (k Keeper) checkGenesisAccounts(
+	gs *escrowv1alpha1.GenesisState,
+	getAccount func(address sdk.AccAddress) sdk.AccountI,
+	recreate func(address sdk.AccAddress, ac *authtypes.ModuleCredential) error,
+) error {
+	// derive the credentials of the agents without accounts only
+	var credentials map[string]*authtypes.ModuleCredential
+	if k.config.RecreateAgentAccounts {
+		missing := map[string]bool{}
+		for _, agentStr := range genesisAgentAddresses(gs) {
+			agent, err := k.addressStringToBytes(agentStr)
+			if err != nil {
+				continue
+			}
+
+			if getAccount(agent) == nil {
+				missing[string(agent)] = true
+			}
+		}
+
+		var err error
+		credentials, err = agentCredentials(gs.NextAgent, missing)
+		if err != nil {
+			return escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+		}
+	}
+
+	checkAgent := func(agentStr string) error {
+		agent, err := k.addressStringToBytes(agentStr)
+		if err != nil {
+			return err
+		}
+
+		if acc := getAccount(agent); acc != nil {
+			return validateAgentAccount(acc)
+		}
+
+		if !k.config.RecreateAgentAccounts {
+			return escrowv1alpha1.ErrAccountNotFound
+		}
+
+		ac, ok := credentials[string(agent)]
+		if !ok {
+			return escrowv1alpha1.ErrAccountNotFound.Wrap("not derivable by next_agent")
+		}
+
+		return recreate(agent, ac)
+	}
+
+	for i, agent := range gs.Agents {
+		if err := checkAgent(agent.Address); err != nil {
+			return errors.Wrap(indexedError(errors.Wrap(err, "address"), i), "agents")
+		}
+	}
+
+	for i, proposal := range gs.Proposals {
+		if err := checkAgent(proposal.Agent); err != nil {
+			return errors.Wrap(indexedError(errors.Wrap(err, "agent"), i), "proposals")
+		}
+
+		proposer, err := k.addressStringToBytes(proposal.Proposer)
+		if err != nil {
+			return errors.Wrap(indexedError(errors.Wrap(err, "proposer"), i), "proposals")
+		}
+
+		if getAccount(proposer) == nil {
+			return errors.Wrap(indexedError(errors.Wrap(escrowv1alpha1.ErrAccountNotFound, "proposer"), i), "proposals")
+		}
+	}
+
+	return nil
+}
+
+// genesisAgentAddresses returns the addresses of the idle agents and the agents
+// of the proposals in the genesis state.
+func genesisAgentAddresses(gs *escrowv1alpha1.GenesisState) []string {
+	addresses := make([]string, 0, len(gs.Agents)+len(gs.Proposals))
+	for _, agent := range gs.Agents {
+		addresses = append(addresses, agent.Address)
+	}
+	for _, proposal := range gs.Proposals {
+		addresses = append(addresses, proposal.Agent)
+	}
+
+	return addresses
+}
+
 func (k Keeper) InitGenesis(ctx context.Context, gs *escrowv1alpha1.GenesisState) error {
+	if err := k.checkGenesisAccounts(gs,
+		func(address sdk.AccAddress) sdk.AccountI {
+			return k.authKeeper.GetAccount(ctx, address)
+		},
+		func(address sdk.AccAddress, ac *authtypes.ModuleCredential) error {
+			return k.setAgentAccount(ctx, address, ac)
+		},
+	); err != nil {
+		return err
+	}
+
 	if err := k.initGenesisParams(ctx, gs.Params); err != nil {
 		return errors.Wrap(err, "params")
 	}
@@ -178,9 +456,7 @@ func (k Keeper) InitGenesis(ctx context.Context, gs *escrowv1alpha1.GenesisState
 }
 
 func (k Keeper) initGenesisParams(ctx context.Context, params *escrowv1alpha1.GenesisState_Params) error {
-	return k.setParams(ctx, &escrowv1alpha1.Params{
-		MaxMetadataLength: params.MaxMetadataLength,
-	})
+	return k.setParams(ctx, genesisParamsToParams(params))
 }
 
 func (k Keeper) initGenesisAgents(ctx context.Context, agents []*escrowv1alpha1.GenesisState_Agent) error {
@@ -230,11 +506,27 @@ func (k Keeper) initGenesisProposal(ctx context.Context, proposal *escrowv1alpha
 		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "proposer")
 	}
 
+	executors, err := k.executorsStringToBytes(proposal.Executors)
+	if err != nil {
+		return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "executors")
+	}
+
 	return k.setProposal(ctx, agent, &escrowv1alpha1.Proposal{
-		Proposer:    proposer,
-		PreActions:  proposal.PreActions,
-		PostActions: proposal.PostActions,
-		Metadata:    proposal.Metadata,
+		Proposer:        proposer,
+		PreActions:      proposal.PreActions,
+		PostActions:     proposal.PostActions,
+		Metadata:        proposal.Metadata,
+		RefundActions:   proposal.RefundActions,
+		ExpireHeight:    proposal.ExpireHeight,
+		ExpireTime:      proposal.ExpireTime,
+		Quantity:        proposal.Quantity,
+		Executors:       executorsToBytes(executors),
+		ExecutorGroupId: proposal.ExecutorGroupId,
+		Deposit:         proposal.Deposit,
+		MaxExecGas:      proposal.MaxExecGas,
+		SubmitHeight:    proposal.SubmitHeight,
+		SubmitTime:      proposal.SubmitTime,
+		RefundFailed:    proposal.RefundFailed,
 	})
 }
 
@@ -274,7 +566,25 @@ func (k Keeper) exportGenesisParams(ctx context.Context) (*escrowv1alpha1.Genesi
 	}
 
 	return &escrowv1alpha1.GenesisState_Params{
-		MaxMetadataLength: params.MaxMetadataLength,
+		MaxMetadataLength:   params.MaxMetadataLength,
+		ExecFeeFlat:         params.ExecFeeFlat,
+		ExecFeeBps:          params.ExecFeeBps,
+		MinProposalDeposit:  params.MinProposalDeposit,
+		BurnExpiredDeposits: params.BurnExpiredDeposits,
+		MaxPreActions:       params.MaxPreActions,
+		MaxPostActions:      params.MaxPostActions,
+		MaxActions:          params.MaxActions,
+		MaxAgentsPerExec:    params.MaxAgentsPerExec,
+		MaxActionSize:       params.MaxActionSize,
+		AllowedMessageTypes: params.AllowedMessageTypes,
+		DeniedMessageTypes:  params.DeniedMessageTypes,
+		MaxNestingDepth:     params.MaxNestingDepth,
+		GasPerAgent:         params.GasPerAgent,
+		GasPerAction:        params.GasPerAction,
+		GasPerActionByte:    params.GasPerActionByte,
+		MaxPrunesPerBlock:   params.MaxPrunesPerBlock,
+		MaxRefundActions:    params.MaxRefundActions,
+		MaxSimulateGas:      params.MaxSimulateGas,
 	}, nil
 }
 
@@ -317,12 +627,28 @@ func (k Keeper) exportGenesisProposals(ctx context.Context) ([]*escrowv1alpha1.G
 			return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "proposer")
 		}
 
+		executorsStr, err := k.executorsBytesToString(proposal.Executors)
+		if err != nil {
+			return errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "executors")
+		}
+
 		proposals = append(proposals, &escrowv1alpha1.GenesisState_Proposal{
-			Agent:       agentStr,
-			Proposer:    proposerStr,
-			PreActions:  proposal.PreActions,
-			PostActions: proposal.PostActions,
-			Metadata:    proposal.Metadata,
+			Agent:           agentStr,
+			Proposer:        proposerStr,
+			PreActions:      proposal.PreActions,
+			PostActions:     proposal.PostActions,
+			Metadata:        proposal.Metadata,
+			RefundActions:   proposal.RefundActions,
+			ExpireHeight:    proposal.ExpireHeight,
+			ExpireTime:      proposal.ExpireTime,
+			Quantity:        proposal.Quantity,
+			Executors:       executorsStr,
+			ExecutorGroupId: proposal.ExecutorGroupId,
+			Deposit:         proposal.Deposit,
+			MaxExecGas:      proposal.MaxExecGas,
+			SubmitHeight:    proposal.SubmitHeight,
+			SubmitTime:      proposal.SubmitTime,
+			RefundFailed:    proposal.RefundFailed,
 		})
 
 		return nil
@@ -1,16 +1,22 @@
 package internal_test
 
 import (
+	"encoding/binary"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"cosmossdk.io/math"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	keeper "github.com/0tech/andromeda/x/escrow/keeper/internal"
 	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
 )
 
 func TestValidateGenesisParams(t *testing.T) {
@@ -34,6 +40,202 @@ func TestValidateGenesisParams(t *testing.T) {
 				},
 			},
 		},
+		{
+			"valid structural limits": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+			},
+			"nil max_pre_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_post_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_agents_per_exec": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_action_size": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_prunes_per_block": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_refund_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxSimulateGas = k.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_simulate_gas": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MaxPreActions = k.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = k.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = k.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = k.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = k.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = k.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = k.DefaultGenesis().Params.MaxRefundActions
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+		},
+		{
+			"no exec fee": {},
+			"valid exec_fee_flat": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					feeFlat := sdk.NewInt64Coin("stake", 10)
+					subject.ExecFeeFlat = &feeFlat
+				},
+			},
+			"valid exec_fee_bps": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.ExecFeeBps = 100
+				},
+			},
+			"both of exec_fee_flat and exec_fee_bps": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					feeFlat := sdk.NewInt64Coin("stake", 10)
+					subject.ExecFeeFlat = &feeFlat
+					subject.ExecFeeBps = 100
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"no min_proposal_deposit": {},
+			"valid min_proposal_deposit": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MinProposalDeposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+				},
+			},
+			"invalid min_proposal_deposit": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.MinProposalDeposit = sdk.Coins{
+						{
+							Denom:  "stake",
+							Amount: math.NewInt(-1),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"no message type filters": {},
+			"valid message type filters": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.AllowedMessageTypes = []string{"/cosmos.bank.*"}
+					subject.DeniedMessageTypes = []string{"/cosmos.bank.v1beta1.MsgMultiSend"}
+				},
+			},
+			"empty allowed_message_types entry": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.AllowedMessageTypes = []string{""}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+			"duplicate denied_message_types entries": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
+					subject.DeniedMessageTypes = []string{"/cosmos.staking.*", "/cosmos.staking.*"}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrDuplicateEntry
+				},
+			},
+		},
 	}
 
 	testutil.DoTest(t, tester, cases)
@@ -355,6 +557,24 @@ func TestValidateGenesisProposals(t *testing.T) {
 					},
 				},
 			},
+			{
+				"nil refund_actions": {
+					Error: func() error {
+						if !added {
+							return nil
+						}
+						return escrowv1alpha1.ErrUnimplemented
+					},
+				},
+				"valid refund_actions": {
+					Malleate: func(subject *[]*escrowv1alpha1.GenesisState_Proposal) {
+						if !added {
+							return
+						}
+						(*subject)[len(*subject)-1].RefundActions = []*codectypes.Any{}
+					},
+				},
+			},
 		}...)
 
 		// add duplicate proposal.
@@ -366,11 +586,12 @@ func TestValidateGenesisProposals(t *testing.T) {
 						return
 					}
 					*subject = append(*subject, &escrowv1alpha1.GenesisState_Proposal{
-						Agent:       agentStr,
-						Proposer:    proposerStr,
-						PreActions:  []*codectypes.Any{},
-						PostActions: []*codectypes.Any{},
-						Metadata:    randomString(int(k.DefaultGenesis().Params.MaxMetadataLength) - 1),
+						Agent:         agentStr,
+						Proposer:      proposerStr,
+						PreActions:    []*codectypes.Any{},
+						PostActions:   []*codectypes.Any{},
+						Metadata:      randomString(int(k.DefaultGenesis().Params.MaxMetadataLength) - 1),
+						RefundActions: []*codectypes.Any{},
 					})
 				},
 				Error: func() error {
@@ -386,8 +607,183 @@ func TestValidateGenesisProposals(t *testing.T) {
 	testutil.DoTest(t, tester, cases)
 }
 
+func TestValidateGenesisProposalActions(t *testing.T) {
+	cdc, _, k := setupEscrowKeeper(t)
+	addressCodec := cdc.InterfaceRegistry().SigningContext().AddressCodec()
+	addressBytesToString := func(address []byte) string {
+		addressStr, err := addressCodec.BytesToString(address)
+		assert.NoError(t, err)
+		return addressStr
+	}
+	encodeMsg := func(msg sdk.Msg) *codectypes.Any {
+		any, err := codectypes.NewAnyWithValue(msg)
+		assert.NoError(t, err)
+		return any
+	}
+
+	agentStr := addressBytesToString(createRandomAddress())
+	proposerStr := addressBytesToString(createRandomAddress())
+	strangerStr := addressBytesToString(createRandomAddress())
+
+	send := func(sender, recipient string) *codectypes.Any {
+		return encodeMsg(&testv1alpha1.MsgSend{
+			Sender:    sender,
+			Recipient: recipient,
+			Asset:     "cat",
+		})
+	}
+
+	tester := func(subject escrowv1alpha1.GenesisState_Proposal) error {
+		subject.Agent = agentStr
+		subject.Proposer = proposerStr
+
+		gs := k.DefaultGenesis()
+		gs.Proposals = []*escrowv1alpha1.GenesisState_Proposal{&subject}
+		return k.ValidateGenesis(gs)
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.GenesisState_Proposal]{
+		{
+			"valid pre_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.PreActions = []*codectypes.Any{send(proposerStr, agentStr)}
+				},
+			},
+			"pre_actions of a wrong signer": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.PreActions = []*codectypes.Any{send(strangerStr, agentStr)}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+			"pre_actions of an unknown message": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.PreActions = []*codectypes.Any{{TypeUrl: "/unknown.Msg"}}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+			"too many pre_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.PreActions = make([]*codectypes.Any, k.DefaultGenesis().Params.MaxPreActions+1)
+					for i := range subject.PreActions {
+						subject.PreActions[i] = send(proposerStr, agentStr)
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrTooManyPreActions
+				},
+			},
+		},
+		{
+			"valid post_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.PostActions = []*codectypes.Any{send(agentStr, proposerStr)}
+				},
+			},
+			"post_actions of a wrong signer": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.PostActions = []*codectypes.Any{send(strangerStr, proposerStr)}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"valid metadata": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.Metadata = randomString(int(k.DefaultGenesis().Params.MaxMetadataLength))
+				},
+			},
+			"large metadata": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.Metadata = randomString(int(k.DefaultGenesis().Params.MaxMetadataLength) + 1)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrLargeMetadata
+				},
+			},
+		},
+		{
+			"valid refund_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.RefundActions = []*codectypes.Any{send(agentStr, proposerStr)}
+				},
+			},
+			"refund_actions of the proposer": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.RefundActions = []*codectypes.Any{send(proposerStr, agentStr)}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"not fillable": {},
+			"fillable without refund_actions": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState_Proposal) {
+					subject.Quantity = 2
+					subject.RefundActions = []*codectypes.Any{}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(t, tester, cases)
+}
+
+func TestValidateGenesisProposalDeniedActions(t *testing.T) {
+	cdc, _, k := setupEscrowKeeper(t)
+	addressCodec := cdc.InterfaceRegistry().SigningContext().AddressCodec()
+	addressBytesToString := func(address []byte) string {
+		addressStr, err := addressCodec.BytesToString(address)
+		assert.NoError(t, err)
+		return addressStr
+	}
+
+	agentStr := addressBytesToString(createRandomAddress())
+	proposerStr := addressBytesToString(createRandomAddress())
+
+	send, err := codectypes.NewAnyWithValue(&testv1alpha1.MsgSend{
+		Sender:    proposerStr,
+		Recipient: agentStr,
+		Asset:     "cat",
+	})
+	assert.NoError(t, err)
+
+	// the stored proposals are exempt from the message type filters
+	gs := k.DefaultGenesis()
+	gs.Params.DeniedMessageTypes = []string{"/andromeda.test.v1alpha1.MsgSend"}
+	gs.Proposals = []*escrowv1alpha1.GenesisState_Proposal{
+		{
+			Agent:         agentStr,
+			Proposer:      proposerStr,
+			PreActions:    []*codectypes.Any{send},
+			PostActions:   []*codectypes.Any{},
+			Metadata:      "very good proposal",
+			RefundActions: []*codectypes.Any{},
+		},
+	}
+	assert.NoError(t, k.ValidateGenesis(gs))
+}
+
 func TestValidateGenesis(t *testing.T) {
-	_, _, k := setupEscrowKeeper(t)
+	cdc, _, k := setupEscrowKeeper(t)
+	addressCodec := cdc.InterfaceRegistry().SigningContext().AddressCodec()
+	addressBytesToString := func(address []byte) string {
+		addressStr, err := addressCodec.BytesToString(address)
+		assert.NoError(t, err)
+		return addressStr
+	}
+
+	agentStr := addressBytesToString(createRandomAddress())
+	creatorStr := addressBytesToString(createRandomAddress())
 
 	tester := func(subject escrowv1alpha1.GenesisState) error {
 		return k.ValidateGenesis(&subject)
@@ -441,6 +837,32 @@ func TestValidateGenesis(t *testing.T) {
 				},
 			},
 		},
+		{
+			"no agent in charge": {},
+			"idle agent in charge": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					if subject.Agents == nil || subject.Proposals == nil {
+						return
+					}
+
+					subject.Agents = append(subject.Agents, &escrowv1alpha1.GenesisState_Agent{
+						Address: agentStr,
+						Creator: creatorStr,
+					})
+					subject.Proposals = append(subject.Proposals, &escrowv1alpha1.GenesisState_Proposal{
+						Agent:         agentStr,
+						Proposer:      creatorStr,
+						PreActions:    []*codectypes.Any{},
+						PostActions:   []*codectypes.Any{},
+						Metadata:      "metadata",
+						RefundActions: []*codectypes.Any{},
+					})
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrDuplicateEntry
+				},
+			},
+		},
 	}
 
 	testutil.DoTest(t, tester, cases)
@@ -477,9 +899,9 @@ func TestInitExportGenesisParams(t *testing.T) {
 	}
 	cases := []map[string]testutil.Case[escrowv1alpha1.GenesisState_Params]{
 		{
-			"valid max_metadata_length": {
+			"default params": {
 				Malleate: func(subject *escrowv1alpha1.GenesisState_Params) {
-					subject.MaxMetadataLength = k.DefaultGenesis().Params.MaxMetadataLength
+					*subject = *k.DefaultGenesis().Params
 				},
 			},
 		},
@@ -489,7 +911,7 @@ func TestInitExportGenesisParams(t *testing.T) {
 }
 
 func TestInitExportGenesisAgents(t *testing.T) {
-	cdc, ctxBefore, k := setupEscrowKeeper(t)
+	cdc, ctxBefore, k, authKeeper, _, _, _ := setupKeepers(t)
 	addressCodec := cdc.InterfaceRegistry().SigningContext().AddressCodec()
 	addressBytesToString := func(address []byte) string {
 		addressStr, err := addressCodec.BytesToString(address)
@@ -502,7 +924,7 @@ func TestInitExportGenesisAgents(t *testing.T) {
 		return addressBytes
 	}
 
-	addresses := createIncrementalAddresses(4)
+	addresses := createAgentAccounts(t, ctxBefore, authKeeper, addressCodec, 4)
 	creatorStr := addressBytesToString(createRandomAddress())
 
 	tester := func(subject []*escrowv1alpha1.GenesisState_Agent) error {
@@ -565,7 +987,7 @@ func TestInitExportGenesisAgents(t *testing.T) {
 }
 
 func TestInitExportGenesisProposals(t *testing.T) {
-	cdc, ctxBefore, k := setupEscrowKeeper(t)
+	cdc, ctxBefore, k, authKeeper, _, _, _ := setupKeepers(t)
 	addressCodec := cdc.InterfaceRegistry().SigningContext().AddressCodec()
 	addressBytesToString := func(address []byte) string {
 		addressStr, err := addressCodec.BytesToString(address)
@@ -578,8 +1000,11 @@ func TestInitExportGenesisProposals(t *testing.T) {
 		return addressBytes
 	}
 
-	agents := createIncrementalAddresses(4)
+	agents := createAgentAccounts(t, ctxBefore, authKeeper, addressCodec, 4)
 	proposerStr := addressBytesToString(createRandomAddress())
+	authKeeper.SetAccount(ctxBefore, authKeeper.NewAccount(ctxBefore, &authtypes.BaseAccount{
+		Address: proposerStr,
+	}))
 
 	tester := func(subject []*escrowv1alpha1.GenesisState_Proposal) error {
 		gsInput := k.DefaultGenesis()
@@ -605,6 +1030,7 @@ func TestInitExportGenesisProposals(t *testing.T) {
 			assert.Equal(t, proposal.PreActions, proposalAfter.PreActions, i)
 			assert.Equal(t, proposal.PostActions, proposalAfter.PostActions, i)
 			assert.Equal(t, proposal.Metadata, proposalAfter.Metadata, i)
+			assert.Equal(t, proposal.RefundActions, proposalAfter.RefundActions, i)
 		}
 
 		gsOutput, err := k.ExportGenesis(ctxAfter)
@@ -631,11 +1057,12 @@ func TestInitExportGenesisProposals(t *testing.T) {
 			fmt.Sprintf("proposal %d", i): {
 				Malleate: func(subject *[]*escrowv1alpha1.GenesisState_Proposal) {
 					*subject = append(*subject, &escrowv1alpha1.GenesisState_Proposal{
-						Agent:       agentStr,
-						Proposer:    proposerStr,
-						PreActions:  []*codectypes.Any{},
-						PostActions: []*codectypes.Any{},
-						Metadata:    "metadata",
+						Agent:         agentStr,
+						Proposer:      proposerStr,
+						PreActions:    []*codectypes.Any{},
+						PostActions:   []*codectypes.Any{},
+						Metadata:      "metadata",
+						RefundActions: []*codectypes.Any{},
 					})
 				},
 			},
@@ -697,3 +1124,207 @@ func TestInitExportGenesis(t *testing.T) {
 
 	testutil.DoTest(t, tester, cases)
 }
+
+func TestInitGenesisAccounts(t *testing.T) {
+	testInitGenesisAccounts(t, false)
+}
+
+func TestInitGenesisRecreateAgentAccounts(t *testing.T) {
+	testInitGenesisAccounts(t, true)
+}
+
+func testInitGenesisAccounts(t *testing.T, recreate bool) {
+	config := keeper.DefaultConfig()
+	config.RecreateAgentAccounts = recreate
+	cdc, ctxBefore, k, authKeeper, _, _, _ := setupKeepersWithConfig(t, config)
+	addressCodec := cdc.InterfaceRegistry().SigningContext().AddressCodec()
+	addressBytesToString := func(address []byte) string {
+		addressStr, err := addressCodec.BytesToString(address)
+		assert.NoError(t, err)
+		return addressStr
+	}
+	addressStringToBytes := func(address string) sdk.AccAddress {
+		addressBytes, err := addressCodec.StringToBytes(address)
+		assert.NoError(t, err)
+		return addressBytes
+	}
+	derivedAddress := func(agentNum uint64) sdk.AccAddress {
+		derivationKey := make([]byte, 8)
+		binary.BigEndian.PutUint64(derivationKey, agentNum)
+
+		ac, err := authtypes.NewModuleCredential(escrowv1alpha1.ModuleName, []byte("agent"), derivationKey)
+		assert.NoError(t, err)
+
+		return sdk.AccAddress(ac.Address())
+	}
+	createAccount := func() sdk.AccAddress {
+		address := createRandomAddress()
+		authKeeper.SetAccount(ctxBefore, authKeeper.NewAccount(ctxBefore, &authtypes.BaseAccount{
+			Address: addressBytesToString(address),
+		}))
+
+		return address
+	}
+
+	const nextAgent = 3
+	agents := createAgentAccounts(t, ctxBefore, authKeeper, addressCodec, 2)
+	proposer := createAccount()
+
+	// derived agents without accounts are recreated only if configured so
+	errAccountNotRecreated := func() error {
+		if recreate {
+			return nil
+		}
+		return escrowv1alpha1.ErrAccountNotFound
+	}
+
+	tester := func(subject escrowv1alpha1.GenesisState) error {
+		assert.NoError(t, k.ValidateGenesis(&subject))
+
+		ctxAfter, _ := sdk.UnwrapSDKContext(ctxBefore).CacheContext()
+
+		addresses := []string{}
+		for _, agent := range subject.Agents {
+			addresses = append(addresses, agent.Address)
+		}
+		for _, proposal := range subject.Proposals {
+			addresses = append(addresses, proposal.Agent, proposal.Proposer)
+		}
+		accounts := []sdk.AccountI{}
+		for _, address := range addresses {
+			address := addressStringToBytes(address)
+			if acc := authKeeper.GetAccount(ctxAfter, address); acc != nil {
+				// the accounts in the genesis of x/auth are encoded in the
+				// global prefix
+				bac := acc.(*authtypes.BaseAccount)
+				bac.Address = address.String()
+				accounts = append(accounts, bac)
+			}
+		}
+		errValidate := k.ValidateGenesisAccounts(&subject, accounts)
+
+		err := k.InitGenesis(ctxAfter, &subject)
+		if err != nil {
+			assert.Error(t, errValidate)
+			assert.Equal(t, err.Error(), errValidate.Error())
+			return err
+		}
+		assert.NoError(t, errValidate)
+
+		for _, address := range addresses {
+			acc := authKeeper.GetAccount(ctxAfter, addressStringToBytes(address))
+			assert.NotNil(t, acc, address)
+		}
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.GenesisState]{
+		{
+			"valid genesis": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					*subject = *k.DefaultGenesis()
+					subject.NextAgent = nextAgent
+				},
+			},
+		},
+		{
+			"agent with account": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					subject.Agents = []*escrowv1alpha1.GenesisState_Agent{
+						{
+							Address: addressBytesToString(agents[0]),
+							Creator: addressBytesToString(proposer),
+						},
+					}
+				},
+			},
+			"derived agent without account": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					subject.Agents = []*escrowv1alpha1.GenesisState_Agent{
+						{
+							Address: addressBytesToString(derivedAddress(nextAgent - 1)),
+							Creator: addressBytesToString(proposer),
+						},
+					}
+				},
+				Error: errAccountNotRecreated,
+			},
+			"underived agent without account": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					subject.Agents = []*escrowv1alpha1.GenesisState_Agent{
+						{
+							Address: addressBytesToString(derivedAddress(nextAgent)),
+							Creator: addressBytesToString(proposer),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrAccountNotFound
+				},
+			},
+			"agent with account not of agent": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					subject.Agents = []*escrowv1alpha1.GenesisState_Agent{
+						{
+							Address: addressBytesToString(createAccount()),
+							Creator: addressBytesToString(proposer),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+		},
+		{
+			"proposal with accounts": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					subject.Proposals = []*escrowv1alpha1.GenesisState_Proposal{
+						{
+							Agent:         addressBytesToString(agents[1]),
+							Proposer:      addressBytesToString(proposer),
+							PreActions:    []*codectypes.Any{},
+							PostActions:   []*codectypes.Any{},
+							Metadata:      "metadata",
+							RefundActions: []*codectypes.Any{},
+						},
+					}
+				},
+			},
+			"proposal of derived agent without account": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					subject.Proposals = []*escrowv1alpha1.GenesisState_Proposal{
+						{
+							Agent:         addressBytesToString(derivedAddress(nextAgent - 2)),
+							Proposer:      addressBytesToString(proposer),
+							PreActions:    []*codectypes.Any{},
+							PostActions:   []*codectypes.Any{},
+							Metadata:      "metadata",
+							RefundActions: []*codectypes.Any{},
+						},
+					}
+				},
+				Error: errAccountNotRecreated,
+			},
+			"proposal of proposer without account": {
+				Malleate: func(subject *escrowv1alpha1.GenesisState) {
+					subject.Proposals = []*escrowv1alpha1.GenesisState_Proposal{
+						{
+							Agent:         addressBytesToString(agents[1]),
+							Proposer:      addressBytesToString(createRandomAddress()),
+							PreActions:    []*codectypes.Any{},
+							PostActions:   []*codectypes.Any{},
+							Metadata:      "metadata",
+							RefundActions: []*codectypes.Any{},
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrAccountNotFound
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(t, tester, cases)
+}
@@ -0,0 +1,118 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"github.com/0tech/andromeda/x/escrow/keeper/expected"
+)
+
+// groupKeeper is a minimal implementation of the queries of x/group, which is
+// used for testing the executor policies.
+type groupKeeper struct {
+	addressCodec address.Codec
+
+	nextGroup collections.Sequence
+	members   collections.KeySet[collections.Pair[uint64, sdk.AccAddress]]
+	groups    collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+}
+
+var _ expected.GroupKeeper = (*groupKeeper)(nil)
+
+func newGroupKeeper(t *testing.T, cdc codec.Codec, key *storetypes.KVStoreKey) *groupKeeper {
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	keeper := &groupKeeper{
+		addressCodec: cdc.InterfaceRegistry().SigningContext().AddressCodec(),
+		nextGroup:    collections.NewSequence(sb, collections.NewPrefix([]byte{0xfd, 0x00}), "next_group"),
+		members: collections.NewKeySet(sb, collections.NewPrefix([]byte{0xfd, 0x01}), "members",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+		),
+		groups: collections.NewKeySet(sb, collections.NewPrefix([]byte{0xfd, 0x02}), "groups",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
+	}
+
+	_, err := sb.Build()
+	assert.NoError(t, err)
+
+	return keeper
+}
+
+// CreateGroup creates a group of the members, returning its id.
+func (k groupKeeper) CreateGroup(ctx context.Context, members []sdk.AccAddress) (uint64, error) {
+	// the id of a group starts from 1
+	groupID, err := k.nextGroup.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	groupID++
+
+	for _, member := range members {
+		if err := k.members.Set(ctx, collections.Join(groupID, member)); err != nil {
+			return 0, err
+		}
+
+		if err := k.groups.Set(ctx, collections.Join(member, groupID)); err != nil {
+			return 0, err
+		}
+	}
+
+	return groupID, nil
+}
+
+func (k groupKeeper) GroupInfo(ctx context.Context, req *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error) {
+	next, err := k.nextGroup.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GroupId == 0 || req.GroupId > next {
+		return nil, sdkerrors.ErrNotFound.Wrapf("group %d", req.GroupId)
+	}
+
+	return &group.QueryGroupInfoResponse{
+		Info: &group.GroupInfo{
+			Id: req.GroupId,
+		},
+	}, nil
+}
+
+func (k groupKeeper) GroupsByMember(ctx context.Context, req *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error) {
+	member, err := k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// a tiny page size exercises the pagination of the callers
+	pagination := &query.PageRequest{Limit: 1}
+	if req.Pagination != nil {
+		pagination.Key = req.Pagination.Key
+	}
+
+	groups, pageRes, err := query.CollectionPaginate(ctx, k.groups, pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (*group.GroupInfo, error) {
+		return &group.GroupInfo{
+			Id: key.K2(),
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](member))
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupsByMemberResponse{
+		Groups:     groups,
+		Pagination: pageRes,
+	}, nil
+}
@@ -39,7 +39,25 @@ func (s queryServer) Params(ctx context.Context, req *escrowv1alpha1.QueryParams
 	}
 
 	return &escrowv1alpha1.QueryParamsResponse{
-		MaxMetadataLength: params.MaxMetadataLength,
+		MaxMetadataLength:   params.MaxMetadataLength,
+		ExecFeeFlat:         params.ExecFeeFlat,
+		ExecFeeBps:          params.ExecFeeBps,
+		MinProposalDeposit:  params.MinProposalDeposit,
+		BurnExpiredDeposits: params.BurnExpiredDeposits,
+		MaxPreActions:       params.MaxPreActions,
+		MaxPostActions:      params.MaxPostActions,
+		MaxActions:          params.MaxActions,
+		MaxAgentsPerExec:    params.MaxAgentsPerExec,
+		MaxActionSize:       params.MaxActionSize,
+		AllowedMessageTypes: params.AllowedMessageTypes,
+		DeniedMessageTypes:  params.DeniedMessageTypes,
+		MaxNestingDepth:     params.MaxNestingDepth,
+		GasPerAgent:         params.GasPerAgent,
+		GasPerAction:        params.GasPerAction,
+		GasPerActionByte:    params.GasPerActionByte,
+		MaxPrunesPerBlock:   params.MaxPrunesPerBlock,
+		MaxRefundActions:    params.MaxRefundActions,
+		MaxSimulateGas:      params.MaxSimulateGas,
 	}, nil
 }
 
@@ -165,19 +183,13 @@ func (s queryServer) Proposal(ctx context.Context, req *escrowv1alpha1.QueryProp
 		return nil, err
 	}
 
-	proposerStr, err := s.keeper.addressBytesToString(proposal.Proposer)
+	res, err := s.keeper.proposalToResponse(agent, *proposal)
 	if err != nil {
-		return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "proposer")
+		return nil, err
 	}
 
 	return &escrowv1alpha1.QueryProposalResponse{
-		Proposal: &escrowv1alpha1.QueryProposalResponse_Proposal{
-			Agent:       req.Agent,
-			Proposer:    proposerStr,
-			PreActions:  proposal.PreActions,
-			PostActions: proposal.PostActions,
-			Metadata:    proposal.Metadata,
-		},
+		Proposal: res,
 	}, nil
 }
 
@@ -203,18 +215,12 @@ func (s queryServer) ProposalsByProposer(ctx context.Context, req *escrowv1alpha
 		}
 		s.keeper.fixActions(&proposal)
 
-		agentStr, err := s.keeper.addressBytesToString(agent)
+		res, err := s.keeper.proposalToResponse(agent, proposal)
 		if err != nil {
-			return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "agent")
+			return nil, err
 		}
 
-		return &escrowv1alpha1.QueryProposalsByProposerResponse_Proposal{
-			Agent:       agentStr,
-			Proposer:    req.Proposer,
-			PreActions:  proposal.PreActions,
-			PostActions: proposal.PostActions,
-			Metadata:    proposal.Metadata,
-		}, nil
+		return (*escrowv1alpha1.QueryProposalsByProposerResponse_Proposal)(res), nil
 	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](proposer))
 	if err != nil {
 		return nil, err
@@ -226,6 +232,116 @@ func (s queryServer) ProposalsByProposer(ctx context.Context, req *escrowv1alpha
 	}, nil
 }
 
+func (s queryServer) ProposalsByMessageType(ctx context.Context, req *escrowv1alpha1.QueryProposalsByMessageTypeRequest) (*escrowv1alpha1.QueryProposalsByMessageTypeResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	if req.MessageType == "" {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil message_type")
+	}
+
+	switch req.Phase {
+	case anyPhase, "pre_actions", "post_actions":
+	default:
+		return nil, errors.Wrap(escrowv1alpha1.ErrInvalidMessage.Wrapf("unknown phase %s", req.Phase), "phase")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, s.keeper.proposals.Indexes.messageType, req.Pagination, func(key collections.Pair[collections.Pair[string, string], sdk.AccAddress], _ collections.NoValue) (*escrowv1alpha1.QueryProposalsByMessageTypeResponse_Proposal, error) {
+		agent := key.K2()
+		proposal, err := s.keeper.proposals.Get(ctx, agent)
+		if err != nil {
+			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+		}
+		s.keeper.fixActions(&proposal)
+
+		res, err := s.keeper.proposalToResponse(agent, proposal)
+		if err != nil {
+			return nil, err
+		}
+
+		return (*escrowv1alpha1.QueryProposalsByMessageTypeResponse_Proposal)(res), nil
+	}, query.WithCollectionPaginationPairPrefix[collections.Pair[string, string], sdk.AccAddress](collections.Join(req.MessageType, req.Phase)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowv1alpha1.QueryProposalsByMessageTypeResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (s queryServer) ProposalsByOfferedAsset(ctx context.Context, req *escrowv1alpha1.QueryProposalsByOfferedAssetRequest) (*escrowv1alpha1.QueryProposalsByOfferedAssetResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	asset, err := assetRefKey(req.Denom, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, s.keeper.proposals.Indexes.offeredAsset, req.Pagination, func(key collections.Pair[collections.Pair[string, string], sdk.AccAddress], _ collections.NoValue) (*escrowv1alpha1.QueryProposalsByOfferedAssetResponse_Proposal, error) {
+		agent := key.K2()
+		proposal, err := s.keeper.proposals.Get(ctx, agent)
+		if err != nil {
+			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+		}
+		s.keeper.fixActions(&proposal)
+
+		res, err := s.keeper.proposalToResponse(agent, proposal)
+		if err != nil {
+			return nil, err
+		}
+
+		return (*escrowv1alpha1.QueryProposalsByOfferedAssetResponse_Proposal)(res), nil
+	}, query.WithCollectionPaginationPairPrefix[collections.Pair[string, string], sdk.AccAddress](asset))
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowv1alpha1.QueryProposalsByOfferedAssetResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (s queryServer) ProposalsByAskedAsset(ctx context.Context, req *escrowv1alpha1.QueryProposalsByAskedAssetRequest) (*escrowv1alpha1.QueryProposalsByAskedAssetResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	asset, err := assetRefKey(req.Denom, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, s.keeper.proposals.Indexes.askedAsset, req.Pagination, func(key collections.Pair[collections.Pair[string, string], sdk.AccAddress], _ collections.NoValue) (*escrowv1alpha1.QueryProposalsByAskedAssetResponse_Proposal, error) {
+		agent := key.K2()
+		proposal, err := s.keeper.proposals.Get(ctx, agent)
+		if err != nil {
+			return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+		}
+		s.keeper.fixActions(&proposal)
+
+		res, err := s.keeper.proposalToResponse(agent, proposal)
+		if err != nil {
+			return nil, err
+		}
+
+		return (*escrowv1alpha1.QueryProposalsByAskedAssetResponse_Proposal)(res), nil
+	}, query.WithCollectionPaginationPairPrefix[collections.Pair[string, string], sdk.AccAddress](asset))
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowv1alpha1.QueryProposalsByAskedAssetResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (s queryServer) Proposals(ctx context.Context, req *escrowv1alpha1.QueryProposalsRequest) (*escrowv1alpha1.QueryProposalsResponse, error) {
 	if req == nil {
 		return nil, errNilRequest
@@ -236,23 +352,12 @@ func (s queryServer) Proposals(ctx context.Context, req *escrowv1alpha1.QueryPro
 		proposal := value
 		s.keeper.fixActions(&proposal)
 
-		agentStr, err := s.keeper.addressBytesToString(agent)
+		res, err := s.keeper.proposalToResponse(agent, proposal)
 		if err != nil {
-			return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "agent")
+			return nil, err
 		}
 
-		proposerStr, err := s.keeper.addressBytesToString(proposal.Proposer)
-		if err != nil {
-			return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "proposer")
-		}
-
-		return &escrowv1alpha1.QueryProposalsResponse_Proposal{
-			Agent:       agentStr,
-			Proposer:    proposerStr,
-			PreActions:  proposal.PreActions,
-			PostActions: proposal.PostActions,
-			Metadata:    proposal.Metadata,
-		}, nil
+		return (*escrowv1alpha1.QueryProposalsResponse_Proposal)(res), nil
 	})
 	if err != nil {
 		return nil, err
@@ -263,3 +368,153 @@ func (s queryServer) Proposals(ctx context.Context, req *escrowv1alpha1.QueryPro
 		Pagination: pageRes,
 	}, nil
 }
+
+func (s queryServer) SimulateExec(ctx context.Context, req *escrowv1alpha1.QuerySimulateExecRequest) (*escrowv1alpha1.QuerySimulateExecResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	params, err := s.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUsed, events, err := simulate(ctx, params.MaxSimulateGas, func(ctx context.Context) error {
+		_, err := s.keeper.HandleExec(ctx, &escrowv1alpha1.MsgExec{
+			Executor:      req.Executor,
+			Agents:        req.Agents,
+			Actions:       req.Actions,
+			Quantities:    req.Quantities,
+			DeclaredValue: req.DeclaredValue,
+		})
+		return err
+	})
+
+	res := &escrowv1alpha1.QuerySimulateExecResponse{
+		Success: err == nil,
+		GasUsed: gasUsed,
+	}
+
+	if err != nil {
+		res.Codespace, res.Code, _ = errors.ABCIInfo(err, false)
+		res.Error = err.Error()
+		res.FailedPhase, res.ActionFailed, res.FailedActionIndex = failedAction(err)
+		res.AgentFailed, res.FailedAgentIndex = failedAgent(err)
+
+		return res, nil
+	}
+
+	for _, event := range events {
+		attributes := make([]*escrowv1alpha1.QuerySimulateExecResponse_Event_Attribute, len(event.Attributes))
+		for i, attribute := range event.Attributes {
+			attributes[i] = &escrowv1alpha1.QuerySimulateExecResponse_Event_Attribute{
+				Key:   attribute.Key,
+				Value: attribute.Value,
+			}
+		}
+
+		res.Events = append(res.Events, &escrowv1alpha1.QuerySimulateExecResponse_Event{
+			EventType:  event.Type,
+			Attributes: attributes,
+		})
+	}
+
+	return res, nil
+}
+
+func (s queryServer) SimulateSubmit(ctx context.Context, req *escrowv1alpha1.QuerySimulateSubmitRequest) (*escrowv1alpha1.QuerySimulateSubmitResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	params, err := s.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUsed, events, err := simulate(ctx, params.MaxSimulateGas, func(ctx context.Context) error {
+		_, err := s.keeper.HandleSubmitProposal(ctx, &escrowv1alpha1.MsgSubmitProposal{
+			Proposer:        req.Proposer,
+			Agent:           req.Agent,
+			PreActions:      req.PreActions,
+			PostActions:     req.PostActions,
+			Metadata:        req.Metadata,
+			RefundActions:   req.RefundActions,
+			ExpireHeight:    req.ExpireHeight,
+			ExpireTime:      req.ExpireTime,
+			Quantity:        req.Quantity,
+			Executors:       req.Executors,
+			ExecutorGroupId: req.ExecutorGroupId,
+			MaxExecGas:      req.MaxExecGas,
+		})
+		return err
+	})
+
+	res := &escrowv1alpha1.QuerySimulateSubmitResponse{
+		Success: err == nil,
+		GasUsed: gasUsed,
+	}
+
+	if err != nil {
+		res.Codespace, res.Code, _ = errors.ABCIInfo(err, false)
+		res.Error = err.Error()
+		res.FailedPhase, res.ActionFailed, res.FailedActionIndex = failedAction(err)
+
+		return res, nil
+	}
+
+	for _, event := range events {
+		attributes := make([]*escrowv1alpha1.QuerySimulateSubmitResponse_Event_Attribute, len(event.Attributes))
+		for i, attribute := range event.Attributes {
+			attributes[i] = &escrowv1alpha1.QuerySimulateSubmitResponse_Event_Attribute{
+				Key:   attribute.Key,
+				Value: attribute.Value,
+			}
+		}
+
+		res.Events = append(res.Events, &escrowv1alpha1.QuerySimulateSubmitResponse_Event{
+			EventType:  event.Type,
+			Attributes: attributes,
+		})
+	}
+
+	return res, nil
+}
+
+// proposalToResponse converts the proposal of the agent into the form of the
+// query responses, which the responses of the other queries share.
+func (k Keeper) proposalToResponse(agent sdk.AccAddress, proposal escrowv1alpha1.Proposal) (*escrowv1alpha1.QueryProposalResponse_Proposal, error) {
+	agentStr, err := k.addressBytesToString(agent)
+	if err != nil {
+		return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "agent")
+	}
+
+	proposerStr, err := k.addressBytesToString(proposal.Proposer)
+	if err != nil {
+		return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "proposer")
+	}
+
+	executorsStr, err := k.executorsBytesToString(proposal.Executors)
+	if err != nil {
+		return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "executors")
+	}
+
+	return &escrowv1alpha1.QueryProposalResponse_Proposal{
+		Agent:           agentStr,
+		Proposer:        proposerStr,
+		PreActions:      proposal.PreActions,
+		PostActions:     proposal.PostActions,
+		Metadata:        proposal.Metadata,
+		RefundActions:   proposal.RefundActions,
+		ExpireHeight:    proposal.ExpireHeight,
+		ExpireTime:      proposal.ExpireTime,
+		Quantity:        proposal.Quantity,
+		Executors:       executorsStr,
+		ExecutorGroupId: proposal.ExecutorGroupId,
+		Deposit:         proposal.Deposit,
+		MaxExecGas:      proposal.MaxExecGas,
+		SubmitHeight:    proposal.SubmitHeight,
+		SubmitTime:      proposal.SubmitTime,
+		RefundFailed:    proposal.RefundFailed,
+	}, nil
+}
@@ -1,8 +1,17 @@
 package internal_test
 
 import (
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
 	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
 )
 
 func (s *KeeperTestSuite) TestQueryParams() {
@@ -158,6 +167,7 @@ func (s *KeeperTestSuite) TestQueryProposal() {
 		s.Require().NotNil(res.Proposal.PreActions)
 		s.Require().NotNil(res.Proposal.PostActions)
 		s.Require().NotEmpty(res.Proposal.Metadata)
+		s.Require().NotNil(res.Proposal.RefundActions)
 
 		return nil
 	}
@@ -212,6 +222,7 @@ func (s *KeeperTestSuite) TestQueryProposalsByProposer() {
 			s.Require().NotNil(proposal.PreActions, i)
 			s.Require().NotNil(proposal.PostActions, i)
 			s.Require().NotEmpty(proposal.Metadata, i)
+			s.Require().NotNil(proposal.RefundActions, i)
 		}
 		return nil
 	}
@@ -241,6 +252,285 @@ func (s *KeeperTestSuite) TestQueryProposalsByProposer() {
 	testutil.DoTest(s.T(), tester, cases)
 }
 
+func (s *KeeperTestSuite) TestQueryProposalsByMessageType() {
+	type proposalsByMessageType struct {
+		messageType string
+		phase       string
+		updated     bool
+	}
+
+	msgSend := sdk.MsgTypeURL(&testv1alpha1.MsgSend{})
+	msgCreate := sdk.MsgTypeURL(&testv1alpha1.MsgCreate{})
+
+	tester := func(subject proposalsByMessageType) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		// replace the post_actions of a proposal
+		if subject.updated {
+			_, _, err := s.keeper.UpdateProposal(ctx, s.seller, s.agentAny, nil,
+				s.encodeMsgs([]sdk.Msg{
+					&testv1alpha1.MsgCreate{
+						Creator: s.addressBytesToString(s.agentAny),
+						Asset:   "lizard",
+					},
+				}),
+				"",
+				nil,
+			)
+			s.Require().NoError(err)
+		}
+
+		res, err := s.queryServer.ProposalsByMessageType(ctx, &escrowv1alpha1.QueryProposalsByMessageTypeRequest{
+			MessageType: subject.messageType,
+			Phase:       subject.phase,
+		})
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+
+		numProposals := 0
+		switch {
+		case subject.messageType == msgSend:
+			numProposals = 2
+			if subject.updated && subject.phase == "post_actions" {
+				numProposals = 1
+			}
+		case subject.messageType == msgCreate && subject.updated && subject.phase != "pre_actions":
+			numProposals = 1
+		}
+		s.Require().Len(res.Proposals, numProposals)
+
+		for i, proposal := range res.Proposals {
+			s.Require().NotNil(proposal, i)
+
+			s.Require().NotNil(proposal.Agent, i)
+			s.Require().NotNil(proposal.Proposer, i)
+			s.Require().NotNil(proposal.PreActions, i)
+			s.Require().NotNil(proposal.PostActions, i)
+			s.Require().NotEmpty(proposal.Metadata, i)
+			s.Require().NotNil(proposal.RefundActions, i)
+		}
+		return nil
+	}
+	cases := []map[string]testutil.Case[proposalsByMessageType]{
+		{
+			"nil message_type": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"message_type in the proposals": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.messageType = msgSend
+				},
+			},
+			"message_type not in the proposals": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.messageType = msgCreate
+				},
+			},
+		},
+		{
+			"either phase": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.phase = ""
+				},
+			},
+			"pre_actions": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.phase = "pre_actions"
+				},
+			},
+			"post_actions": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.phase = "post_actions"
+				},
+			},
+			"unknown phase": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.phase = "refund_actions"
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"submitted proposals": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.updated = false
+				},
+			},
+			"updated proposal": {
+				Malleate: func(subject *proposalsByMessageType) {
+					subject.updated = true
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+// submitAssetProposal submits a proposal offering stake for usdc and a cat nft.
+func (s *KeeperTestSuite) submitAssetProposal(ctx sdk.Context) {
+	err := s.bank.Mint(ctx, s.seller, sdk.NewCoins(sdk.NewInt64Coin("stake", 42)))
+	s.Require().NoError(err)
+
+	_, _, err = s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+		s.encodeMsgs([]sdk.Msg{
+			&banktypes.MsgSend{
+				FromAddress: s.addressBytesToString(s.seller),
+				ToAddress:   s.addressBytesToString(s.agentIdle),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 42)),
+			},
+		}),
+		s.encodeMsgs([]sdk.Msg{
+			&banktypes.MsgSend{
+				FromAddress: s.addressBytesToString(s.agentIdle),
+				ToAddress:   s.addressBytesToString(s.seller),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("usdc", 42)),
+			},
+			&nft.MsgSend{
+				ClassId:  "cat",
+				Id:       "leopardcat",
+				Sender:   s.addressBytesToString(s.agentIdle),
+				Receiver: s.addressBytesToString(s.seller),
+			},
+		}),
+		"sell stake for usdc and a cat",
+		s.encodeMsgs([]sdk.Msg{
+			&banktypes.MsgSend{
+				FromAddress: s.addressBytesToString(s.agentIdle),
+				ToAddress:   s.addressBytesToString(s.seller),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 42)),
+			},
+		}),
+		0,
+		nil,
+		0,
+		nil,
+		0,
+		0,
+	)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestQueryProposalsByOfferedAsset() {
+	tester := func(subject escrowv1alpha1.QueryProposalsByOfferedAssetRequest) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		s.submitAssetProposal(ctx)
+
+		res, err := s.queryServer.ProposalsByOfferedAsset(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+
+		if subject.Denom == "stake" {
+			s.Require().Len(res.Proposals, 1)
+			s.Require().Equal(s.addressBytesToString(s.agentIdle), res.Proposals[0].Agent)
+		} else {
+			s.Require().Empty(res.Proposals)
+		}
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.QueryProposalsByOfferedAssetRequest]{
+		{
+			"nil asset": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"offered denom": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByOfferedAssetRequest) {
+					subject.Denom = "stake"
+				},
+			},
+			"asked denom": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByOfferedAssetRequest) {
+					subject.Denom = "usdc"
+				},
+			},
+			"asked class_id": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByOfferedAssetRequest) {
+					subject.ClassId = "cat"
+				},
+			},
+			"both of denom and class_id": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByOfferedAssetRequest) {
+					subject.Denom = "stake"
+					subject.ClassId = "cat"
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestQueryProposalsByAskedAsset() {
+	tester := func(subject escrowv1alpha1.QueryProposalsByAskedAssetRequest) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		s.submitAssetProposal(ctx)
+
+		res, err := s.queryServer.ProposalsByAskedAsset(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+
+		if subject.Denom == "usdc" || subject.ClassId == "cat" {
+			s.Require().Len(res.Proposals, 1)
+			s.Require().Equal(s.addressBytesToString(s.agentIdle), res.Proposals[0].Agent)
+		} else {
+			s.Require().Empty(res.Proposals)
+		}
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.QueryProposalsByAskedAssetRequest]{
+		{
+			"nil asset": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"offered denom": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByAskedAssetRequest) {
+					subject.Denom = "stake"
+				},
+			},
+			"asked denom": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByAskedAssetRequest) {
+					subject.Denom = "usdc"
+				},
+			},
+			"asked class_id": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByAskedAssetRequest) {
+					subject.ClassId = "cat"
+				},
+			},
+			"both of denom and class_id": {
+				Malleate: func(subject *escrowv1alpha1.QueryProposalsByAskedAssetRequest) {
+					subject.Denom = "usdc"
+					subject.ClassId = "cat"
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
 func (s *KeeperTestSuite) TestQueryProposals() {
 	tester := func(subject escrowv1alpha1.QueryProposalsRequest) error {
 		res, err := s.queryServer.Proposals(s.ctx, &subject)
@@ -258,6 +548,7 @@ func (s *KeeperTestSuite) TestQueryProposals() {
 			s.Require().NotNil(proposal.PreActions, i)
 			s.Require().NotNil(proposal.PostActions, i)
 			s.Require().NotEmpty(proposal.Metadata, i)
+			s.Require().NotNil(proposal.RefundActions, i)
 		}
 		return nil
 	}
@@ -269,3 +560,416 @@ func (s *KeeperTestSuite) TestQueryProposals() {
 
 	testutil.DoTest(s.T(), tester, cases)
 }
+
+func (s *KeeperTestSuite) TestQuerySimulateExec() {
+	type simulateExec struct {
+		actions           []sdk.Msg
+		failedPhase       string
+		actionFailed      bool
+		failedActionIndex uint64
+		agentFailed       bool
+		failedAgentIndex  uint64
+	}
+
+	tester := func(subject simulateExec) error {
+		res, err := s.queryServer.SimulateExec(s.ctx, &escrowv1alpha1.QuerySimulateExecRequest{
+			Executor: s.addressBytesToString(s.stranger),
+			Agents: []string{
+				s.addressBytesToString(s.agentAny),
+			},
+			Actions: s.encodeMsgs(subject.actions),
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(res)
+
+		// the simulation must not affect the state
+		_, err = s.keeper.GetProposal(s.ctx, s.agentAny)
+		s.Require().NoError(err)
+
+		s.Require().NotZero(res.GasUsed)
+		s.Require().Equal(subject.failedPhase, res.FailedPhase)
+		s.Require().Equal(subject.actionFailed, res.ActionFailed)
+		s.Require().Equal(subject.failedActionIndex, res.FailedActionIndex)
+		s.Require().Equal(subject.agentFailed, res.AgentFailed)
+		s.Require().Equal(subject.failedAgentIndex, res.FailedAgentIndex)
+
+		if !res.Success {
+			s.Require().Empty(res.Events)
+			return errors.ABCIError(res.Codespace, res.Code, res.Error)
+		}
+		s.Require().NotEmpty(res.Events)
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[simulateExec]{
+		{
+			"valid actions": {
+				Malleate: func(subject *simulateExec) {
+					subject.actions = []sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.stranger),
+							Recipient: s.addressBytesToString(s.agentAny),
+							Asset:     "voucher",
+						},
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentAny),
+							Recipient: s.addressBytesToString(s.stranger),
+							Asset:     "dog",
+						},
+					}
+				},
+			},
+			"actions of wrong signer": {
+				Malleate: func(subject *simulateExec) {
+					subject.actions = []sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.seller),
+							Recipient: s.addressBytesToString(s.agentAny),
+							Asset:     "voucher",
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+			"actions failing": {
+				Malleate: func(subject *simulateExec) {
+					subject.actions = []sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.stranger),
+							Recipient: s.addressBytesToString(s.agentAny),
+							Asset:     "voucher",
+						},
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentAny),
+							Recipient: s.addressBytesToString(s.stranger),
+							Asset:     "whale",
+						},
+					}
+					subject.failedPhase = "actions"
+					subject.actionFailed = true
+					subject.failedActionIndex = 1
+				},
+				Error: func() error {
+					return testv1alpha1.ErrAssetNotFound
+				},
+			},
+			"post_actions failing": {
+				Malleate: func(subject *simulateExec) {
+					subject.actions = []sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentAny),
+							Recipient: s.addressBytesToString(s.stranger),
+							Asset:     "dog",
+						},
+					}
+					subject.failedPhase = "post_actions"
+					subject.actionFailed = true
+					subject.failedActionIndex = 0
+					subject.agentFailed = true
+					subject.failedAgentIndex = 0
+				},
+				Error: func() error {
+					return testv1alpha1.ErrAssetNotFound
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestQuerySimulateExecFailedAgent() {
+	type simulateExecFailedAgent struct {
+		agents           []sdk.AccAddress
+		failedAgentIndex uint64
+	}
+
+	tester := func(subject simulateExecFailedAgent) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		_, _, err := s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			"sell a snake for a voucher",
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+			0,
+			nil,
+			0,
+			nil,
+			0,
+			0,
+		)
+		s.Require().NoError(err)
+
+		agents := make([]string, len(subject.agents))
+		for i, agent := range subject.agents {
+			agents[i] = s.addressBytesToString(agent)
+		}
+
+		// the only voucher of the executor goes to agentAny, so the
+		// post_actions of agentIdle fail wherever it is in the request
+		res, err := s.queryServer.SimulateExec(ctx, &escrowv1alpha1.QuerySimulateExecRequest{
+			Executor: s.addressBytesToString(s.stranger),
+			Agents:   agents,
+			Actions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.stranger),
+					Recipient: s.addressBytesToString(s.agentAny),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentAny),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "dog",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "snake",
+				},
+			}),
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(res)
+
+		s.Require().False(res.Success)
+		s.Require().Equal("post_actions", res.FailedPhase)
+		s.Require().True(res.ActionFailed)
+		s.Require().Equal(uint64(0), res.FailedActionIndex)
+		s.Require().True(res.AgentFailed)
+		s.Require().Equal(subject.failedAgentIndex, res.FailedAgentIndex)
+
+		return errors.ABCIError(res.Codespace, res.Code, res.Error)
+	}
+
+	cases := []map[string]testutil.Case[simulateExecFailedAgent]{
+		{
+			"first agent failing": {
+				Malleate: func(subject *simulateExecFailedAgent) {
+					subject.agents = []sdk.AccAddress{s.agentIdle, s.agentAny}
+					subject.failedAgentIndex = 0
+				},
+				Error: func() error {
+					return testv1alpha1.ErrAssetNotFound
+				},
+			},
+			"second agent failing": {
+				Malleate: func(subject *simulateExecFailedAgent) {
+					subject.agents = []sdk.AccAddress{s.agentAny, s.agentIdle}
+					subject.failedAgentIndex = 1
+				},
+				Error: func() error {
+					return testv1alpha1.ErrAssetNotFound
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestQuerySimulateGasLimit() {
+	type simulateGasLimit struct {
+		maxSimulateGas uint64
+		queryGasLimit  uint64
+	}
+
+	tester := func(subject simulateGasLimit) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.MaxSimulateGas = subject.maxSimulateGas
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		if subject.queryGasLimit != 0 {
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(subject.queryGasLimit))
+		}
+
+		res, err := s.queryServer.SimulateExec(ctx, &escrowv1alpha1.QuerySimulateExecRequest{
+			Executor: s.addressBytesToString(s.stranger),
+			Agents: []string{
+				s.addressBytesToString(s.agentAny),
+			},
+			Actions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.stranger),
+					Recipient: s.addressBytesToString(s.agentAny),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentAny),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "dog",
+				},
+			}),
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(res)
+
+		// the gas used is reported up to the limit
+		gasLimit := subject.maxSimulateGas
+		if subject.queryGasLimit != 0 && subject.queryGasLimit-ctx.GasMeter().GasConsumed() < gasLimit {
+			gasLimit = subject.queryGasLimit - ctx.GasMeter().GasConsumed()
+		}
+		s.Require().NotZero(res.GasUsed)
+		s.Require().LessOrEqual(res.GasUsed, gasLimit)
+
+		if !res.Success {
+			s.Require().Equal(gasLimit, res.GasUsed)
+			s.Require().Empty(res.Events)
+			return errors.ABCIError(res.Codespace, res.Code, res.Error)
+		}
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[simulateGasLimit]{
+		{
+			"enough max_simulate_gas": {
+				Malleate: func(subject *simulateGasLimit) {
+					subject.maxSimulateGas = 10000000
+				},
+			},
+			"low max_simulate_gas": {
+				Malleate: func(subject *simulateGasLimit) {
+					subject.maxSimulateGas = 1000
+				},
+				Error: func() error {
+					return sdkerrors.ErrOutOfGas
+				},
+			},
+		},
+		{
+			"no query gas limit": {},
+			"enough query gas limit": {
+				Malleate: func(subject *simulateGasLimit) {
+					subject.queryGasLimit = 100000000
+				},
+			},
+			"low query gas limit": {
+				Malleate: func(subject *simulateGasLimit) {
+					subject.queryGasLimit = 5000
+				},
+				Error: func() error {
+					return sdkerrors.ErrOutOfGas
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestQuerySimulateSubmit() {
+	type simulateSubmit struct {
+		metadata          string
+		preAsset          string
+		failedPhase       string
+		actionFailed      bool
+		failedActionIndex uint64
+	}
+
+	tester := func(subject simulateSubmit) error {
+		res, err := s.queryServer.SimulateSubmit(s.ctx, &escrowv1alpha1.QuerySimulateSubmitRequest{
+			Proposer: s.addressBytesToString(s.seller),
+			Agent:    s.addressBytesToString(s.agentIdle),
+			PreActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     subject.preAsset,
+				},
+			}),
+			PostActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			Metadata: subject.metadata,
+			RefundActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     subject.preAsset,
+				},
+			}),
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(res)
+
+		// the simulation must not affect the state
+		_, err = s.keeper.GetProposal(s.ctx, s.agentIdle)
+		s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+
+		s.Require().Equal(subject.failedPhase, res.FailedPhase)
+		s.Require().Equal(subject.actionFailed, res.ActionFailed)
+		s.Require().Equal(subject.failedActionIndex, res.FailedActionIndex)
+
+		if !res.Success {
+			s.Require().Empty(res.Events)
+			return errors.ABCIError(res.Codespace, res.Code, res.Error)
+		}
+		s.Require().NotZero(res.GasUsed)
+		s.Require().NotEmpty(res.Events)
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[simulateSubmit]{
+		{
+			"valid proposal": {
+				Malleate: func(subject *simulateSubmit) {
+					subject.metadata = "sell a snake for a voucher"
+					subject.preAsset = "snake"
+				},
+			},
+			"nil metadata": {
+				Malleate: func(subject *simulateSubmit) {
+					subject.preAsset = "snake"
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"pre_actions failing": {
+				Malleate: func(subject *simulateSubmit) {
+					subject.metadata = "sell a snake for a voucher"
+					subject.preAsset = "whale"
+					subject.failedPhase = "pre_actions"
+					subject.actionFailed = true
+					subject.failedActionIndex = 0
+				},
+				Error: func() error {
+					return testv1alpha1.ErrAssetNotFound
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
@@ -0,0 +1,292 @@
+package internal
+
+import (
+	"context"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	escrowv1beta1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1beta1"
+)
+
+// queryServerV1Beta1 serves v1beta1 by converting its requests and responses
+// from and to those of v1alpha1.
+// Note: the conversions between the messages of the same shape rely on Go
+// struct conversion, which fails to compile if the shapes diverge.
+type queryServerV1Beta1 struct {
+	server escrowv1alpha1.QueryServer
+}
+
+var _ escrowv1beta1.QueryServer = (*queryServerV1Beta1)(nil)
+
+// NewQueryServerV1Beta1 returns an implementation of the v1beta1 QueryServer
+// interface for the provided Keeper.
+func NewQueryServerV1Beta1(keeper Keeper) escrowv1beta1.QueryServer {
+	return &queryServerV1Beta1{
+		server: NewQueryServer(keeper),
+	}
+}
+
+func (s queryServerV1Beta1) Params(ctx context.Context, req *escrowv1beta1.QueryParamsRequest) (*escrowv1beta1.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.Params(ctx, &escrowv1alpha1.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowv1beta1.QueryParamsResponse{
+		Params: escrowv1beta1.Params(*res),
+	}, nil
+}
+
+func (s queryServerV1Beta1) Agent(ctx context.Context, req *escrowv1beta1.QueryAgentRequest) (*escrowv1beta1.QueryAgentResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.Agent(ctx, (*escrowv1alpha1.QueryAgentRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowv1beta1.QueryAgentResponse{
+		Agent: (*escrowv1beta1.Agent)(res.Agent),
+	}, nil
+}
+
+func (s queryServerV1Beta1) AgentsByCreator(ctx context.Context, req *escrowv1beta1.QueryAgentsByCreatorRequest) (*escrowv1beta1.QueryAgentsByCreatorResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.AgentsByCreator(ctx, (*escrowv1alpha1.QueryAgentsByCreatorRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	agents := make([]*escrowv1beta1.Agent, len(res.Agents))
+	for i, agent := range res.Agents {
+		agents[i] = (*escrowv1beta1.Agent)(agent)
+	}
+
+	return &escrowv1beta1.QueryAgentsByCreatorResponse{
+		Agents:     agents,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (s queryServerV1Beta1) Agents(ctx context.Context, req *escrowv1beta1.QueryAgentsRequest) (*escrowv1beta1.QueryAgentsResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.Agents(ctx, (*escrowv1alpha1.QueryAgentsRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	agents := make([]*escrowv1beta1.Agent, len(res.Agents))
+	for i, agent := range res.Agents {
+		agents[i] = (*escrowv1beta1.Agent)(agent)
+	}
+
+	return &escrowv1beta1.QueryAgentsResponse{
+		Agents:     agents,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (s queryServerV1Beta1) Proposal(ctx context.Context, req *escrowv1beta1.QueryProposalRequest) (*escrowv1beta1.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.Proposal(ctx, (*escrowv1alpha1.QueryProposalRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowv1beta1.QueryProposalResponse{
+		Proposal: (*escrowv1beta1.Proposal)(res.Proposal),
+	}, nil
+}
+
+func (s queryServerV1Beta1) ProposalsByProposer(ctx context.Context, req *escrowv1beta1.QueryProposalsByProposerRequest) (*escrowv1beta1.QueryProposalsByProposerResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.ProposalsByProposer(ctx, (*escrowv1alpha1.QueryProposalsByProposerRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*escrowv1beta1.Proposal, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		proposals[i] = (*escrowv1beta1.Proposal)(proposal)
+	}
+
+	return &escrowv1beta1.QueryProposalsByProposerResponse{
+		Proposals:  proposals,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (s queryServerV1Beta1) ProposalsByMessageType(ctx context.Context, req *escrowv1beta1.QueryProposalsByMessageTypeRequest) (*escrowv1beta1.QueryProposalsByMessageTypeResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.ProposalsByMessageType(ctx, (*escrowv1alpha1.QueryProposalsByMessageTypeRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*escrowv1beta1.Proposal, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		proposals[i] = (*escrowv1beta1.Proposal)(proposal)
+	}
+
+	return &escrowv1beta1.QueryProposalsByMessageTypeResponse{
+		Proposals:  proposals,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (s queryServerV1Beta1) ProposalsByOfferedAsset(ctx context.Context, req *escrowv1beta1.QueryProposalsByOfferedAssetRequest) (*escrowv1beta1.QueryProposalsByOfferedAssetResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.ProposalsByOfferedAsset(ctx, (*escrowv1alpha1.QueryProposalsByOfferedAssetRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*escrowv1beta1.Proposal, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		proposals[i] = (*escrowv1beta1.Proposal)(proposal)
+	}
+
+	return &escrowv1beta1.QueryProposalsByOfferedAssetResponse{
+		Proposals:  proposals,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (s queryServerV1Beta1) ProposalsByAskedAsset(ctx context.Context, req *escrowv1beta1.QueryProposalsByAskedAssetRequest) (*escrowv1beta1.QueryProposalsByAskedAssetResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.ProposalsByAskedAsset(ctx, (*escrowv1alpha1.QueryProposalsByAskedAssetRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*escrowv1beta1.Proposal, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		proposals[i] = (*escrowv1beta1.Proposal)(proposal)
+	}
+
+	return &escrowv1beta1.QueryProposalsByAskedAssetResponse{
+		Proposals:  proposals,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (s queryServerV1Beta1) Proposals(ctx context.Context, req *escrowv1beta1.QueryProposalsRequest) (*escrowv1beta1.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.Proposals(ctx, (*escrowv1alpha1.QueryProposalsRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*escrowv1beta1.Proposal, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		proposals[i] = (*escrowv1beta1.Proposal)(proposal)
+	}
+
+	return &escrowv1beta1.QueryProposalsResponse{
+		Proposals:  proposals,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+func (s queryServerV1Beta1) SimulateExec(ctx context.Context, req *escrowv1beta1.QuerySimulateExecRequest) (*escrowv1beta1.QuerySimulateExecResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.SimulateExec(ctx, (*escrowv1alpha1.QuerySimulateExecRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*escrowv1beta1.Event, len(res.Events))
+	for i, event := range res.Events {
+		attributes := make([]*escrowv1beta1.Event_Attribute, len(event.Attributes))
+		for j, attribute := range event.Attributes {
+			attributes[j] = (*escrowv1beta1.Event_Attribute)(attribute)
+		}
+
+		events[i] = &escrowv1beta1.Event{
+			EventType:  event.EventType,
+			Attributes: attributes,
+		}
+	}
+
+	return &escrowv1beta1.QuerySimulateExecResponse{
+		Success:           res.Success,
+		GasUsed:           res.GasUsed,
+		Events:            events,
+		Error:             res.Error,
+		Codespace:         res.Codespace,
+		Code:              res.Code,
+		FailedPhase:       res.FailedPhase,
+		ActionFailed:      res.ActionFailed,
+		FailedActionIndex: res.FailedActionIndex,
+		AgentFailed:       res.AgentFailed,
+		FailedAgentIndex:  res.FailedAgentIndex,
+	}, nil
+}
+
+func (s queryServerV1Beta1) SimulateSubmit(ctx context.Context, req *escrowv1beta1.QuerySimulateSubmitRequest) (*escrowv1beta1.QuerySimulateSubmitResponse, error) {
+	if req == nil {
+		return nil, errNilRequest
+	}
+
+	res, err := s.server.SimulateSubmit(ctx, (*escrowv1alpha1.QuerySimulateSubmitRequest)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*escrowv1beta1.Event, len(res.Events))
+	for i, event := range res.Events {
+		attributes := make([]*escrowv1beta1.Event_Attribute, len(event.Attributes))
+		for j, attribute := range event.Attributes {
+			attributes[j] = (*escrowv1beta1.Event_Attribute)(attribute)
+		}
+
+		events[i] = &escrowv1beta1.Event{
+			EventType:  event.EventType,
+			Attributes: attributes,
+		}
+	}
+
+	return &escrowv1beta1.QuerySimulateSubmitResponse{
+		Success:           res.Success,
+		GasUsed:           res.GasUsed,
+		Events:            events,
+		Error:             res.Error,
+		Codespace:         res.Codespace,
+		Code:              res.Code,
+		FailedPhase:       res.FailedPhase,
+		ActionFailed:      res.ActionFailed,
+		FailedActionIndex: res.FailedActionIndex,
+	}, nil
+}
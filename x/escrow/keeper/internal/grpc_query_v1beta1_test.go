@@ -0,0 +1,132 @@
+package internal_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	escrowv1beta1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1beta1"
+	keeper "github.com/0tech/andromeda/x/escrow/keeper/internal"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestQueryParamsV1Beta1() {
+	queryServer := keeper.NewQueryServerV1Beta1(*s.keeper)
+
+	res, err := queryServer.Params(s.ctx, &escrowv1beta1.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(escrowv1beta1.Params(*params), res.Params)
+}
+
+func (s *KeeperTestSuite) TestQueryAgentV1Beta1() {
+	queryServer := keeper.NewQueryServerV1Beta1(*s.keeper)
+
+	tester := func(subject escrowv1beta1.QueryAgentRequest) error {
+		res, err := queryServer.Agent(s.ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+
+		s.Require().Equal(&escrowv1beta1.Agent{
+			Address: subject.Agent,
+			Creator: s.addressBytesToString(s.seller),
+		}, res.Agent)
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1beta1.QueryAgentRequest]{
+		{
+			"nil agent": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid agent": {
+				Malleate: func(subject *escrowv1beta1.QueryAgentRequest) {
+					subject.Agent = s.addressBytesToString(s.agentIdle)
+				},
+			},
+			"agent not found": {
+				Malleate: func(subject *escrowv1beta1.QueryAgentRequest) {
+					subject.Agent = s.addressBytesToString(createRandomAddress())
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrAgentNotFound
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestQueryProposalsV1Beta1() {
+	queryServer := keeper.NewQueryServerV1Beta1(*s.keeper)
+
+	expected, err := s.queryServer.Proposals(s.ctx, &escrowv1alpha1.QueryProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(expected.Proposals, 2)
+
+	res, err := queryServer.Proposals(s.ctx, &escrowv1beta1.QueryProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+
+	s.Require().Len(res.Proposals, len(expected.Proposals))
+	for i, proposal := range res.Proposals {
+		s.Require().Equal(expected.Proposals[i].Agent, proposal.Agent, i)
+		s.Require().Equal(expected.Proposals[i].Proposer, proposal.Proposer, i)
+		s.Require().Equal(expected.Proposals[i].Metadata, proposal.Metadata, i)
+		s.Require().Equal(expected.Proposals[i].ExpireTime, proposal.ExpireTime, i)
+		s.Require().Equal(expected.Proposals[i].SubmitHeight, proposal.SubmitHeight, i)
+	}
+	s.Require().Equal(expected.Pagination, res.Pagination)
+}
+
+func (s *KeeperTestSuite) TestQuerySimulateSubmitV1Beta1() {
+	queryServer := keeper.NewQueryServerV1Beta1(*s.keeper)
+
+	res, err := queryServer.SimulateSubmit(s.ctx, &escrowv1beta1.QuerySimulateSubmitRequest{
+		Proposer: s.addressBytesToString(s.seller),
+		Agent:    s.addressBytesToString(s.agentIdle),
+		PreActions: s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.seller),
+				Recipient: s.addressBytesToString(s.agentIdle),
+				Asset:     "snake",
+			},
+		}),
+		PostActions: s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "voucher",
+			},
+		}),
+		Metadata: "sell a snake for a voucher",
+		RefundActions: s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentIdle),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "snake",
+			},
+		}),
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+
+	s.Require().True(res.Success, res.Error)
+	s.Require().NotZero(res.GasUsed)
+	s.Require().NotEmpty(res.Events)
+	for i, event := range res.Events {
+		s.Require().NotEmpty(event.EventType, i)
+	}
+
+	// nothing has been committed
+	_, err = s.keeper.GetProposal(s.ctx, s.agentIdle)
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+}
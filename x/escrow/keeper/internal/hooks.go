@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/keeper/expected"
+)
+
+// hooksHolder is shared by the copies of the keeper, so the hooks set after
+// the construction are visible to the servers holding the keeper.
+type hooksHolder struct {
+	hooks expected.EscrowHooks
+}
+
+func (k Keeper) SetHooks(hooks expected.EscrowHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set escrow hooks twice")
+	}
+
+	k.hooks.hooks = hooks
+}
+
+func (k Keeper) afterAgentCreated(ctx context.Context, creator, agent sdk.AccAddress) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.hooks.AfterAgentCreated(ctx, creator, agent)
+}
+
+func (k Keeper) beforeProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.hooks.BeforeProposalSubmitted(ctx, agent, proposal)
+}
+
+func (k Keeper) afterProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.hooks.AfterProposalSubmitted(ctx, agent, proposal)
+}
+
+func (k Keeper) beforeExec(ctx context.Context, executor sdk.AccAddress, agents []sdk.AccAddress) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.hooks.BeforeExec(ctx, executor, agents)
+}
+
+func (k Keeper) afterProposalExecuted(ctx context.Context, executor, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal, quantity uint64) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.hooks.AfterProposalExecuted(ctx, executor, agent, proposal, quantity)
+}
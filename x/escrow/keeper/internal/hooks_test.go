@@ -0,0 +1,177 @@
+package internal_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/keeper/expected"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+var errVetoed = escrowv1alpha1.ErrPermissionDenied.Wrap("vetoed")
+
+// escrowHooks records the calls of the hooks, vetoing on demand.
+type escrowHooks struct {
+	vetoSubmit bool
+	vetoExec   bool
+
+	calls []string
+}
+
+var _ expected.EscrowHooks = (*escrowHooks)(nil)
+
+func (h *escrowHooks) AfterAgentCreated(ctx context.Context, creator, agent sdk.AccAddress) error {
+	h.calls = append(h.calls, "AfterAgentCreated")
+	return nil
+}
+
+func (h *escrowHooks) BeforeProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error {
+	h.calls = append(h.calls, "BeforeProposalSubmitted")
+	if h.vetoSubmit {
+		return errVetoed
+	}
+	return nil
+}
+
+func (h *escrowHooks) AfterProposalSubmitted(ctx context.Context, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal) error {
+	h.calls = append(h.calls, "AfterProposalSubmitted")
+	return nil
+}
+
+func (h *escrowHooks) BeforeExec(ctx context.Context, executor sdk.AccAddress, agents []sdk.AccAddress) error {
+	h.calls = append(h.calls, "BeforeExec")
+	if h.vetoExec {
+		return errVetoed
+	}
+	return nil
+}
+
+func (h *escrowHooks) AfterProposalExecuted(ctx context.Context, executor, agent sdk.AccAddress, proposal *escrowv1alpha1.Proposal, quantity uint64) error {
+	h.calls = append(h.calls, "AfterProposalExecuted")
+	return nil
+}
+
+func (s *KeeperTestSuite) TestHooks() {
+	hooks := &escrowHooks{}
+	s.keeper.SetHooks(hooks)
+	s.Require().Panics(func() {
+		s.keeper.SetHooks(hooks)
+	})
+
+	tester := func(subject escrowHooks) error {
+		hooks.vetoSubmit = subject.vetoSubmit
+		hooks.vetoExec = subject.vetoExec
+		hooks.calls = nil
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		agent, err := s.keeper.CreateAgent(ctx, s.seller)
+		s.Require().NoError(err)
+
+		_, _, err = s.keeper.SubmitProposal(ctx, s.seller, agent,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(agent),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(agent),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			"sell a snake for a voucher",
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(agent),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+			0,
+			nil,
+			0,
+			nil,
+			0,
+			0,
+		)
+		if err != nil {
+			s.Require().Equal([]string{
+				"AfterAgentCreated",
+				"BeforeProposalSubmitted",
+			}, hooks.calls)
+
+			return err
+		}
+
+		_, _, _, _, err = s.keeper.Exec(ctx, s.stranger, []sdk.AccAddress{agent},
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.stranger),
+					Recipient: s.addressBytesToString(agent),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(agent),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "snake",
+				},
+			}),
+			nil,
+			nil,
+		)
+		if err != nil {
+			s.Require().Equal([]string{
+				"AfterAgentCreated",
+				"BeforeProposalSubmitted",
+				"AfterProposalSubmitted",
+				"BeforeExec",
+			}, hooks.calls)
+
+			return err
+		}
+
+		s.Require().Equal([]string{
+			"AfterAgentCreated",
+			"BeforeProposalSubmitted",
+			"AfterProposalSubmitted",
+			"BeforeExec",
+			"AfterProposalExecuted",
+		}, hooks.calls)
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[escrowHooks]{
+		{
+			"submission allowed": {},
+			"submission vetoed": {
+				Malleate: func(subject *escrowHooks) {
+					subject.vetoSubmit = true
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"execution allowed": {},
+			"execution vetoed": {
+				Malleate: func(subject *escrowHooks) {
+					subject.vetoExec = true
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
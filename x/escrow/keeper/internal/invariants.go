@@ -0,0 +1,255 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// RegisterInvariants registers the invariants of the module.
+func (k Keeper) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(escrowv1alpha1.ModuleName, "agents-by-creator", k.AgentsByCreatorInvariant())
+	ir.RegisterRoute(escrowv1alpha1.ModuleName, "proposals-by-proposer", k.ProposalsByProposerInvariant())
+	ir.RegisterRoute(escrowv1alpha1.ModuleName, "agents-proposals-disjoint", k.AgentsProposalsDisjointInvariant())
+	ir.RegisterRoute(escrowv1alpha1.ModuleName, "accounts", k.AccountsInvariant())
+	ir.RegisterRoute(escrowv1alpha1.ModuleName, "next-agent", k.NextAgentInvariant())
+}
+
+// AllInvariants runs all the invariants of the module.
+func (k Keeper) AllInvariants() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			k.AgentsByCreatorInvariant(),
+			k.ProposalsByProposerInvariant(),
+			k.AgentsProposalsDisjointInvariant(),
+			k.AccountsInvariant(),
+			k.NextAgentInvariant(),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// AgentsByCreatorInvariant checks that every agent has the corresponding entry
+// in the index by the creator, and vice versa.
+func (k Keeper) AgentsByCreatorInvariant() sdk.Invariant {
+	const name = "agents-by-creator"
+
+	return func(ctx sdk.Context) (string, bool) {
+		entries := map[[2]string]bool{}
+		if err := k.iterateAgents(ctx, func(address sdk.AccAddress, agent escrowv1alpha1.Agent) error {
+			entries[[2]string{string(agent.Creator), string(address)}] = true
+			return nil
+		}); err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		iter, err := k.agents.Indexes.creator.Iterate(ctx, nil)
+		if err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+		defer iter.Close()
+
+		var msg string
+		var broken bool
+		for ; iter.Valid(); iter.Next() {
+			key, err := iter.FullKey()
+			if err != nil {
+				return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+			}
+
+			entry := [2]string{string(key.K1()), string(key.K2())}
+			if !entries[entry] {
+				msg += fmt.Sprintf("\tdangling index entry of agent %s by creator %s\n", key.K2(), key.K1())
+				broken = true
+				continue
+			}
+			delete(entries, entry)
+		}
+
+		for _, entry := range sortedEntries(entries) {
+			msg += fmt.Sprintf("\tmissing index entry of agent %s by creator %s\n", sdk.AccAddress(entry[1]), sdk.AccAddress(entry[0]))
+			broken = true
+		}
+
+		return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, msg), broken
+	}
+}
+
+// ProposalsByProposerInvariant checks that every proposal has the
+// corresponding entry in the index by the proposer, and vice versa.
+func (k Keeper) ProposalsByProposerInvariant() sdk.Invariant {
+	const name = "proposals-by-proposer"
+
+	return func(ctx sdk.Context) (string, bool) {
+		entries := map[[2]string]bool{}
+		if err := k.iterateProposals(ctx, func(agent sdk.AccAddress, proposal escrowv1alpha1.Proposal) error {
+			entries[[2]string{string(proposal.Proposer), string(agent)}] = true
+			return nil
+		}); err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		iter, err := k.proposals.Indexes.proposer.Iterate(ctx, nil)
+		if err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+		defer iter.Close()
+
+		var msg string
+		var broken bool
+		for ; iter.Valid(); iter.Next() {
+			key, err := iter.FullKey()
+			if err != nil {
+				return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+			}
+
+			entry := [2]string{string(key.K1()), string(key.K2())}
+			if !entries[entry] {
+				msg += fmt.Sprintf("\tdangling index entry of proposal %s by proposer %s\n", key.K2(), key.K1())
+				broken = true
+				continue
+			}
+			delete(entries, entry)
+		}
+
+		for _, entry := range sortedEntries(entries) {
+			msg += fmt.Sprintf("\tmissing index entry of proposal %s by proposer %s\n", sdk.AccAddress(entry[1]), sdk.AccAddress(entry[0]))
+			broken = true
+		}
+
+		return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, msg), broken
+	}
+}
+
+// AgentsProposalsDisjointInvariant checks that no address is both of an agent
+// and of a proposal.
+func (k Keeper) AgentsProposalsDisjointInvariant() sdk.Invariant {
+	const name = "agents-proposals-disjoint"
+
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+		if err := k.iterateProposals(ctx, func(agent sdk.AccAddress, _ escrowv1alpha1.Proposal) error {
+			has, err := k.agents.Has(ctx, agent)
+			if err != nil {
+				return escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+			}
+
+			if has {
+				msg += fmt.Sprintf("\t%s is both of an agent and of a proposal\n", agent)
+				broken = true
+			}
+
+			return nil
+		}); err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, msg), broken
+	}
+}
+
+// AccountsInvariant checks that the address of every agent and proposal has
+// its account.
+func (k Keeper) AccountsInvariant() sdk.Invariant {
+	const name = "accounts"
+
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+		check := func(address sdk.AccAddress) {
+			if !k.authKeeper.HasAccount(ctx, address) {
+				msg += fmt.Sprintf("\taccount of %s not found\n", address)
+				broken = true
+			}
+		}
+
+		if err := k.iterateAgents(ctx, func(address sdk.AccAddress, _ escrowv1alpha1.Agent) error {
+			check(address)
+			return nil
+		}); err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		if err := k.iterateProposals(ctx, func(agent sdk.AccAddress, _ escrowv1alpha1.Proposal) error {
+			check(agent)
+			return nil
+		}); err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, msg), broken
+	}
+}
+
+// NextAgentInvariant checks that every agent (including those of the
+// proposals) is derived from a sequence less than the next agent sequence.
+func (k Keeper) NextAgentInvariant() sdk.Invariant {
+	const name = "next-agent"
+
+	return func(ctx sdk.Context) (string, bool) {
+		nextAgent, err := k.nextAgent.Peek(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		// the sequence of an agent is found in the credential of its account
+		var msg string
+		var broken bool
+		check := func(address sdk.AccAddress) {
+			agentNum, err := agentNumber(address, k.authKeeper.GetAccount(ctx, address))
+			if err != nil {
+				msg += fmt.Sprintf("\t%s not derived from any sequence: %s\n", address, err)
+				broken = true
+				return
+			}
+
+			if agentNum >= nextAgent {
+				msg += fmt.Sprintf("\t%s derived from sequence %d, not less than %d\n", address, agentNum, nextAgent)
+				broken = true
+			}
+		}
+
+		if err := k.iterateAgents(ctx, func(address sdk.AccAddress, _ escrowv1alpha1.Agent) error {
+			check(address)
+			return nil
+		}); err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		if err := k.iterateProposals(ctx, func(agent sdk.AccAddress, _ escrowv1alpha1.Proposal) error {
+			check(agent)
+			return nil
+		}); err != nil {
+			return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, err.Error()), true
+		}
+
+		return sdk.FormatInvariant(escrowv1alpha1.ModuleName, name, msg), broken
+	}
+}
+
+// sortedEntries returns the entries of the index in order, so that the
+// messages of the broken invariants are deterministic.
+func sortedEntries(entries map[[2]string]bool) [][2]string {
+	sorted := make([][2]string, 0, len(entries))
+	for entry := range entries {
+		sorted = append(sorted, entry)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i][0] != sorted[j][0] {
+			return sorted[i][0] < sorted[j][0]
+		}
+		return sorted[i][1] < sorted[j][1]
+	})
+
+	return sorted
+}
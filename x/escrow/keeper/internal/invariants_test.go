@@ -0,0 +1,169 @@
+package internal_test
+
+import (
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+)
+
+func (s *KeeperTestSuite) TestInvariants() {
+	type invariants struct {
+		agents       []*escrowv1alpha1.GenesisState_Agent
+		rewindAgents bool
+		corrupt      func(ctx sdk.Context)
+		route        string
+	}
+
+	// the index entries keyed by the pair of the creator (or the proposer)
+	// and the agent
+	indexKey := func(prefix byte, owner, agent sdk.AccAddress) []byte {
+		key, err := collections.EncodeKeyWithPrefix([]byte{prefix},
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			collections.Join(owner, agent))
+		s.Require().NoError(err)
+		return key
+	}
+	setEntry := func(ctx sdk.Context, key []byte) {
+		err := runtime.NewKVStoreService(s.storeKey).OpenKVStore(ctx).Set(key, []byte{})
+		s.Require().NoError(err)
+	}
+	removeEntry := func(ctx sdk.Context, key []byte) {
+		store := runtime.NewKVStoreService(s.storeKey).OpenKVStore(ctx)
+		has, err := store.Has(key)
+		s.Require().NoError(err)
+		s.Require().True(has)
+
+		err = store.Delete(key)
+		s.Require().NoError(err)
+	}
+
+	tester := func(subject invariants) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		// the additional entries are imported on top of the existing state
+		gs, err := s.keeper.ExportGenesis(ctx)
+		s.Require().NoError(err)
+		gs.Agents = subject.agents
+		gs.Proposals = []*escrowv1alpha1.GenesisState_Proposal{}
+		if subject.rewindAgents {
+			gs.NextAgent = 1
+		}
+
+		err = s.keeper.InitGenesis(ctx, gs)
+		s.Require().NoError(err)
+
+		if subject.corrupt != nil {
+			subject.corrupt(ctx)
+		}
+
+		// the corrupted index is reported by its own invariant
+		if subject.route != "" {
+			msg, broken := s.keeper.AllInvariants()(ctx)
+			s.Require().True(broken)
+			s.Require().Contains(msg, subject.route)
+		}
+
+		if msg, broken := s.keeper.AllInvariants()(ctx); broken {
+			return escrowv1alpha1.ErrInvariantBroken.Wrap(msg)
+		}
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[invariants]{
+		{
+			"no additional agents": {},
+			"agent not derived": {
+				Malleate: func(subject *invariants) {
+					subject.agents = append(subject.agents, &escrowv1alpha1.GenesisState_Agent{
+						Address: s.addressBytesToString(createAgentAccounts(s.T(), s.ctx, s.authKeeper, s.addressCodec, 1)[0]),
+						Creator: s.addressBytesToString(s.seller),
+					})
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvariantBroken
+				},
+			},
+			"agent of proposal": {
+				Malleate: func(subject *invariants) {
+					subject.agents = append(subject.agents, &escrowv1alpha1.GenesisState_Agent{
+						Address: s.addressBytesToString(s.agentAny),
+						Creator: s.addressBytesToString(s.seller),
+					})
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvariantBroken
+				},
+			},
+		},
+		{
+			"next agent kept": {},
+			"next agent rewound": {
+				Malleate: func(subject *invariants) {
+					subject.rewindAgents = true
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvariantBroken
+				},
+			},
+		},
+		{
+			"indexes kept": {},
+			"dangling agents_by_creator": {
+				Malleate: func(subject *invariants) {
+					subject.route = "agents-by-creator"
+					subject.corrupt = func(ctx sdk.Context) {
+						setEntry(ctx, indexKey(0x12, s.seller, createRandomAddress()))
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvariantBroken
+				},
+			},
+			"missing agents_by_creator": {
+				Malleate: func(subject *invariants) {
+					subject.route = "agents-by-creator"
+					subject.corrupt = func(ctx sdk.Context) {
+						agent, err := s.keeper.GetAgent(ctx, s.agentIdle)
+						s.Require().NoError(err)
+
+						removeEntry(ctx, indexKey(0x12, agent.Creator, s.agentIdle))
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvariantBroken
+				},
+			},
+			"dangling proposals_by_proposer": {
+				Malleate: func(subject *invariants) {
+					subject.route = "proposals-by-proposer"
+					subject.corrupt = func(ctx sdk.Context) {
+						setEntry(ctx, indexKey(0x21, s.seller, createRandomAddress()))
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvariantBroken
+				},
+			},
+			"missing proposals_by_proposer": {
+				Malleate: func(subject *invariants) {
+					subject.route = "proposals-by-proposer"
+					subject.corrupt = func(ctx sdk.Context) {
+						proposal, err := s.keeper.GetProposal(ctx, s.agentAny)
+						s.Require().NoError(err)
+
+						removeEntry(ctx, indexKey(0x21, proposal.Proposer, s.agentAny))
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvariantBroken
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
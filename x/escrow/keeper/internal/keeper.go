@@ -9,18 +9,38 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
 	"github.com/0tech/andromeda/x/escrow/keeper/expected"
 )
 
+// Config defines the configuration of the keeper.
+type Config struct {
+	// RecreateAgentAccounts tells InitGenesis to recreate the missing accounts
+	// of the agents from their derivation, instead of failing.
+	RecreateAgentAccounts bool
+}
+
+// DefaultConfig returns the default configuration of the keeper.
+func DefaultConfig() Config {
+	return Config{}
+}
+
 type Keeper struct {
 	cdc codec.Codec
 
+	config Config
+
 	authority sdk.AccAddress
 	router    expected.MessageRouter
 
-	authKeeper expected.AuthKeeper
+	authKeeper  expected.AuthKeeper
+	bankKeeper  expected.BankKeeper
+	distrKeeper expected.DistributionKeeper
+	groupKeeper expected.GroupKeeper
+
+	hooks *hooksHolder
 
 	schema collections.Schema
 
@@ -38,13 +58,22 @@ func NewKeeper(
 	authority sdk.AccAddress,
 	router expected.MessageRouter,
 	authKeeper expected.AuthKeeper,
+	bankKeeper expected.BankKeeper,
+	distrKeeper expected.DistributionKeeper,
+	groupKeeper expected.GroupKeeper,
+	config Config,
 ) (*Keeper, error) {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
-		cdc:        cdc,
-		authority:  authority,
-		router:     router,
-		authKeeper: authKeeper,
+		cdc:         cdc,
+		config:      config,
+		authority:   authority,
+		router:      router,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
+		groupKeeper: groupKeeper,
+		hooks:       &hooksHolder{},
 		params: collections.NewItem(sb, paramsKey, "params",
 			codec.CollValue[escrowv1alpha1.Params](cdc)),
 		nextAgent: collections.NewSequence(sb, agentsSeqKey, "next_agent"),
@@ -56,7 +85,7 @@ func NewKeeper(
 		proposals: collections.NewIndexedMap(sb, proposalsKeyPrefix, "proposals",
 			sdk.AccAddressKey,
 			codec.CollValue[escrowv1alpha1.Proposal](cdc),
-			newProposalsIndexes(sb),
+			newProposalsIndexes(sb, cdc),
 		),
 	}
 
@@ -89,10 +118,19 @@ func (k Keeper) addressStringToBytes(addr string) (sdk.AccAddress, error) {
 	return addrBytes, nil
 }
 
+func (k Keeper) moduleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(escrowv1alpha1.ModuleName)
+}
+
 func (k Keeper) GetAuthority() sdk.AccAddress {
 	return k.authority
 }
 
+// Schema returns the schema of the collections in the store.
+func (k Keeper) Schema() collections.Schema {
+	return k.schema
+}
+
 func (k Keeper) validateAuthority(candidate sdk.AccAddress) error {
 	if !candidate.Equals(k.authority) {
 		return escrowv1alpha1.ErrPermissionDenied.Wrap("not authority")
@@ -107,8 +145,12 @@ func (k Keeper) validateMetadata(ctx context.Context, metadata string) error {
 		return err
 	}
 
-	if length := uint64(len(metadata)); length > params.MaxMetadataLength {
-		return errors.Wrapf(escrowv1alpha1.ErrLargeMetadata.Wrapf("over limit of %d", params.MaxMetadataLength), "%d", length)
+	return validateMetadataLength(metadata, params.MaxMetadataLength)
+}
+
+func validateMetadataLength(metadata string, limit uint64) error {
+	if length := uint64(len(metadata)); length > limit {
+		return errors.Wrapf(escrowv1alpha1.ErrLargeMetadata.Wrapf("over limit of %d", limit), "%d", length)
 	}
 
 	return nil
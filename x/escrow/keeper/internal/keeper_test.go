@@ -1,9 +1,12 @@
 package internal_test
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +16,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -65,11 +69,43 @@ func createRandomAddress() sdk.AccAddress {
 	return createRandomAddresses(1)[0]
 }
 
+// createAgentAccounts creates the accounts of agents on x/auth, whose
+// addresses are not derived from the sequence of the agents. The addresses
+// are sorted.
+func createAgentAccounts(t *testing.T, ctx context.Context, authKeeper expected.AuthKeeper, addressCodec address.Codec, size int) []sdk.AccAddress {
+	addresses := make([]sdk.AccAddress, size)
+	for i := range addresses {
+		ac, err := authtypes.NewModuleCredential(escrowv1alpha1.ModuleName, []byte("agent"), []byte(randomString(8)))
+		assert.NoError(t, err)
+		addresses[i] = sdk.AccAddress(ac.Address())
+
+		addressStr, err := addressCodec.BytesToString(addresses[i])
+		assert.NoError(t, err)
+
+		account := &authtypes.BaseAccount{
+			Address: addressStr,
+		}
+		err = account.SetPubKey(ac)
+		assert.NoError(t, err)
+
+		authKeeper.SetAccount(ctx, authKeeper.NewAccount(ctx, account))
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i], addresses[j]) < 0
+	})
+
+	return addresses
+}
+
 type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx context.Context
 
+	// the store of the module, which the tests may corrupt directly
+	storeKey *storetypes.KVStoreKey
+
 	addressCodec address.Codec
 
 	keeper *keeper.Keeper
@@ -79,6 +115,13 @@ type KeeperTestSuite struct {
 
 	testQueryServer testv1alpha1.QueryServer
 
+	authKeeper expected.AuthKeeper
+
+	bank  *bankMsgServer
+	group *groupKeeper
+
+	executorGroup uint64
+
 	seller   sdk.AccAddress
 	buyer    sdk.AccAddress
 	stranger sdk.AccAddress
@@ -113,10 +156,13 @@ func (s *KeeperTestSuite) encodeMsgs(msgs []sdk.Msg) []*codectypes.Any {
 }
 
 func (s *KeeperTestSuite) SetupTest() {
-	var authKeeper expected.AuthKeeper
 	var testKeeper *testkeeper.Keeper
 	var cdc codec.Codec
-	cdc, s.ctx, s.keeper, authKeeper, testKeeper = setupKeepers(s.T())
+	s.storeKey = storetypes.NewKVStoreKey(escrowv1alpha1.ModuleName)
+	cdc, s.ctx, s.keeper, s.authKeeper, testKeeper, s.bank, s.group = setupKeepersOnStore(s.T(), s.storeKey, keeper.DefaultConfig())
+	s.ctx = sdk.UnwrapSDKContext(s.ctx).
+		WithBlockHeight(42).
+		WithBlockTime(time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC))
 	s.addressCodec = cdc.InterfaceRegistry().SigningContext().AddressCodec()
 
 	s.queryServer = keeper.NewQueryServer(*s.keeper)
@@ -139,7 +185,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		account := &authtypes.BaseAccount{
 			Address: s.addressBytesToString(*account),
 		}
-		authKeeper.SetAccount(s.ctx, authKeeper.NewAccount(s.ctx, account))
+		s.authKeeper.SetAccount(s.ctx, s.authKeeper.NewAccount(s.ctx, account))
 	}
 
 	// allocate assets
@@ -171,6 +217,11 @@ func (s *KeeperTestSuite) SetupTest() {
 		}
 	}
 
+	// create a group of the eligible executors
+	executorGroup, err := s.group.CreateGroup(s.ctx, []sdk.AccAddress{s.buyer})
+	s.NoError(err)
+	s.executorGroup = executorGroup
+
 	// seller creates agents
 	for _, agent := range []*sdk.AccAddress{
 		&s.agentDedicated,
@@ -183,7 +234,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	// submit proposal for the buyer
-	err = s.keeper.SubmitProposal(s.ctx, s.seller, s.agentDedicated,
+	_, _, err = s.keeper.SubmitProposal(s.ctx, s.seller, s.agentDedicated,
 		s.encodeMsgs([]sdk.Msg{
 			&testv1alpha1.MsgSend{
 				Sender:    s.addressBytesToString(s.seller),
@@ -204,11 +255,24 @@ func (s *KeeperTestSuite) SetupTest() {
 			},
 		}),
 		"sell a cat to buyer for a voucher",
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentDedicated),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "cat",
+			},
+		}),
+		0,
+		nil,
+		0,
+		nil,
+		0,
+		0,
 	)
 	s.NoError(err)
 
 	// submit proposal for anyone
-	err = s.keeper.SubmitProposal(s.ctx, s.seller, s.agentAny,
+	_, _, err = s.keeper.SubmitProposal(s.ctx, s.seller, s.agentAny,
 		s.encodeMsgs([]sdk.Msg{
 			&testv1alpha1.MsgSend{
 				Sender:    s.addressBytesToString(s.seller),
@@ -224,6 +288,22 @@ func (s *KeeperTestSuite) SetupTest() {
 			},
 		}),
 		"sell a dog for a voucher",
+		s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentAny),
+				Recipient: s.addressBytesToString(s.seller),
+				Asset:     "dog",
+			},
+		}),
+		0,
+		func() *time.Time {
+			expireTime := sdk.UnwrapSDKContext(s.ctx).BlockTime().Add(time.Hour)
+			return &expireTime
+		}(),
+		0,
+		nil,
+		0,
+		0,
 	)
 	s.NoError(err)
 }
@@ -237,7 +317,7 @@ func setupEscrowKeeper(t *testing.T) (
 	context.Context,
 	*keeper.Keeper,
 ) {
-	cdc, ctx, keeper, _, _ := setupKeepers(t)
+	cdc, ctx, keeper, _, _, _, _ := setupKeepers(t)
 	return cdc, ctx, keeper
 }
 
@@ -247,8 +327,35 @@ func setupKeepers(t *testing.T) (
 	*keeper.Keeper,
 	expected.AuthKeeper,
 	*testkeeper.Keeper,
+	*bankMsgServer,
+	*groupKeeper,
+) {
+	return setupKeepersWithConfig(t, keeper.DefaultConfig())
+}
+
+func setupKeepersWithConfig(t *testing.T, config keeper.Config) (
+	codec.Codec,
+	context.Context,
+	*keeper.Keeper,
+	expected.AuthKeeper,
+	*testkeeper.Keeper,
+	*bankMsgServer,
+	*groupKeeper,
+) {
+	return setupKeepersOnStore(t, storetypes.NewKVStoreKey(escrowv1alpha1.ModuleName), config)
+}
+
+// setupKeepersOnStore sets up the keepers on the given store, which the tests
+// may access directly.
+func setupKeepersOnStore(t *testing.T, key *storetypes.KVStoreKey, config keeper.Config) (
+	codec.Codec,
+	context.Context,
+	*keeper.Keeper,
+	expected.AuthKeeper,
+	*testkeeper.Keeper,
+	*bankMsgServer,
+	*groupKeeper,
 ) {
-	key := storetypes.NewKVStoreKey(escrowv1alpha1.ModuleName)
 	tkey := storetypes.NewTransientStoreKey("transient_test")
 	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
@@ -272,10 +379,24 @@ func setupKeepers(t *testing.T) (
 	ctrl := gomock.NewController(t)
 	authKeeper := newAuthKeeper(t, ctrl, encCfg.Codec, key)
 
-	escrowKeeper := newEscrowKeeper(t, bapp, encCfg.Codec, key, authKeeper)
+	bankMsgServer := newBankMsgServer(t, bapp, encCfg.Codec, key)
+	distrKeeper := &distrKeeper{bank: bankMsgServer}
+	groupKeeper := newGroupKeeper(t, encCfg.Codec, key)
+
+	// the accounts of the agents hold their credentials as pubkeys
+	authtypes.RegisterInterfaces(ir)
+
+	// x/nft is not executed in the tests, but its messages may appear in the
+	// actions as assets
+	nft.RegisterInterfaces(ir)
+
+	// x/authz may wrap the messages of this module in the actions
+	newAuthzMsgServer(bapp, encCfg.Codec)
+
+	escrowKeeper := newEscrowKeeper(t, bapp, encCfg.Codec, key, authKeeper, bankMsgServer, distrKeeper, groupKeeper, config)
 	testKeeper := newTestKeeper(t, bapp, encCfg.Codec, key) // register test keeper
 
-	return encCfg.Codec, testCtx.Ctx, escrowKeeper, authKeeper, testKeeper
+	return encCfg.Codec, testCtx.Ctx, escrowKeeper, authKeeper, testKeeper, bankMsgServer, groupKeeper
 }
 
 // mock auth keeper
@@ -317,17 +438,48 @@ func newAuthKeeper(t *testing.T, ctrl *gomock.Controller, cdc codec.Codec, key *
 
 		return has
 	}
+	getAccount := func(ctx context.Context, address sdk.AccAddress) sdk.AccountI {
+		store := runtime.NewKVStoreService(key).OpenKVStore(ctx)
+
+		key := append(append([]byte{}, accountPrefix...), address...)
+		bz, err := store.Get(key)
+		assert.NoError(t, err)
+		if bz == nil {
+			return nil
+		}
+
+		var account authtypes.BaseAccount
+		err = cdc.Unmarshal(bz, &account)
+		assert.NoError(t, err)
+
+		return &account
+	}
+	// the address of the account may be encoded in either of the global
+	// prefix or the prefix of the tests
+	accountAddress := func(account sdk.AccountI) sdk.AccAddress {
+		if address := account.GetAddress(); !address.Empty() {
+			return address
+		}
+
+		address, err := cdc.InterfaceRegistry().SigningContext().AddressCodec().StringToBytes(account.(*authtypes.BaseAccount).Address)
+		assert.NoError(t, err)
+
+		return address
+	}
 	setAccount := func(ctx context.Context, account sdk.AccountI) {
 		store := runtime.NewKVStoreService(key).OpenKVStore(ctx)
 
 		bz, err := cdc.Marshal(account)
 		assert.NoError(t, err)
 
-		key := append(append([]byte{}, accountPrefix...), account.GetAddress()...)
+		key := append(append([]byte{}, accountPrefix...), accountAddress(account)...)
 		err = store.Set(key, bz)
 		assert.NoError(t, err)
 	}
 
+	authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, address sdk.AccAddress) sdk.AccountI {
+		return getAccount(ctx, address)
+	}).AnyTimes()
 	authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, address sdk.AccAddress) bool {
 		return hasAccount(ctx, address)
 	}).AnyTimes()
@@ -344,10 +496,10 @@ func newAuthKeeper(t *testing.T, ctrl *gomock.Controller, cdc codec.Codec, key *
 	return authKeeper
 }
 
-func newEscrowKeeper(t *testing.T, bapp *baseapp.BaseApp, cdc codec.Codec, key *storetypes.KVStoreKey, authKeeper expected.AuthKeeper) *keeper.Keeper {
+func newEscrowKeeper(t *testing.T, bapp *baseapp.BaseApp, cdc codec.Codec, key *storetypes.KVStoreKey, authKeeper expected.AuthKeeper, bankKeeper expected.BankKeeper, distrKeeper expected.DistributionKeeper, groupKeeper expected.GroupKeeper, config keeper.Config) *keeper.Keeper {
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
-	escrowKeeper, err := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), authority, bapp.MsgServiceRouter(), authKeeper)
+	escrowKeeper, err := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), authority, bapp.MsgServiceRouter(), authKeeper, bankKeeper, distrKeeper, groupKeeper, config)
 	assert.NoError(t, err)
 
 	msgServer := keeper.NewMsgServer(*escrowKeeper)
@@ -1,8 +1,12 @@
 package internal
 
 import (
+	"time"
+
 	"cosmossdk.io/collections"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
@@ -18,6 +22,14 @@ var (
 
 	proposalsKeyPrefix         = collections.NewPrefix(0x20)
 	proposalsKeyProposerPrefix = collections.NewPrefix(0x21)
+
+	proposalsKeyExpireHeightPrefix = collections.NewPrefix(0x22)
+	proposalsKeyExpireTimePrefix   = collections.NewPrefix(0x23)
+
+	proposalsKeyMessageTypePrefix = collections.NewPrefix(0x24)
+
+	proposalsKeyOfferedAssetPrefix = collections.NewPrefix(0x25)
+	proposalsKeyAskedAssetPrefix   = collections.NewPrefix(0x26)
 )
 
 func newAgentsIndexes(sb *collections.SchemaBuilder) agentsIndexes {
@@ -44,7 +56,7 @@ func (a agentsIndexes) IndexesList() []collections.Index[sdk.AccAddress, escrowv
 	}
 }
 
-func newProposalsIndexes(sb *collections.SchemaBuilder) proposalsIndexes {
+func newProposalsIndexes(sb *collections.SchemaBuilder, cdc codec.Codec) proposalsIndexes {
 	return proposalsIndexes{
 		proposer: indexes.NewMulti(
 			sb, proposalsKeyProposerPrefix, "proposals_by_proposer",
@@ -55,15 +67,110 @@ func newProposalsIndexes(sb *collections.SchemaBuilder) proposalsIndexes {
 				return proposer, nil
 			},
 		),
+		expireHeight: indexes.NewMultiRefs(
+			sb, proposalsKeyExpireHeightPrefix, "proposals_by_expire_height",
+			collections.Uint64Key,
+			sdk.AccAddressKey,
+			func(_ sdk.AccAddress, value escrowv1alpha1.Proposal) ([]uint64, error) {
+				// the proposals whose refund_actions failed are not pruned
+				if value.ExpireHeight == 0 || value.RefundFailed {
+					return nil, nil
+				}
+				return []uint64{value.ExpireHeight}, nil
+			},
+		),
+		expireTime: indexes.NewMultiRefs(
+			sb, proposalsKeyExpireTimePrefix, "proposals_by_expire_time",
+			sdk.TimeKey,
+			sdk.AccAddressKey,
+			func(_ sdk.AccAddress, value escrowv1alpha1.Proposal) ([]time.Time, error) {
+				if value.ExpireTime == nil || value.RefundFailed {
+					return nil, nil
+				}
+				return []time.Time{*value.ExpireTime}, nil
+			},
+		),
+		messageType: indexes.NewMultiRefs(
+			sb, proposalsKeyMessageTypePrefix, "proposals_by_message_type",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			sdk.AccAddressKey,
+			func(_ sdk.AccAddress, value escrowv1alpha1.Proposal) ([]collections.Pair[string, string], error) {
+				return messageTypeRefKeys(value), nil
+			},
+		),
+		offeredAsset: indexes.NewMultiRefs(
+			sb, proposalsKeyOfferedAssetPrefix, "proposals_by_offered_asset",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			sdk.AccAddressKey,
+			func(_ sdk.AccAddress, value escrowv1alpha1.Proposal) ([]collections.Pair[string, string], error) {
+				return assetRefKeys(cdc, value.PreActions)
+			},
+		),
+		askedAsset: indexes.NewMultiRefs(
+			sb, proposalsKeyAskedAssetPrefix, "proposals_by_asked_asset",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			sdk.AccAddressKey,
+			func(_ sdk.AccAddress, value escrowv1alpha1.Proposal) ([]collections.Pair[string, string], error) {
+				return assetRefKeys(cdc, value.PostActions)
+			},
+		),
 	}
 }
 
+// anyPhase is the phase in the message type index, which references the
+// proposals by the message types in either of their phases.
+const anyPhase = ""
+
+// messageTypeRefKeys returns the pairs of the message type and the phase of
+// the actions in the proposal, without duplicates.
+func messageTypeRefKeys(proposal escrowv1alpha1.Proposal) []collections.Pair[string, string] {
+	var refKeys []collections.Pair[string, string]
+	seen := map[[2]string]bool{}
+	for _, phase := range []struct {
+		name    string
+		actions []*codectypes.Any
+	}{
+		{
+			name:    "pre_actions",
+			actions: proposal.PreActions,
+		},
+		{
+			name:    "post_actions",
+			actions: proposal.PostActions,
+		},
+	} {
+		for _, action := range phase.actions {
+			for _, name := range []string{phase.name, anyPhase} {
+				key := [2]string{action.TypeUrl, name}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				refKeys = append(refKeys, collections.Join(action.TypeUrl, name))
+			}
+		}
+	}
+
+	return refKeys
+}
+
 type proposalsIndexes struct {
-	proposer *indexes.Multi[sdk.AccAddress, sdk.AccAddress, escrowv1alpha1.Proposal]
+	proposer     *indexes.Multi[sdk.AccAddress, sdk.AccAddress, escrowv1alpha1.Proposal]
+	expireHeight *indexes.MultiRefs[uint64, sdk.AccAddress, escrowv1alpha1.Proposal]
+	expireTime   *indexes.MultiRefs[time.Time, sdk.AccAddress, escrowv1alpha1.Proposal]
+	messageType  *indexes.MultiRefs[collections.Pair[string, string], sdk.AccAddress, escrowv1alpha1.Proposal]
+	offeredAsset *indexes.MultiRefs[collections.Pair[string, string], sdk.AccAddress, escrowv1alpha1.Proposal]
+	askedAsset   *indexes.MultiRefs[collections.Pair[string, string], sdk.AccAddress, escrowv1alpha1.Proposal]
 }
 
 func (a proposalsIndexes) IndexesList() []collections.Index[sdk.AccAddress, escrowv1alpha1.Proposal] {
 	return []collections.Index[sdk.AccAddress, escrowv1alpha1.Proposal]{
 		a.proposer,
+		a.expireHeight,
+		a.expireTime,
+		a.messageType,
+		a.offeredAsset,
+		a.askedAsset,
 	}
 }
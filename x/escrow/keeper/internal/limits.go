@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// validateProposalLimits checks the actions of a proposal against the
+// structural limits in the params.
+func (k Keeper) validateProposalLimits(ctx context.Context, preActions, postActions, refundActions []*codectypes.Any) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	return validateProposalLimitsWithParams(params, preActions, postActions, refundActions)
+}
+
+func validateProposalLimitsWithParams(params *escrowv1alpha1.Params, preActions, postActions, refundActions []*codectypes.Any) error {
+	if err := validateNumEntries(len(preActions), params.MaxPreActions, escrowv1alpha1.ErrTooManyPreActions); err != nil {
+		return errors.Wrap(err, "pre_actions")
+	}
+
+	if err := validateNumEntries(len(postActions), params.MaxPostActions, escrowv1alpha1.ErrTooManyPostActions); err != nil {
+		return errors.Wrap(err, "post_actions")
+	}
+
+	if err := validateNumEntries(len(refundActions), params.MaxRefundActions, escrowv1alpha1.ErrTooManyRefundActions); err != nil {
+		return errors.Wrap(err, "refund_actions")
+	}
+
+	for _, phase := range []struct {
+		name    string
+		actions []*codectypes.Any
+	}{
+		{
+			name:    "pre_actions",
+			actions: preActions,
+		},
+		{
+			name:    "post_actions",
+			actions: postActions,
+		},
+		{
+			name:    "refund_actions",
+			actions: refundActions,
+		},
+	} {
+		if err := validateActionSizes(phase.actions, params.MaxActionSize); err != nil {
+			return errors.Wrap(err, phase.name)
+		}
+	}
+
+	return nil
+}
+
+// validateExecLimits checks Msg/Exec against the structural limits in the
+// params.
+func (k Keeper) validateExecLimits(ctx context.Context, numAgents int, actions []*codectypes.Any) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := validateNumEntries(numAgents, params.MaxAgentsPerExec, escrowv1alpha1.ErrTooManyAgents); err != nil {
+		return errors.Wrap(err, "agents")
+	}
+
+	if err := validateNumEntries(len(actions), params.MaxActions, escrowv1alpha1.ErrTooManyActions); err != nil {
+		return errors.Wrap(err, "actions")
+	}
+
+	if err := validateActionSizes(actions, params.MaxActionSize); err != nil {
+		return errors.Wrap(err, "actions")
+	}
+
+	return nil
+}
+
+func validateNumEntries(num int, limit uint64, errTooMany *errors.Error) error {
+	if n := uint64(num); n > limit {
+		return errors.Wrapf(errTooMany.Wrapf("over limit of %d", limit), "%d", n)
+	}
+
+	return nil
+}
+
+func validateActionSizes(actions []*codectypes.Any, limit uint64) error {
+	for i, action := range actions {
+		if size := uint64(action.Size()); size > limit {
+			return indexedError(errors.Wrapf(escrowv1alpha1.ErrLargeAction.Wrapf("over limit of %d", limit), "%d", size), i)
+		}
+	}
+
+	return nil
+}
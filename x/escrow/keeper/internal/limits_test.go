@@ -0,0 +1,277 @@
+package internal_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestProposalLimits() {
+	type proposalLimits struct {
+		preActions    []*codectypes.Any
+		postActions   []*codectypes.Any
+		refundActions []*codectypes.Any
+		maxActionSize uint64
+	}
+
+	preAction := s.encodeMsgs([]sdk.Msg{
+		&testv1alpha1.MsgSend{
+			Sender:    s.addressBytesToString(s.seller),
+			Recipient: s.addressBytesToString(s.agentIdle),
+			Asset:     "snake",
+		},
+	})
+	postAction := s.encodeMsgs([]sdk.Msg{
+		&testv1alpha1.MsgSend{
+			Sender:    s.addressBytesToString(s.agentIdle),
+			Recipient: s.addressBytesToString(s.seller),
+			Asset:     "voucher",
+		},
+	})
+	refundAction := s.encodeMsgs([]sdk.Msg{
+		&testv1alpha1.MsgSend{
+			Sender:    s.addressBytesToString(s.agentIdle),
+			Recipient: s.addressBytesToString(s.seller),
+			Asset:     "snake",
+		},
+	})
+
+	tester := func(subject proposalLimits) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.MaxPreActions = 1
+		params.MaxPostActions = 1
+		params.MaxRefundActions = 1
+		params.MaxActionSize = subject.maxActionSize
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.SubmitProposal(ctx, &escrowv1alpha1.MsgSubmitProposal{
+			Proposer:      s.addressBytesToString(s.seller),
+			Agent:         s.addressBytesToString(s.agentIdle),
+			PreActions:    subject.preActions,
+			PostActions:   subject.postActions,
+			Metadata:      "sell a snake for a voucher",
+			RefundActions: subject.refundActions,
+		})
+
+		return err
+	}
+
+	cases := []map[string]testutil.Case[proposalLimits]{
+		{
+			"pre_actions within limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.preActions = preAction
+				},
+			},
+			"pre_actions over limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.preActions = append(preAction, preAction...)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrTooManyPreActions
+				},
+			},
+		},
+		{
+			"post_actions within limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.postActions = postAction
+				},
+			},
+			"post_actions over limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.postActions = append(postAction, postAction...)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrTooManyPostActions
+				},
+			},
+		},
+		{
+			"refund_actions within limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.refundActions = refundAction
+				},
+			},
+			"refund_actions over limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.refundActions = append(refundAction, refundAction...)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrTooManyRefundActions
+				},
+			},
+		},
+		{
+			"actions within size limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.maxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+				},
+			},
+			"actions over size limit": {
+				Malleate: func(subject *proposalLimits) {
+					subject.maxActionSize = 1
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrLargeAction
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestUpdateProposalLimits() {
+	type updateProposalLimits struct {
+		preActions []*codectypes.Any
+	}
+
+	preAction := s.encodeMsgs([]sdk.Msg{
+		&testv1alpha1.MsgSend{
+			Sender:    s.addressBytesToString(s.seller),
+			Recipient: s.addressBytesToString(s.agentAny),
+			Asset:     "snake",
+		},
+	})
+
+	tester := func(subject updateProposalLimits) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		// the proposal of agentAny has a pre_action already
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.MaxPreActions = 2
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.UpdateProposal(ctx, &escrowv1alpha1.MsgUpdateProposal{
+			Proposer:   s.addressBytesToString(s.seller),
+			Agent:      s.addressBytesToString(s.agentAny),
+			PreActions: subject.preActions,
+		})
+
+		return err
+	}
+
+	cases := []map[string]testutil.Case[updateProposalLimits]{
+		{
+			"pre_actions within limit": {
+				Malleate: func(subject *updateProposalLimits) {
+					subject.preActions = preAction
+				},
+			},
+			"pre_actions over limit with the stored ones": {
+				Malleate: func(subject *updateProposalLimits) {
+					subject.preActions = append(preAction, preAction...)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrTooManyPreActions
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestExecLimits() {
+	type execLimits struct {
+		agents        []string
+		maxActions    uint64
+		maxActionSize uint64
+	}
+
+	tester := func(subject execLimits) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.MaxAgentsPerExec = 1
+		params.MaxActions = subject.maxActions
+		params.MaxActionSize = subject.maxActionSize
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.Exec(ctx, &escrowv1alpha1.MsgExec{
+			Executor: s.addressBytesToString(s.stranger),
+			Agents:   subject.agents,
+			Actions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.stranger),
+					Recipient: s.addressBytesToString(s.agentAny),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentAny),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "dog",
+				},
+			}),
+		})
+
+		return err
+	}
+
+	cases := []map[string]testutil.Case[execLimits]{
+		{
+			"agents within limit": {
+				Malleate: func(subject *execLimits) {
+					subject.agents = []string{
+						s.addressBytesToString(s.agentAny),
+					}
+				},
+			},
+			"agents over limit": {
+				Malleate: func(subject *execLimits) {
+					subject.agents = []string{
+						s.addressBytesToString(s.agentAny),
+						s.addressBytesToString(s.agentDedicated),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrTooManyAgents
+				},
+			},
+		},
+		{
+			"actions within limit": {
+				Malleate: func(subject *execLimits) {
+					subject.maxActions = 2
+				},
+			},
+			"actions over limit": {
+				Malleate: func(subject *execLimits) {
+					subject.maxActions = 1
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrTooManyActions
+				},
+			},
+		},
+		{
+			"actions within size limit": {
+				Malleate: func(subject *execLimits) {
+					subject.maxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+				},
+			},
+			"actions over size limit": {
+				Malleate: func(subject *execLimits) {
+					subject.maxActionSize = 1
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrLargeAction
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
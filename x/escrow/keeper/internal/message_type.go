@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+const messageTypeWildcard = "*"
+
+func validateMessageTypeFilters(filters []string) error {
+	seen := map[string]bool{}
+	for i, filter := range filters {
+		if filter == "" || filter == messageTypeWildcard {
+			return indexedError(escrowv1alpha1.ErrInvalidMessage.Wrap("empty type url"), i)
+		}
+
+		if seen[filter] {
+			return indexedError(escrowv1alpha1.ErrDuplicateEntry, i)
+		}
+		seen[filter] = true
+	}
+
+	return nil
+}
+
+// validateMessageType checks whether the message of the type url is allowed
+// in the actions. The denied types take precedence over the allowed types.
+func validateMessageType(params *escrowv1alpha1.Params, typeURL string) error {
+	if matchMessageType(params.DeniedMessageTypes, typeURL) {
+		return errors.Wrap(escrowv1alpha1.ErrMessageNotAllowed.Wrap("denied"), typeURL)
+	}
+
+	if len(params.AllowedMessageTypes) != 0 && !matchMessageType(params.AllowedMessageTypes, typeURL) {
+		return errors.Wrap(escrowv1alpha1.ErrMessageNotAllowed.Wrap("not allowed"), typeURL)
+	}
+
+	return nil
+}
+
+// matchMessageType returns whether any of the filters matches the type url.
+// A filter ending with the wildcard matches by the preceding prefix,
+// otherwise it matches exactly.
+func matchMessageType(filters []string, typeURL string) bool {
+	for _, filter := range filters {
+		if prefix, ok := strings.CutSuffix(filter, messageTypeWildcard); ok {
+			if strings.HasPrefix(typeURL, prefix) {
+				return true
+			}
+			continue
+		}
+
+		if typeURL == filter {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package internal_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestMessageTypeFilters() {
+	type messageTypeFilters struct {
+		allowed []string
+		denied  []string
+	}
+
+	tester := func(subject messageTypeFilters) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.AllowedMessageTypes = subject.allowed
+		params.DeniedMessageTypes = subject.denied
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		if _, err := s.msgServer.SubmitProposal(ctx, &escrowv1alpha1.MsgSubmitProposal{
+			Proposer: s.addressBytesToString(s.seller),
+			Agent:    s.addressBytesToString(s.agentIdle),
+			PreActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			PostActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "voucher",
+				},
+			}),
+			Metadata: "sell a snake for a voucher",
+			RefundActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+		}); err != nil {
+			return err
+		}
+
+		_, err = s.msgServer.Exec(ctx, &escrowv1alpha1.MsgExec{
+			Executor: s.addressBytesToString(s.stranger),
+			Agents: []string{
+				s.addressBytesToString(s.agentAny),
+			},
+			Actions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.stranger),
+					Recipient: s.addressBytesToString(s.agentAny),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentAny),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "dog",
+				},
+			}),
+		})
+
+		return err
+	}
+
+	cases := []map[string]testutil.Case[messageTypeFilters]{
+		{
+			"no allowed_message_types": {},
+			"allowed_message_types matching exactly": {
+				Malleate: func(subject *messageTypeFilters) {
+					subject.allowed = []string{"/andromeda.test.v1alpha1.MsgSend"}
+				},
+			},
+			"allowed_message_types matching by prefix": {
+				Malleate: func(subject *messageTypeFilters) {
+					subject.allowed = []string{"/cosmos.bank.*", "/andromeda.test.*"}
+				},
+			},
+			"allowed_message_types not matching": {
+				Malleate: func(subject *messageTypeFilters) {
+					subject.allowed = []string{"/andromeda.test.v1alpha1.MsgCreate", "/andromeda.test.v1alpha1.MsgSend.*"}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrMessageNotAllowed
+				},
+			},
+		},
+		{
+			"no denied_message_types": {},
+			"denied_message_types not matching": {
+				Malleate: func(subject *messageTypeFilters) {
+					subject.denied = []string{"/cosmos.staking.*", "/andromeda.test.v1alpha1.MsgCreate"}
+				},
+			},
+			"denied_message_types matching exactly": {
+				Malleate: func(subject *messageTypeFilters) {
+					subject.denied = []string{"/andromeda.test.v1alpha1.MsgSend"}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrMessageNotAllowed
+				},
+			},
+			"denied_message_types matching by prefix": {
+				Malleate: func(subject *messageTypeFilters) {
+					subject.denied = []string{"/andromeda.*"}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrMessageNotAllowed
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// Migrate1to2 migrates the state from the consensus version 1 to 2.
+//   - the params introduced in the version 2 are set to their default values
+//   - the submission height and time of the proposals are backfilled with the
+//     current block, as they have not been recorded
+//   - the proposals are indexed by the expiry, the message types and the
+//     assets
+func (k Keeper) Migrate1to2(ctx context.Context) error {
+	if err := k.migrateParams1to2(ctx); err != nil {
+		return errors.Wrap(err, "params")
+	}
+
+	if err := k.migrateProposals1to2(ctx); err != nil {
+		return errors.Wrap(err, "proposals")
+	}
+
+	return nil
+}
+
+func (k Keeper) migrateParams1to2(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the version 1 has max_metadata_length only
+	defaults := DefaultGenesisParams()
+	params.MaxPreActions = defaults.MaxPreActions
+	params.MaxPostActions = defaults.MaxPostActions
+	params.MaxActions = defaults.MaxActions
+	params.MaxAgentsPerExec = defaults.MaxAgentsPerExec
+	params.MaxActionSize = defaults.MaxActionSize
+	params.MaxNestingDepth = defaults.MaxNestingDepth
+	params.GasPerAgent = defaults.GasPerAgent
+	params.GasPerAction = defaults.GasPerAction
+	params.GasPerActionByte = defaults.GasPerActionByte
+	params.MaxPrunesPerBlock = defaults.MaxPrunesPerBlock
+	params.MaxRefundActions = defaults.MaxRefundActions
+	params.MaxSimulateGas = defaults.MaxSimulateGas
+
+	return k.setParams(ctx, params)
+}
+
+func (k Keeper) migrateProposals1to2(ctx context.Context) error {
+	// collect the proposals first not to write on the iteration
+	var agents []sdk.AccAddress
+	var proposals []escrowv1alpha1.Proposal
+	if err := k.iterateProposals(ctx, func(agent sdk.AccAddress, proposal escrowv1alpha1.Proposal) error {
+		agents = append(agents, agent)
+		proposals = append(proposals, proposal)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	submitTime := sdkCtx.BlockTime()
+	for i, proposal := range proposals {
+		proposal.SubmitHeight = uint64(sdkCtx.BlockHeight())
+		proposal.SubmitTime = &submitTime
+
+		// setting the proposal again fills the missing index entries
+		if err := k.setProposal(ctx, agents[i], &proposal); err != nil {
+			return indexedError(err, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	keeper "github.com/0tech/andromeda/x/escrow/keeper/internal"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+	testkeeper "github.com/0tech/andromeda/x/test/keeper"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	key := storetypes.NewKVStoreKey(escrowv1alpha1.ModuleName)
+	cdc, ctx, k, _, testKeeper, _, _ := setupKeepersOnStore(t, key, keeper.DefaultConfig())
+	addressCodec := cdc.InterfaceRegistry().SigningContext().AddressCodec()
+
+	blockHeight := int64(42)
+	blockTime := time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)
+	ctx = sdk.UnwrapSDKContext(ctx).
+		WithBlockHeight(blockHeight).
+		WithBlockTime(blockTime)
+
+	// write the state of the version 1 directly
+	store := runtime.NewKVStoreService(key).OpenKVStore(ctx)
+	set := func(key []byte, value []byte) {
+		err := store.Set(key, value)
+		assert.NoError(t, err)
+	}
+
+	// params of v1 have max_metadata_length only
+	set([]byte{0x00}, cdc.MustMarshal(&escrowv1alpha1.Params{
+		MaxMetadataLength: 123,
+	}))
+
+	proposer := createRandomAddress()
+	proposerStr, err := addressCodec.BytesToString(proposer)
+	assert.NoError(t, err)
+
+	agent := createRandomAddress()
+	agentStr, err := addressCodec.BytesToString(agent)
+	assert.NoError(t, err)
+
+	encodeMsg := func(msg sdk.Msg) *codectypes.Any {
+		any, err := codectypes.NewAnyWithValue(msg)
+		assert.NoError(t, err)
+		return any
+	}
+
+	// proposals of v1 have proposer, pre_actions, post_actions and metadata
+	// only, being indexed by the proposer only
+	proposalKey, err := collections.EncodeKeyWithPrefix([]byte{0x20}, sdk.AccAddressKey, agent)
+	assert.NoError(t, err)
+	set(proposalKey, cdc.MustMarshal(&escrowv1alpha1.Proposal{
+		Proposer: proposer,
+		PreActions: []*codectypes.Any{
+			encodeMsg(&nft.MsgSend{
+				ClassId:  "kitty",
+				Id:       "tom",
+				Sender:   proposerStr,
+				Receiver: agentStr,
+			}),
+		},
+		PostActions: []*codectypes.Any{
+			encodeMsg(&testv1alpha1.MsgSend{
+				Sender:    agentStr,
+				Recipient: proposerStr,
+				Asset:     "voucher",
+			}),
+		},
+		Metadata: "sell a kitty for a voucher",
+	}))
+
+	proposerKey, err := collections.EncodeKeyWithPrefix([]byte{0x21},
+		collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		collections.Join(proposer, agent))
+	assert.NoError(t, err)
+	set(proposerKey, []byte{})
+
+	err = k.Migrate1to2(ctx)
+	assert.NoError(t, err)
+
+	// new params are set to the default values
+	defaults := keeper.DefaultGenesisParams()
+	params, err := k.GetParams(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, escrowv1alpha1.Params{
+		MaxMetadataLength: 123,
+		MaxPreActions:     defaults.MaxPreActions,
+		MaxPostActions:    defaults.MaxPostActions,
+		MaxActions:        defaults.MaxActions,
+		MaxAgentsPerExec:  defaults.MaxAgentsPerExec,
+		MaxActionSize:     defaults.MaxActionSize,
+		MaxNestingDepth:   defaults.MaxNestingDepth,
+		GasPerAgent:       defaults.GasPerAgent,
+		GasPerAction:      defaults.GasPerAction,
+		GasPerActionByte:  defaults.GasPerActionByte,
+		MaxPrunesPerBlock: defaults.MaxPrunesPerBlock,
+		MaxRefundActions:  defaults.MaxRefundActions,
+		MaxSimulateGas:    defaults.MaxSimulateGas,
+	}, *params)
+
+	// submission is backfilled by the current block
+	proposal, err := k.GetProposal(ctx, agent)
+	assert.NoError(t, err)
+	assert.Equal(t, proposer, sdk.AccAddress(proposal.Proposer))
+	assert.Equal(t, "sell a kitty for a voucher", proposal.Metadata)
+	assert.Equal(t, uint64(blockHeight), proposal.SubmitHeight)
+	assert.NotNil(t, proposal.SubmitTime)
+	assert.Equal(t, blockTime, *proposal.SubmitTime)
+
+	// the new indexes are filled
+	queryServer := keeper.NewQueryServer(*k)
+
+	byMessageType, err := queryServer.ProposalsByMessageType(ctx, &escrowv1alpha1.QueryProposalsByMessageTypeRequest{
+		MessageType: sdk.MsgTypeURL(&testv1alpha1.MsgSend{}),
+		Phase:       "post_actions",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, byMessageType.Proposals, 1)
+	assert.Equal(t, agentStr, byMessageType.Proposals[0].Agent)
+
+	byOfferedAsset, err := queryServer.ProposalsByOfferedAsset(ctx, &escrowv1alpha1.QueryProposalsByOfferedAssetRequest{
+		ClassId: "kitty",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, byOfferedAsset.Proposals, 1)
+	assert.Equal(t, agentStr, byOfferedAsset.Proposals[0].Agent)
+
+	// the existing index is kept intact
+	msg, broken := k.ProposalsByProposerInvariant()(sdk.UnwrapSDKContext(ctx))
+	assert.False(t, broken, msg)
+
+	// the proposal of v1 has no refund_actions, so cancelling it would strand
+	// the assets reserved by its pre_actions
+	_, err = testkeeper.NewMsgServer(*testKeeper).Create(ctx, &testv1alpha1.MsgCreate{
+		Creator: agentStr,
+		Asset:   "snake",
+	})
+	assert.NoError(t, err)
+
+	_, _, err = k.CancelProposal(ctx, proposer, agent)
+	assert.ErrorIs(t, err, escrowv1alpha1.ErrNoRefundActions)
+
+	// the proposer sets the refund_actions, then cancels it
+	_, _, err = k.UpdateProposal(ctx, proposer, agent, nil, nil, "", []*codectypes.Any{
+		encodeMsg(&testv1alpha1.MsgSend{
+			Sender:    agentStr,
+			Recipient: proposerStr,
+			Asset:     "snake",
+		}),
+	})
+	assert.NoError(t, err)
+
+	_, _, err = k.CancelProposal(ctx, proposer, agent)
+	assert.NoError(t, err)
+
+	_, err = testkeeper.NewQueryServer(*testKeeper).Asset(ctx, &testv1alpha1.QueryAssetRequest{
+		Account: proposerStr,
+		Asset:   "snake",
+	})
+	assert.NoError(t, err)
+}
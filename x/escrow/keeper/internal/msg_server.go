@@ -24,7 +24,32 @@ func NewMsgServer(keeper Keeper) escrowv1alpha1.MsgServer {
 	}
 }
 
+// ValidateSigner validates that the address string in a message refers to the
+// signer, for the callers handling the message without its signatures.
+func (k Keeper) ValidateSigner(signer sdk.AccAddress, addr string) error {
+	addrBytes, err := k.addressStringToBytes(addr)
+	if err != nil {
+		return err
+	}
+
+	if !signer.Equals(sdk.AccAddress(addrBytes)) {
+		return escrowv1alpha1.ErrPermissionDenied.Wrap("not signer")
+	}
+
+	return nil
+}
+
 func (s msgServer) UpdateParams(ctx context.Context, req *escrowv1alpha1.MsgUpdateParams) (*escrowv1alpha1.MsgUpdateParamsResponse, error) {
+	return s.keeper.HandleUpdateParams(ctx, req)
+}
+
+// HandleUpdateParams validates and handles Msg/UpdateParams, except the
+// signatures of its signers.
+func (k Keeper) HandleUpdateParams(ctx context.Context, req *escrowv1alpha1.MsgUpdateParams) (*escrowv1alpha1.MsgUpdateParamsResponse, error) {
+	if err := k.validateNestingDepth(ctx); err != nil {
+		return nil, err
+	}
+
 	if req.Authority == "" {
 		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil authority")
 	}
@@ -33,24 +58,108 @@ func (s msgServer) UpdateParams(ctx context.Context, req *escrowv1alpha1.MsgUpda
 		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_metadata_length")
 	}
 
-	authority, err := s.keeper.addressStringToBytes(req.Authority)
+	if req.MaxPreActions == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_pre_actions")
+	}
+
+	if req.MaxPostActions == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_post_actions")
+	}
+
+	if req.MaxActions == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_actions")
+	}
+
+	if req.MaxAgentsPerExec == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_agents_per_exec")
+	}
+
+	if req.MaxActionSize == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_action_size")
+	}
+
+	if req.MaxPrunesPerBlock == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_prunes_per_block")
+	}
+
+	if req.MaxRefundActions == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_refund_actions")
+	}
+
+	if req.MaxSimulateGas == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil max_simulate_gas")
+	}
+
+	authority, err := k.addressStringToBytes(req.Authority)
 	if err != nil {
 		return nil, errors.Wrap(err, "authority")
 	}
 
-	if err := s.keeper.validateAuthority(authority); err != nil {
+	if err := validateExecFee(req.ExecFeeFlat, req.ExecFeeBps); err != nil {
 		return nil, err
 	}
 
-	if err := s.keeper.UpdateParams(ctx, &escrowv1alpha1.Params{
-		MaxMetadataLength: req.MaxMetadataLength,
+	if err := validateMinProposalDeposit(req.MinProposalDeposit); err != nil {
+		return nil, err
+	}
+
+	if err := validateMessageTypeFilters(req.AllowedMessageTypes); err != nil {
+		return nil, errors.Wrap(err, "allowed_message_types")
+	}
+
+	if err := validateMessageTypeFilters(req.DeniedMessageTypes); err != nil {
+		return nil, errors.Wrap(err, "denied_message_types")
+	}
+
+	if err := k.validateAuthority(authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.UpdateParams(ctx, &escrowv1alpha1.Params{
+		MaxMetadataLength:   req.MaxMetadataLength,
+		ExecFeeFlat:         req.ExecFeeFlat,
+		ExecFeeBps:          req.ExecFeeBps,
+		MinProposalDeposit:  req.MinProposalDeposit,
+		BurnExpiredDeposits: req.BurnExpiredDeposits,
+		MaxPreActions:       req.MaxPreActions,
+		MaxPostActions:      req.MaxPostActions,
+		MaxActions:          req.MaxActions,
+		MaxAgentsPerExec:    req.MaxAgentsPerExec,
+		MaxActionSize:       req.MaxActionSize,
+		AllowedMessageTypes: req.AllowedMessageTypes,
+		DeniedMessageTypes:  req.DeniedMessageTypes,
+		MaxNestingDepth:     req.MaxNestingDepth,
+		GasPerAgent:         req.GasPerAgent,
+		GasPerAction:        req.GasPerAction,
+		GasPerActionByte:    req.GasPerActionByte,
+		MaxPrunesPerBlock:   req.MaxPrunesPerBlock,
+		MaxRefundActions:    req.MaxRefundActions,
+		MaxSimulateGas:      req.MaxSimulateGas,
 	}); err != nil {
 		return nil, err
 	}
 
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&escrowv1alpha1.EventUpdateParams{
-		Authority:         req.Authority,
-		MaxMetadataLength: req.MaxMetadataLength,
+		Authority:           req.Authority,
+		MaxMetadataLength:   req.MaxMetadataLength,
+		ExecFeeFlat:         req.ExecFeeFlat,
+		ExecFeeBps:          req.ExecFeeBps,
+		MinProposalDeposit:  req.MinProposalDeposit,
+		BurnExpiredDeposits: req.BurnExpiredDeposits,
+		MaxPreActions:       req.MaxPreActions,
+		MaxPostActions:      req.MaxPostActions,
+		MaxActions:          req.MaxActions,
+		MaxAgentsPerExec:    req.MaxAgentsPerExec,
+		MaxActionSize:       req.MaxActionSize,
+		AllowedMessageTypes: req.AllowedMessageTypes,
+		DeniedMessageTypes:  req.DeniedMessageTypes,
+		MaxNestingDepth:     req.MaxNestingDepth,
+		GasPerAgent:         req.GasPerAgent,
+		GasPerAction:        req.GasPerAction,
+		GasPerActionByte:    req.GasPerActionByte,
+		MaxPrunesPerBlock:   req.MaxPrunesPerBlock,
+		MaxRefundActions:    req.MaxRefundActions,
+		MaxSimulateGas:      req.MaxSimulateGas,
 	}); err != nil {
 		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
 	}
@@ -59,21 +168,31 @@ func (s msgServer) UpdateParams(ctx context.Context, req *escrowv1alpha1.MsgUpda
 }
 
 func (s msgServer) CreateAgent(ctx context.Context, req *escrowv1alpha1.MsgCreateAgent) (*escrowv1alpha1.MsgCreateAgentResponse, error) {
+	return s.keeper.HandleCreateAgent(ctx, req)
+}
+
+// HandleCreateAgent validates and handles Msg/CreateAgent, except the
+// signatures of its signers.
+func (k Keeper) HandleCreateAgent(ctx context.Context, req *escrowv1alpha1.MsgCreateAgent) (*escrowv1alpha1.MsgCreateAgentResponse, error) {
+	if err := k.validateNestingDepth(ctx); err != nil {
+		return nil, err
+	}
+
 	if req.Creator == "" {
 		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil creator")
 	}
 
-	creator, err := s.keeper.addressStringToBytes(req.Creator)
+	creator, err := k.addressStringToBytes(req.Creator)
 	if err != nil {
 		return nil, errors.Wrap(err, "creator")
 	}
 
-	agent, err := s.keeper.CreateAgent(ctx, creator)
+	agent, err := k.CreateAgent(ctx, creator)
 	if err != nil {
 		return nil, err
 	}
 
-	agentStr, err := s.keeper.addressBytesToString(agent)
+	agentStr, err := k.addressBytesToString(agent)
 	if err != nil {
 		return nil, errors.Wrap(escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error()), "agent")
 	}
@@ -89,6 +208,16 @@ func (s msgServer) CreateAgent(ctx context.Context, req *escrowv1alpha1.MsgCreat
 }
 
 func (s msgServer) SubmitProposal(ctx context.Context, req *escrowv1alpha1.MsgSubmitProposal) (*escrowv1alpha1.MsgSubmitProposalResponse, error) {
+	return s.keeper.HandleSubmitProposal(ctx, req)
+}
+
+// HandleSubmitProposal validates and handles Msg/SubmitProposal, except the
+// signatures of its signers.
+func (k Keeper) HandleSubmitProposal(ctx context.Context, req *escrowv1alpha1.MsgSubmitProposal) (*escrowv1alpha1.MsgSubmitProposalResponse, error) {
+	if err := k.validateNestingDepth(ctx); err != nil {
+		return nil, err
+	}
+
 	if req.Proposer == "" {
 		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil proposer")
 	}
@@ -109,44 +238,82 @@ func (s msgServer) SubmitProposal(ctx context.Context, req *escrowv1alpha1.MsgSu
 		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil metadata")
 	}
 
-	proposer, err := s.keeper.addressStringToBytes(req.Proposer)
+	if req.RefundActions == nil {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil refund_actions")
+	}
+
+	proposer, err := k.addressStringToBytes(req.Proposer)
 	if err != nil {
 		return nil, errors.Wrap(err, "proposer")
 	}
 
-	agent, err := s.keeper.addressStringToBytes(req.Agent)
+	agent, err := k.addressStringToBytes(req.Agent)
 	if err != nil {
 		return nil, errors.Wrap(err, "agent")
 	}
 
+	executors, err := k.executorsStringToBytes(req.Executors)
+	if err != nil {
+		return nil, errors.Wrap(err, "executors")
+	}
+
+	if err := k.validateProposalLimits(ctx, req.PreActions, req.PostActions, req.RefundActions); err != nil {
+		return nil, err
+	}
+
 	signers := []sdk.AccAddress{proposer, agent}
 
-	if err := s.keeper.validateActions(req.PreActions, signers); err != nil {
+	if err := k.validateActions(ctx, req.PreActions, signers); err != nil {
 		return nil, errors.Wrap(err, "pre_actions")
 	}
 
-	if err := s.keeper.validateActions(req.PostActions, signers); err != nil {
+	if err := k.validateActions(ctx, req.PostActions, signers); err != nil {
 		return nil, errors.Wrap(err, "post_actions")
 	}
 
-	if err := s.keeper.SubmitProposal(ctx, proposer, agent, req.PreActions, req.PostActions, req.Metadata); err != nil {
+	if err := k.validateActions(ctx, req.RefundActions, []sdk.AccAddress{agent}); err != nil {
+		return nil, errors.Wrap(err, "refund_actions")
+	}
+
+	deposit, preActionResponses, err := k.SubmitProposal(ctx, proposer, agent, req.PreActions, req.PostActions, req.Metadata, req.RefundActions, req.ExpireHeight, req.ExpireTime, req.Quantity, executors, req.ExecutorGroupId, req.MaxExecGas)
+	if err != nil {
 		return nil, err
 	}
 
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&escrowv1alpha1.EventSubmitProposal{
-		Proposer:    req.Proposer,
-		Agent:       req.Agent,
-		PreActions:  req.PreActions,
-		PostActions: req.PostActions,
-		Metadata:    req.Metadata,
+		Proposer:        req.Proposer,
+		Agent:           req.Agent,
+		PreActions:      req.PreActions,
+		PostActions:     req.PostActions,
+		Metadata:        req.Metadata,
+		RefundActions:   req.RefundActions,
+		ExpireHeight:    req.ExpireHeight,
+		ExpireTime:      req.ExpireTime,
+		Quantity:        req.Quantity,
+		Executors:       req.Executors,
+		ExecutorGroupId: req.ExecutorGroupId,
+		Deposit:         deposit,
+		MaxExecGas:      req.MaxExecGas,
 	}); err != nil {
 		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
 	}
 
-	return &escrowv1alpha1.MsgSubmitProposalResponse{}, nil
+	return &escrowv1alpha1.MsgSubmitProposalResponse{
+		PreActionResponses: preActionResponses,
+	}, nil
 }
 
 func (s msgServer) Exec(ctx context.Context, req *escrowv1alpha1.MsgExec) (*escrowv1alpha1.MsgExecResponse, error) {
+	return s.keeper.HandleExec(ctx, req)
+}
+
+// HandleExec validates and handles Msg/Exec, except the signatures of its
+// signers.
+func (k Keeper) HandleExec(ctx context.Context, req *escrowv1alpha1.MsgExec) (*escrowv1alpha1.MsgExecResponse, error) {
+	if err := k.validateNestingDepth(ctx); err != nil {
+		return nil, err
+	}
+
 	if req.Executor == "" {
 		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil executor")
 	}
@@ -159,37 +326,190 @@ func (s msgServer) Exec(ctx context.Context, req *escrowv1alpha1.MsgExec) (*escr
 		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil actions")
 	}
 
-	executor, err := s.keeper.addressStringToBytes(req.Executor)
+	if len(req.Quantities) != 0 && len(req.Quantities) != len(req.Agents) {
+		return nil, escrowv1alpha1.ErrInvalidMessage.Wrap("quantities must be empty or have the same length as agents")
+	}
+
+	if err := req.DeclaredValue.Validate(); err != nil {
+		return nil, errors.Wrap(escrowv1alpha1.ErrInvalidMessage.Wrap(err.Error()), "declared_value")
+	}
+
+	if err := k.validateExecLimits(ctx, len(req.Agents), req.Actions); err != nil {
+		return nil, err
+	}
+
+	executor, err := k.addressStringToBytes(req.Executor)
 	if err != nil {
 		return nil, errors.Wrap(err, "executor")
 	}
 
+	// a proposal is filled once per execution
 	agents := make([]sdk.AccAddress, len(req.Agents))
+	seen := map[string]bool{}
 	for i, agent := range req.Agents {
 		var err error
-		agents[i], err = s.keeper.addressStringToBytes(agent)
+		agents[i], err = k.addressStringToBytes(agent)
 		if err != nil {
 			return nil, errors.Wrap(indexedError(err, i), "agents")
 		}
+
+		if seen[string(agents[i])] {
+			return nil, errors.Wrap(indexedError(escrowv1alpha1.ErrDuplicateEntry, i), "agents")
+		}
+		seen[string(agents[i])] = true
 	}
 
 	signers := append([]sdk.AccAddress{executor}, agents...)
 
-	if err := s.keeper.validateActions(req.Actions, signers); err != nil {
+	if err := k.validateActions(ctx, req.Actions, signers); err != nil {
 		return nil, errors.Wrap(err, "actions")
 	}
 
-	if err := s.keeper.Exec(ctx, agents, req.Actions); err != nil {
+	quantities, fee, actionResponses, postActionResponses, err := k.Exec(ctx, executor, agents, req.Actions, req.Quantities, req.DeclaredValue)
+	if err != nil {
 		return nil, err
 	}
 
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&escrowv1alpha1.EventExec{
-		Executor: req.Executor,
-		Agents:   req.Agents,
-		Actions:  req.Actions,
+		Executor:      req.Executor,
+		Agents:        req.Agents,
+		Actions:       req.Actions,
+		Quantities:    quantities,
+		DeclaredValue: req.DeclaredValue,
+		Fee:           fee,
+	}); err != nil {
+		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+
+	postActionResponsesByAgent := make([]*escrowv1alpha1.MsgExecResponse_PostActionResponses, len(req.Agents))
+	for i, agent := range req.Agents {
+		postActionResponsesByAgent[i] = &escrowv1alpha1.MsgExecResponse_PostActionResponses{
+			Agent:     agent,
+			Responses: postActionResponses[i],
+		}
+	}
+
+	return &escrowv1alpha1.MsgExecResponse{
+		ActionResponses:     actionResponses,
+		PostActionResponses: postActionResponsesByAgent,
+	}, nil
+}
+
+func (s msgServer) CancelProposal(ctx context.Context, req *escrowv1alpha1.MsgCancelProposal) (*escrowv1alpha1.MsgCancelProposalResponse, error) {
+	return s.keeper.HandleCancelProposal(ctx, req)
+}
+
+// HandleCancelProposal validates and handles Msg/CancelProposal, except the
+// signatures of its signers.
+func (k Keeper) HandleCancelProposal(ctx context.Context, req *escrowv1alpha1.MsgCancelProposal) (*escrowv1alpha1.MsgCancelProposalResponse, error) {
+	if err := k.validateNestingDepth(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Proposer == "" {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil proposer")
+	}
+
+	if req.Agent == "" {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil agent")
+	}
+
+	proposer, err := k.addressStringToBytes(req.Proposer)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposer")
+	}
+
+	agent, err := k.addressStringToBytes(req.Agent)
+	if err != nil {
+		return nil, errors.Wrap(err, "agent")
+	}
+
+	proposal, refundActions, err := k.CancelProposal(ctx, proposer, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&escrowv1alpha1.EventCancelProposal{
+		Proposer:      req.Proposer,
+		Agent:         req.Agent,
+		RefundActions: refundActions,
+		Deposit:       proposal.Deposit,
+	}); err != nil {
+		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
+	}
+
+	return &escrowv1alpha1.MsgCancelProposalResponse{}, nil
+}
+
+func (s msgServer) UpdateProposal(ctx context.Context, req *escrowv1alpha1.MsgUpdateProposal) (*escrowv1alpha1.MsgUpdateProposalResponse, error) {
+	return s.keeper.HandleUpdateProposal(ctx, req)
+}
+
+// HandleUpdateProposal validates and handles Msg/UpdateProposal, except the
+// signatures of its signers.
+func (k Keeper) HandleUpdateProposal(ctx context.Context, req *escrowv1alpha1.MsgUpdateProposal) (*escrowv1alpha1.MsgUpdateProposalResponse, error) {
+	if err := k.validateNestingDepth(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Proposer == "" {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil proposer")
+	}
+
+	if req.Agent == "" {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nil agent")
+	}
+
+	if len(req.PreActions) == 0 && len(req.PostActions) == 0 && req.Metadata == "" && len(req.RefundActions) == 0 {
+		return nil, escrowv1alpha1.ErrUnimplemented.Wrap("nothing to update")
+	}
+
+	proposer, err := k.addressStringToBytes(req.Proposer)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposer")
+	}
+
+	agent, err := k.addressStringToBytes(req.Agent)
+	if err != nil {
+		return nil, errors.Wrap(err, "agent")
+	}
+
+	if err := k.validateProposalLimits(ctx, req.PreActions, req.PostActions, req.RefundActions); err != nil {
+		return nil, err
+	}
+
+	signers := []sdk.AccAddress{proposer, agent}
+
+	if err := k.validateActions(ctx, req.PreActions, signers); err != nil {
+		return nil, errors.Wrap(err, "pre_actions")
+	}
+
+	if err := k.validateActions(ctx, req.PostActions, signers); err != nil {
+		return nil, errors.Wrap(err, "post_actions")
+	}
+
+	if err := k.validateActions(ctx, req.RefundActions, []sdk.AccAddress{agent}); err != nil {
+		return nil, errors.Wrap(err, "refund_actions")
+	}
+
+	before, after, err := k.UpdateProposal(ctx, proposer, agent, req.PreActions, req.PostActions, req.Metadata, req.RefundActions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&escrowv1alpha1.EventUpdateProposal{
+		Proposer:            req.Proposer,
+		Agent:               req.Agent,
+		PreActions:          req.PreActions,
+		PostActionsBefore:   before.PostActions,
+		PostActionsAfter:    after.PostActions,
+		MetadataBefore:      before.Metadata,
+		MetadataAfter:       after.Metadata,
+		RefundActionsBefore: before.RefundActions,
+		RefundActionsAfter:  after.RefundActions,
 	}); err != nil {
 		return nil, escrowv1alpha1.ErrInvariantBroken.Wrap(err.Error())
 	}
 
-	return &escrowv1alpha1.MsgExecResponse{}, nil
+	return &escrowv1alpha1.MsgUpdateProposalResponse{}, nil
 }
@@ -1,6 +1,9 @@
 package internal_test
 
 import (
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
@@ -21,8 +24,26 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 		s.Require().Len(events, 1)
 
 		eventExpected, err := sdk.TypedEventToEvent(&escrowv1alpha1.EventUpdateParams{
-			Authority:         subject.Authority,
-			MaxMetadataLength: subject.MaxMetadataLength,
+			Authority:           subject.Authority,
+			MaxMetadataLength:   subject.MaxMetadataLength,
+			ExecFeeFlat:         subject.ExecFeeFlat,
+			ExecFeeBps:          subject.ExecFeeBps,
+			MinProposalDeposit:  subject.MinProposalDeposit,
+			BurnExpiredDeposits: subject.BurnExpiredDeposits,
+			MaxPreActions:       subject.MaxPreActions,
+			MaxPostActions:      subject.MaxPostActions,
+			MaxActions:          subject.MaxActions,
+			MaxAgentsPerExec:    subject.MaxAgentsPerExec,
+			MaxActionSize:       subject.MaxActionSize,
+			AllowedMessageTypes: subject.AllowedMessageTypes,
+			DeniedMessageTypes:  subject.DeniedMessageTypes,
+			MaxNestingDepth:     subject.MaxNestingDepth,
+			GasPerAgent:         subject.GasPerAgent,
+			GasPerAction:        subject.GasPerAction,
+			GasPerActionByte:    subject.GasPerActionByte,
+			MaxPrunesPerBlock:   subject.MaxPrunesPerBlock,
+			MaxRefundActions:    subject.MaxRefundActions,
+			MaxSimulateGas:      subject.MaxSimulateGas,
 		})
 		s.Require().NoError(err)
 		s.Require().Equal(eventExpected, events[0])
@@ -62,6 +83,204 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 				},
 			},
 		},
+		{
+			"valid structural limits": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+			},
+			"nil max_pre_actions": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_post_actions": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_actions": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_agents_per_exec": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_action_size": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_prunes_per_block": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_refund_actions": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxSimulateGas = s.keeper.DefaultGenesis().Params.MaxSimulateGas
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"nil max_simulate_gas": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MaxPreActions = s.keeper.DefaultGenesis().Params.MaxPreActions
+					subject.MaxPostActions = s.keeper.DefaultGenesis().Params.MaxPostActions
+					subject.MaxActions = s.keeper.DefaultGenesis().Params.MaxActions
+					subject.MaxAgentsPerExec = s.keeper.DefaultGenesis().Params.MaxAgentsPerExec
+					subject.MaxActionSize = s.keeper.DefaultGenesis().Params.MaxActionSize
+					subject.MaxPrunesPerBlock = s.keeper.DefaultGenesis().Params.MaxPrunesPerBlock
+					subject.MaxRefundActions = s.keeper.DefaultGenesis().Params.MaxRefundActions
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+		},
+		{
+			"no exec fee": {},
+			"valid exec_fee_flat": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					feeFlat := sdk.NewInt64Coin("stake", 10)
+					subject.ExecFeeFlat = &feeFlat
+				},
+			},
+			"invalid exec_fee_flat": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.ExecFeeFlat = &sdk.Coin{
+						Denom:  "stake",
+						Amount: math.NewInt(-1),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+			"valid exec_fee_bps": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.ExecFeeBps = 10000
+				},
+			},
+			"exec_fee_bps over limit": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.ExecFeeBps = 10001
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+			"both of exec_fee_flat and exec_fee_bps": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					feeFlat := sdk.NewInt64Coin("stake", 10)
+					subject.ExecFeeFlat = &feeFlat
+					subject.ExecFeeBps = 100
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"no min_proposal_deposit": {},
+			"valid min_proposal_deposit": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MinProposalDeposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+				},
+			},
+			"invalid min_proposal_deposit": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.MinProposalDeposit = sdk.Coins{
+						{
+							Denom:  "stake",
+							Amount: math.NewInt(-1),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"forward expired deposits to community pool": {},
+			"burn expired deposits": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateParams) {
+					subject.BurnExpiredDeposits = true
+				},
+			},
+		},
 	}
 
 	testutil.DoTest(s.T(), tester, cases)
@@ -126,15 +345,23 @@ func (s *KeeperTestSuite) TestMsgSubmitProposal() {
 		}
 		s.Require().NotNil(res)
 
+		s.Require().Len(res.PreActionResponses, len(subject.PreActions))
+		for _, response := range res.PreActionResponses {
+			s.Require().Equal(sdk.MsgTypeURL(&testv1alpha1.MsgSendResponse{}), response.TypeUrl)
+		}
+
 		events := ctx.EventManager().Events()
 		s.Require().NotEmpty(events)
 
 		eventExpected, err := sdk.TypedEventToEvent(&escrowv1alpha1.EventSubmitProposal{
-			Proposer:    subject.Proposer,
-			Agent:       subject.Agent,
-			PreActions:  subject.PreActions,
-			PostActions: subject.PostActions,
-			Metadata:    subject.Metadata,
+			Proposer:        subject.Proposer,
+			Agent:           subject.Agent,
+			PreActions:      subject.PreActions,
+			PostActions:     subject.PostActions,
+			Metadata:        subject.Metadata,
+			RefundActions:   subject.RefundActions,
+			Executors:       subject.Executors,
+			ExecutorGroupId: subject.ExecutorGroupId,
 		})
 		s.Require().NoError(err)
 		s.Require().Equal(eventExpected, events[len(events)-1])
@@ -246,6 +473,65 @@ func (s *KeeperTestSuite) TestMsgSubmitProposal() {
 				},
 			},
 		},
+		{
+			"nil refund_actions": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid refund_actions": {
+				Malleate: func(subject *escrowv1alpha1.MsgSubmitProposal) {
+					subject.RefundActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentIdle),
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     "snake",
+						},
+					})
+				},
+			},
+			"refund_actions not signed by agent": {
+				Malleate: func(subject *escrowv1alpha1.MsgSubmitProposal) {
+					subject.RefundActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.seller),
+							Recipient: s.addressBytesToString(s.agentIdle),
+							Asset:     "voucher",
+						},
+					})
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"empty executors": {},
+			"valid executors": {
+				Malleate: func(subject *escrowv1alpha1.MsgSubmitProposal) {
+					subject.Executors = []string{s.addressBytesToString(s.buyer)}
+				},
+			},
+			"invalid executors": {
+				Malleate: func(subject *escrowv1alpha1.MsgSubmitProposal) {
+					subject.Executors = []string{notInBech32}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+			"duplicate executors": {
+				Malleate: func(subject *escrowv1alpha1.MsgSubmitProposal) {
+					subject.Executors = []string{
+						s.addressBytesToString(s.buyer),
+						s.addressBytesToString(s.buyer),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrDuplicateEntry
+				},
+			},
+		},
 	}
 
 	testutil.DoTest(s.T(), tester, cases)
@@ -254,19 +540,48 @@ func (s *KeeperTestSuite) TestMsgSubmitProposal() {
 func (s *KeeperTestSuite) TestMsgExec() {
 	tester := func(subject escrowv1alpha1.MsgExec) error {
 		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		// the proposals are removed on the execution
+		numPostActions := make([]int, len(subject.Agents))
+		for i, agentStr := range subject.Agents {
+			agent, err := s.addressCodec.StringToBytes(agentStr)
+			if err != nil {
+				continue
+			}
+
+			if proposal, err := s.keeper.GetProposal(ctx, agent); err == nil {
+				numPostActions[i] = len(proposal.PostActions)
+			}
+		}
+
 		res, err := s.msgServer.Exec(ctx, &subject)
 		if err != nil {
 			return err
 		}
 		s.Require().NotNil(res)
 
+		s.Require().Len(res.ActionResponses, len(subject.Actions))
+		for _, response := range res.ActionResponses {
+			s.Require().Equal(sdk.MsgTypeURL(&testv1alpha1.MsgSendResponse{}), response.TypeUrl)
+		}
+
+		s.Require().Len(res.PostActionResponses, len(subject.Agents))
+		for i, responses := range res.PostActionResponses {
+			s.Require().Equal(subject.Agents[i], responses.Agent)
+			s.Require().Len(responses.Responses, numPostActions[i])
+		}
+
 		events := ctx.EventManager().Events()
 		s.Require().NotEmpty(events)
 
+		// the proposals in the setup are not fillable partially
 		eventExpected, err := sdk.TypedEventToEvent(&escrowv1alpha1.EventExec{
-			Executor: subject.Executor,
-			Agents:   subject.Agents,
-			Actions:  subject.Actions,
+			Executor:      subject.Executor,
+			Agents:        subject.Agents,
+			Actions:       subject.Actions,
+			Quantities:    make([]uint64, len(subject.Agents)),
+			DeclaredValue: subject.DeclaredValue,
+			Fee:           sdk.NewCoins(),
 		})
 		s.Require().NoError(err)
 		s.Require().Equal(eventExpected, events[len(events)-1])
@@ -329,6 +644,17 @@ func (s *KeeperTestSuite) TestMsgExec() {
 					return escrowv1alpha1.ErrPermissionDenied
 				},
 			},
+			"duplicate agents": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.Agents = []string{
+						s.addressBytesToString(s.agentAny),
+						s.addressBytesToString(s.agentAny),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrDuplicateEntry
+				},
+			},
 		},
 		{
 			"nil actions": {
@@ -353,6 +679,606 @@ func (s *KeeperTestSuite) TestMsgExec() {
 				},
 			},
 		},
+		{
+			"empty quantities": {},
+			"valid quantities": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.Quantities = make([]uint64, len(subject.Agents))
+				},
+			},
+			"quantities not matching agents": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.Quantities = make([]uint64, len(subject.Agents)+1)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"empty declared_value": {},
+			"valid declared_value": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.DeclaredValue = sdk.NewCoins(sdk.NewInt64Coin("stake", 42))
+				},
+			},
+			"invalid declared_value": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.DeclaredValue = sdk.Coins{
+						{
+							Denom:  "stake",
+							Amount: math.NewInt(-1),
+						},
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestMsgCancelProposal() {
+	tester := func(subject escrowv1alpha1.MsgCancelProposal) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := s.msgServer.CancelProposal(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+
+		events := ctx.EventManager().Events()
+		s.Require().NotEmpty(events)
+
+		eventExpected, err := sdk.TypedEventToEvent(&escrowv1alpha1.EventCancelProposal{
+			Proposer: subject.Proposer,
+			Agent:    subject.Agent,
+			RefundActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentAny),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "dog",
+				},
+			}),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(eventExpected, events[len(events)-1])
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.MsgCancelProposal]{
+		{
+			"nil proposer": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Proposer = s.addressBytesToString(s.seller)
+				},
+			},
+			"invalid proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Proposer = notInBech32
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+			"not proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Proposer = s.addressBytesToString(s.stranger)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"nil agent": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid agent": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Agent = s.addressBytesToString(s.agentAny)
+				},
+			},
+			"invalid agent": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Agent = notInBech32
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+			"proposal not found": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Agent = s.addressBytesToString(s.agentIdle)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalNotFound
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateProposal() {
+	tester := func(subject escrowv1alpha1.MsgUpdateProposal) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := s.msgServer.UpdateProposal(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+
+		events := ctx.EventManager().Events()
+		s.Require().NotEmpty(events)
+
+		agent, err := s.addressCodec.StringToBytes(subject.Agent)
+		s.Require().NoError(err)
+
+		before, err := s.keeper.GetProposal(s.ctx, agent)
+		s.Require().NoError(err)
+
+		after, err := s.keeper.GetProposal(ctx, agent)
+		s.Require().NoError(err)
+
+		eventExpected, err := sdk.TypedEventToEvent(&escrowv1alpha1.EventUpdateProposal{
+			Proposer:            subject.Proposer,
+			Agent:               subject.Agent,
+			PreActions:          subject.PreActions,
+			PostActionsBefore:   before.PostActions,
+			PostActionsAfter:    after.PostActions,
+			MetadataBefore:      before.Metadata,
+			MetadataAfter:       after.Metadata,
+			RefundActionsBefore: before.RefundActions,
+			RefundActionsAfter:  after.RefundActions,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(eventExpected, events[len(events)-1])
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.MsgUpdateProposal]{
+		{
+			"nil proposer": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Proposer = s.addressBytesToString(s.seller)
+				},
+			},
+			"invalid proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Proposer = notInBech32
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+			"not proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Proposer = s.addressBytesToString(s.stranger)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"nil agent": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid agent": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Agent = s.addressBytesToString(s.agentAny)
+				},
+			},
+			"invalid agent": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Agent = notInBech32
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+			"proposal not found": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Agent = s.addressBytesToString(s.agentIdle)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalNotFound
+				},
+			},
+		},
+		{
+			"empty pre_actions": {},
+			"valid pre_actions": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.PreActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.seller),
+							Recipient: subject.Agent,
+							Asset:     "snake",
+						},
+					})
+				},
+			},
+			"pre_actions not signed by proposer or agent": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.PreActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.stranger),
+							Recipient: subject.Agent,
+							Asset:     "voucher",
+						},
+					})
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"empty post_actions": {},
+			"valid post_actions": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.PostActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    subject.Agent,
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     "voucher",
+						},
+						&testv1alpha1.MsgSend{
+							Sender:    subject.Agent,
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     "voucher",
+						},
+					})
+				},
+			},
+		},
+		{
+			"valid metadata": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Metadata = "sell a dog for two vouchers"
+				},
+			},
+		},
+		{
+			"empty refund_actions": {},
+			"refund_actions not signed by agent": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.RefundActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.seller),
+							Recipient: subject.Agent,
+							Asset:     "snake",
+						},
+					})
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestHandleCreateAgent() {
+	tester := func(subject escrowv1alpha1.MsgCreateAgent) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := s.keeper.HandleCreateAgent(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+		s.Require().Len(ctx.EventManager().Events(), 1)
+
+		agent, err := s.addressCodec.StringToBytes(res.Agent)
+		s.Require().NoError(err)
+
+		agentInfo, err := s.keeper.GetAgent(ctx, agent)
+		s.Require().NoError(err)
+		s.Require().Equal(subject.Creator, s.addressBytesToString(agentInfo.Creator))
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.MsgCreateAgent]{
+		{
+			"nil creator": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid creator": {
+				Malleate: func(subject *escrowv1alpha1.MsgCreateAgent) {
+					subject.Creator = s.addressBytesToString(s.stranger)
+				},
+			},
+			"invalid creator": {
+				Malleate: func(subject *escrowv1alpha1.MsgCreateAgent) {
+					subject.Creator = notInBech32
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestHandleSubmitProposal() {
+	type submitProposal struct {
+		proposer sdk.AccAddress
+		sender   sdk.AccAddress
+	}
+
+	tester := func(subject submitProposal) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := s.keeper.HandleSubmitProposal(ctx, &escrowv1alpha1.MsgSubmitProposal{
+			Proposer: s.addressBytesToString(subject.proposer),
+			Agent:    s.addressBytesToString(s.agentIdle),
+			PreActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(subject.sender),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			PostActions: []*codectypes.Any{},
+			Metadata:    "give a snake away",
+			RefundActions: s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(subject.proposer),
+					Asset:     "snake",
+				},
+			}),
+		})
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+		s.Require().NotEmpty(ctx.EventManager().Events())
+
+		// the agent is in use by the proposal
+		_, err = s.keeper.GetAgent(ctx, s.agentIdle)
+		s.Require().ErrorIs(err, escrowv1alpha1.ErrAgentNotFound)
+
+		proposal, err := s.keeper.GetProposal(ctx, s.agentIdle)
+		s.Require().NoError(err)
+		s.Require().Equal(subject.proposer, sdk.AccAddress(proposal.Proposer))
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[submitProposal]{
+		{
+			"creator of the agent": {
+				Malleate: func(subject *submitProposal) {
+					subject.proposer = s.seller
+				},
+			},
+			"not creator of the agent": {
+				Malleate: func(subject *submitProposal) {
+					subject.proposer = s.stranger
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"pre_actions of the proposer": {
+				Malleate: func(subject *submitProposal) {
+					subject.sender = subject.proposer
+				},
+			},
+			"pre_actions of a wrong signer": {
+				Malleate: func(subject *submitProposal) {
+					subject.sender = s.buyer
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestHandleExec() {
+	tester := func(subject escrowv1alpha1.MsgExec) error {
+		subject.Executor = s.addressBytesToString(s.stranger)
+		subject.Actions = s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.stranger),
+				Recipient: s.addressBytesToString(s.agentAny),
+				Asset:     "voucher",
+			},
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.agentAny),
+				Recipient: s.addressBytesToString(s.stranger),
+				Asset:     "dog",
+			},
+		})
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := s.keeper.HandleExec(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+		s.Require().NotEmpty(ctx.EventManager().Events())
+
+		// the proposal is removed on its execution
+		_, err = s.keeper.GetProposal(ctx, s.agentAny)
+		s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.MsgExec]{
+		{
+			"valid agents": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.Agents = []string{
+						s.addressBytesToString(s.agentAny),
+					}
+				},
+			},
+			"duplicate agents": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.Agents = []string{
+						s.addressBytesToString(s.agentAny),
+						s.addressBytesToString(s.agentAny),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrDuplicateEntry
+				},
+			},
+			"agent without proposal": {
+				Malleate: func(subject *escrowv1alpha1.MsgExec) {
+					subject.Agents = []string{
+						s.addressBytesToString(s.agentIdle),
+					}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestHandleCancelProposal() {
+	tester := func(subject escrowv1alpha1.MsgCancelProposal) error {
+		subject.Agent = s.addressBytesToString(s.agentAny)
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := s.keeper.HandleCancelProposal(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+		s.Require().NotEmpty(ctx.EventManager().Events())
+
+		// the proposal is removed on its cancellation
+		_, err = s.keeper.GetProposal(ctx, s.agentAny)
+		s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.MsgCancelProposal]{
+		{
+			"proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Proposer = s.addressBytesToString(s.seller)
+				},
+			},
+			"not proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgCancelProposal) {
+					subject.Proposer = s.addressBytesToString(s.stranger)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestHandleUpdateProposal() {
+	tester := func(subject escrowv1alpha1.MsgUpdateProposal) error {
+		subject.Agent = s.addressBytesToString(s.agentAny)
+		subject.Metadata = "give a dog away"
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := s.keeper.HandleUpdateProposal(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+		s.Require().NotEmpty(ctx.EventManager().Events())
+
+		proposal, err := s.keeper.GetProposal(ctx, s.agentAny)
+		s.Require().NoError(err)
+		s.Require().Equal(subject.Metadata, proposal.Metadata)
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1alpha1.MsgUpdateProposal]{
+		{
+			"proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Proposer = s.addressBytesToString(s.seller)
+				},
+			},
+			"not proposer": {
+				Malleate: func(subject *escrowv1alpha1.MsgUpdateProposal) {
+					subject.Proposer = s.addressBytesToString(s.stranger)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestValidateSigner() {
+	tester := func(subject string) error {
+		return s.keeper.ValidateSigner(s.seller, subject)
+	}
+	cases := []map[string]testutil.Case[string]{
+		{
+			"signer": {
+				Malleate: func(subject *string) {
+					*subject = s.addressBytesToString(s.seller)
+				},
+			},
+			"not signer": {
+				Malleate: func(subject *string) {
+					*subject = s.addressBytesToString(s.stranger)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+			"invalid address": {
+				Malleate: func(subject *string) {
+					*subject = notInBech32
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidAddress
+				},
+			},
+		},
 	}
 
 	testutil.DoTest(s.T(), tester, cases)
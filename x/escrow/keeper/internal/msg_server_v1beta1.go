@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	escrowv1beta1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1beta1"
+)
+
+// msgServerV1Beta1 serves v1beta1 by converting its requests and responses
+// from and to those of v1alpha1.
+type msgServerV1Beta1 struct {
+	server escrowv1alpha1.MsgServer
+}
+
+var _ escrowv1beta1.MsgServer = (*msgServerV1Beta1)(nil)
+
+// NewMsgServerV1Beta1 returns an implementation of the v1beta1 MsgServer
+// interface for the provided Keeper.
+func NewMsgServerV1Beta1(keeper Keeper) escrowv1beta1.MsgServer {
+	return &msgServerV1Beta1{
+		server: NewMsgServer(keeper),
+	}
+}
+
+func (s msgServerV1Beta1) UpdateParams(ctx context.Context, req *escrowv1beta1.MsgUpdateParams) (*escrowv1beta1.MsgUpdateParamsResponse, error) {
+	params := req.Params
+
+	_, err := s.server.UpdateParams(ctx, &escrowv1alpha1.MsgUpdateParams{
+		Authority:           req.Authority,
+		MaxMetadataLength:   params.MaxMetadataLength,
+		ExecFeeFlat:         params.ExecFeeFlat,
+		ExecFeeBps:          params.ExecFeeBps,
+		MinProposalDeposit:  params.MinProposalDeposit,
+		BurnExpiredDeposits: params.BurnExpiredDeposits,
+		MaxPreActions:       params.MaxPreActions,
+		MaxPostActions:      params.MaxPostActions,
+		MaxActions:          params.MaxActions,
+		MaxAgentsPerExec:    params.MaxAgentsPerExec,
+		MaxActionSize:       params.MaxActionSize,
+		AllowedMessageTypes: params.AllowedMessageTypes,
+		DeniedMessageTypes:  params.DeniedMessageTypes,
+		MaxNestingDepth:     params.MaxNestingDepth,
+		GasPerAgent:         params.GasPerAgent,
+		GasPerAction:        params.GasPerAction,
+		GasPerActionByte:    params.GasPerActionByte,
+		MaxPrunesPerBlock:   params.MaxPrunesPerBlock,
+		MaxRefundActions:    params.MaxRefundActions,
+		MaxSimulateGas:      params.MaxSimulateGas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &escrowv1beta1.MsgUpdateParamsResponse{}, nil
+}
+
+func (s msgServerV1Beta1) CreateAgent(ctx context.Context, req *escrowv1beta1.MsgCreateAgent) (*escrowv1beta1.MsgCreateAgentResponse, error) {
+	res, err := s.server.CreateAgent(ctx, (*escrowv1alpha1.MsgCreateAgent)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return (*escrowv1beta1.MsgCreateAgentResponse)(res), nil
+}
+
+func (s msgServerV1Beta1) SubmitProposal(ctx context.Context, req *escrowv1beta1.MsgSubmitProposal) (*escrowv1beta1.MsgSubmitProposalResponse, error) {
+	res, err := s.server.SubmitProposal(ctx, (*escrowv1alpha1.MsgSubmitProposal)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return (*escrowv1beta1.MsgSubmitProposalResponse)(res), nil
+}
+
+func (s msgServerV1Beta1) Exec(ctx context.Context, req *escrowv1beta1.MsgExec) (*escrowv1beta1.MsgExecResponse, error) {
+	res, err := s.server.Exec(ctx, (*escrowv1alpha1.MsgExec)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	postActionResponses := make([]*escrowv1beta1.MsgExecResponse_PostActionResponses, len(res.PostActionResponses))
+	for i, responses := range res.PostActionResponses {
+		postActionResponses[i] = (*escrowv1beta1.MsgExecResponse_PostActionResponses)(responses)
+	}
+
+	return &escrowv1beta1.MsgExecResponse{
+		ActionResponses:     res.ActionResponses,
+		PostActionResponses: postActionResponses,
+	}, nil
+}
+
+func (s msgServerV1Beta1) CancelProposal(ctx context.Context, req *escrowv1beta1.MsgCancelProposal) (*escrowv1beta1.MsgCancelProposalResponse, error) {
+	res, err := s.server.CancelProposal(ctx, (*escrowv1alpha1.MsgCancelProposal)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return (*escrowv1beta1.MsgCancelProposalResponse)(res), nil
+}
+
+func (s msgServerV1Beta1) UpdateProposal(ctx context.Context, req *escrowv1beta1.MsgUpdateProposal) (*escrowv1beta1.MsgUpdateProposalResponse, error) {
+	res, err := s.server.UpdateProposal(ctx, (*escrowv1alpha1.MsgUpdateProposal)(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return (*escrowv1beta1.MsgUpdateProposalResponse)(res), nil
+}
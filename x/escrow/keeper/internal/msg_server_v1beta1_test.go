@@ -0,0 +1,87 @@
+package internal_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	escrowv1beta1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1beta1"
+	keeper "github.com/0tech/andromeda/x/escrow/keeper/internal"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestMsgUpdateParamsV1Beta1() {
+	msgServer := keeper.NewMsgServerV1Beta1(*s.keeper)
+
+	tester := func(subject escrowv1beta1.MsgUpdateParams) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		res, err := msgServer.UpdateParams(ctx, &subject)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(res)
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(subject.Params, escrowv1beta1.Params(*params))
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[escrowv1beta1.MsgUpdateParams]{
+		{
+			"nil authority": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid authority": {
+				Malleate: func(subject *escrowv1beta1.MsgUpdateParams) {
+					subject.Authority = s.addressBytesToString(s.keeper.GetAuthority())
+				},
+			},
+		},
+		{
+			"nil params": {
+				Error: func() error {
+					return escrowv1alpha1.ErrUnimplemented
+				},
+			},
+			"valid params": {
+				Malleate: func(subject *escrowv1beta1.MsgUpdateParams) {
+					params := s.keeper.DefaultGenesis().Params
+					params.MaxMetadataLength++
+					subject.Params = escrowv1beta1.Params(*params)
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestMsgExecV1Beta1() {
+	msgServer := keeper.NewMsgServerV1Beta1(*s.keeper)
+
+	ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+	res, err := msgServer.Exec(ctx, &escrowv1beta1.MsgExec{
+		Executor: s.addressBytesToString(s.buyer),
+		Agents:   []string{s.addressBytesToString(s.agentDedicated)},
+		Actions: s.encodeMsgs([]sdk.Msg{
+			&testv1alpha1.MsgSend{
+				Sender:    s.addressBytesToString(s.buyer),
+				Recipient: s.addressBytesToString(s.agentDedicated),
+				Asset:     "voucher",
+			},
+		}),
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+
+	s.Require().Len(res.ActionResponses, 1)
+	s.Require().Len(res.PostActionResponses, 1)
+	s.Require().Equal(s.addressBytesToString(s.agentDedicated), res.PostActionResponses[0].Agent)
+	s.Require().Len(res.PostActionResponses[0].Responses, 2)
+
+	_, err = s.keeper.GetProposal(ctx, s.agentDedicated)
+	s.Require().ErrorIs(err, escrowv1alpha1.ErrProposalNotFound)
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// nestingDepthKey is the context key of the depth of the actions being
+// executed, which is zero outside of any actions.
+type nestingDepthKey struct{}
+
+func nestingDepth(ctx context.Context) uint64 {
+	depth, _ := ctx.Value(nestingDepthKey{}).(uint64)
+	return depth
+}
+
+func withNestingDepth(ctx sdk.Context, depth uint64) sdk.Context {
+	return ctx.WithValue(nestingDepthKey{}, depth)
+}
+
+// validateNestingDepth checks the depth of the escrow message being handled,
+// which may have been wrapped in the other messages (e.g. Msg/Exec of x/authz)
+// in the actions.
+func (k Keeper) validateNestingDepth(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if depth := nestingDepth(ctx); depth > params.MaxNestingDepth {
+		return errors.Wrapf(escrowv1alpha1.ErrNestingTooDeep.Wrapf("over limit of %d", params.MaxNestingDepth), "%d", depth)
+	}
+
+	return nil
+}
+
+// isEscrowMessage returns whether the message belongs to this module,
+// regardless of its version.
+func isEscrowMessage(msg sdk.Msg) bool {
+	return strings.HasPrefix(sdk.MsgTypeURL(msg), "/andromeda."+escrowv1alpha1.ModuleName+".")
+}
@@ -0,0 +1,188 @@
+package internal_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/testutil"
+	testv1alpha1 "github.com/0tech/andromeda/x/test/andromeda/test/v1alpha1"
+)
+
+func (s *KeeperTestSuite) TestNestingDepth() {
+	type nestingDepth struct {
+		maxNestingDepth uint64
+		nested          []sdk.Msg
+	}
+
+	// an escrow message at the depth 1, which executes the given actions
+	// at the depth 2
+	nestedExec := func(actions ...sdk.Msg) sdk.Msg {
+		return &escrowv1alpha1.MsgExec{
+			Executor: s.addressBytesToString(s.stranger),
+			Agents: []string{
+				s.addressBytesToString(s.agentIdle),
+			},
+			Actions: s.encodeMsgs(append([]sdk.Msg{
+				&testv1alpha1.MsgCreate{
+					Creator: s.addressBytesToString(s.stranger),
+					Asset:   "lizard",
+				},
+			}, actions...)),
+		}
+	}
+
+	// the given message wrapped in Msg/Exec of x/authz, at the same depth
+	wrappedMsg := func(msg sdk.Msg) sdk.Msg {
+		wrapped := authz.NewMsgExec(s.stranger, []sdk.Msg{msg})
+		wrapped.Grantee = s.addressBytesToString(s.stranger)
+		return &wrapped
+	}
+
+	tester := func(subject nestingDepth) error {
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+
+		params, err := s.keeper.GetParams(ctx)
+		s.Require().NoError(err)
+		params.MaxNestingDepth = subject.maxNestingDepth
+		err = s.keeper.UpdateParams(ctx, params)
+		s.Require().NoError(err)
+
+		// give a snake away, for the nested execution
+		_, _, err = s.keeper.SubmitProposal(ctx, s.seller, s.agentIdle,
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.seller),
+					Recipient: s.addressBytesToString(s.agentIdle),
+					Asset:     "snake",
+				},
+			}),
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "snake",
+				},
+			}),
+			"give a snake away",
+			s.encodeMsgs([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentIdle),
+					Recipient: s.addressBytesToString(s.seller),
+					Asset:     "snake",
+				},
+			}),
+			0,
+			nil,
+			0,
+			nil,
+			0,
+			0,
+		)
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.Exec(ctx, &escrowv1alpha1.MsgExec{
+			Executor: s.addressBytesToString(s.stranger),
+			Agents: []string{
+				s.addressBytesToString(s.agentAny),
+			},
+			Actions: s.encodeMsgs(append([]sdk.Msg{
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.stranger),
+					Recipient: s.addressBytesToString(s.agentAny),
+					Asset:     "voucher",
+				},
+				&testv1alpha1.MsgSend{
+					Sender:    s.addressBytesToString(s.agentAny),
+					Recipient: s.addressBytesToString(s.stranger),
+					Asset:     "dog",
+				},
+			}, subject.nested...)),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(subject.nested) != 0 {
+			_, err := s.testQueryServer.Asset(ctx, &testv1alpha1.QueryAssetRequest{
+				Account: s.addressBytesToString(s.stranger),
+				Asset:   "snake",
+			})
+			s.Require().NoError(err)
+		}
+
+		return nil
+	}
+
+	cases := []map[string]testutil.Case[nestingDepth]{
+		{
+			"no nested message": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 0
+				},
+			},
+			"escrow message at depth 1 without nesting allowed": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 0
+					subject.nested = []sdk.Msg{nestedExec()}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrNestingTooDeep
+				},
+			},
+			"escrow message at depth 1 within limit": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 1
+					subject.nested = []sdk.Msg{nestedExec()}
+				},
+			},
+			"escrow message at depth 2 over limit": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 1
+					subject.nested = []sdk.Msg{nestedExec(&escrowv1alpha1.MsgCreateAgent{
+						Creator: s.addressBytesToString(s.stranger),
+					})}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrNestingTooDeep
+				},
+			},
+			"escrow message at depth 2 within limit": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 2
+					subject.nested = []sdk.Msg{nestedExec(&escrowv1alpha1.MsgCreateAgent{
+						Creator: s.addressBytesToString(s.stranger),
+					})}
+				},
+			},
+			"escrow message wrapped at depth 1 without nesting allowed": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 0
+					subject.nested = []sdk.Msg{wrappedMsg(nestedExec())}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrNestingTooDeep
+				},
+			},
+			"escrow message wrapped at depth 1 within limit": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 1
+					subject.nested = []sdk.Msg{wrappedMsg(nestedExec())}
+				},
+			},
+			"escrow message wrapped at depth 2 over limit": {
+				Malleate: func(subject *nestingDepth) {
+					subject.maxNestingDepth = 1
+					subject.nested = []sdk.Msg{nestedExec(wrappedMsg(&escrowv1alpha1.MsgCreateAgent{
+						Creator: s.addressBytesToString(s.stranger),
+					}))}
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrNestingTooDeep
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
@@ -12,27 +13,203 @@ import (
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
 )
 
-func (k Keeper) SubmitProposal(ctx context.Context, proposer, agent sdk.AccAddress, preActions, postActions []*codectypes.Any, metadata string) error {
+func (k Keeper) SubmitProposal(ctx context.Context, proposer, agent sdk.AccAddress, preActions, postActions []*codectypes.Any, metadata string, refundActions []*codectypes.Any, expireHeight uint64, expireTime *time.Time, quantity uint64, executors []sdk.AccAddress, executorGroupID uint64, maxExecGas uint64) (deposit sdk.Coins, preActionResponses []*codectypes.Any, err error) {
 	if err := k.validateMetadata(ctx, metadata); err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	if err := k.validateExpiry(ctx, expireHeight, expireTime); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.validateExecutorPolicy(ctx, executors, executorGroupID); err != nil {
+		return nil, nil, err
+	}
+
+	if quantity != 0 {
+		if err := k.validateScalableActions(postActions); err != nil {
+			return nil, nil, errors.Wrap(err, "post_actions")
+		}
+
+		if err := k.validateFillableRefundActions(refundActions); err != nil {
+			return nil, nil, errors.Wrap(err, "refund_actions")
+		}
 	}
 
 	agentInfo, err := k.GetAgent(ctx, agent)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if !proposer.Equals(sdk.AccAddress(agentInfo.Creator)) {
-		return escrowv1alpha1.ErrPermissionDenied.Wrap("proposer differs from creator")
+		return nil, nil, escrowv1alpha1.ErrPermissionDenied.Wrap("proposer differs from creator")
 	}
 
-	if err := k.setProposal(ctx, agent, &escrowv1alpha1.Proposal{
-		Proposer:    proposer,
-		PreActions:  preActions,
-		PostActions: postActions,
-		Metadata:    metadata,
-	}); err != nil {
-		return err
+	deposit, err = k.collectDeposit(ctx, proposer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	submitTime := sdkCtx.BlockTime()
+	proposal := &escrowv1alpha1.Proposal{
+		Proposer:        proposer,
+		PreActions:      preActions,
+		PostActions:     postActions,
+		Metadata:        metadata,
+		RefundActions:   refundActions,
+		ExpireHeight:    expireHeight,
+		ExpireTime:      expireTime,
+		Quantity:        quantity,
+		Executors:       executorsToBytes(executors),
+		ExecutorGroupId: executorGroupID,
+		Deposit:         deposit,
+		MaxExecGas:      maxExecGas,
+		SubmitHeight:    uint64(sdkCtx.BlockHeight()),
+		SubmitTime:      &submitTime,
+	}
+
+	if err := k.consumeStoredActionsGas(ctx, proposal); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.beforeProposalSubmitted(ctx, agent, proposal); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.setProposal(ctx, agent, proposal); err != nil {
+		return nil, nil, err
+	}
+
+	preActionResponses, err = k.executeActions(ctx, agent, "pre_actions", preActions)
+	if err != nil {
+		return nil, nil, newPhaseError(err, "pre_actions")
+	}
+
+	if err := k.removeAgent(ctx, agent); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.afterProposalSubmitted(ctx, agent, proposal); err != nil {
+		return nil, nil, err
+	}
+
+	return deposit, preActionResponses, nil
+}
+
+func (k Keeper) CancelProposal(ctx context.Context, proposer, agent sdk.AccAddress) (*escrowv1alpha1.Proposal, []*codectypes.Any, error) {
+	proposal, err := k.GetProposal(ctx, agent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !proposer.Equals(sdk.AccAddress(proposal.Proposer)) {
+		return nil, nil, escrowv1alpha1.ErrPermissionDenied.Wrap("not proposer")
+	}
+
+	// the deposit of an expired proposal is forfeited on its pruning, which
+	// keeps the proposal whose refund_actions failed for its proposer
+	if k.isExpired(ctx, proposal) && !proposal.RefundFailed {
+		return nil, nil, escrowv1alpha1.ErrProposalExpired
+	}
+
+	if err := validateRefundable(proposal); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.removeProposal(ctx, agent); err != nil {
+		return nil, nil, err
+	}
+
+	refundActions, err := k.refundActions(proposal)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "refund_actions")
+	}
+
+	for _, phase := range []struct {
+		name    string
+		actions []*codectypes.Any
+	}{
+		{
+			name:    "refund_actions",
+			actions: refundActions,
+		},
+	} {
+		if _, err := k.executeActions(ctx, agent, phase.name, phase.actions); err != nil {
+			return nil, nil, newPhaseError(err, phase.name)
+		}
+	}
+
+	if err := k.refundDeposit(ctx, proposal); err != nil {
+		return nil, nil, err
+	}
+
+	return proposal, refundActions, nil
+}
+
+func (k Keeper) UpdateProposal(ctx context.Context, proposer, agent sdk.AccAddress, preActions, postActions []*codectypes.Any, metadata string, refundActions []*codectypes.Any) (before, after *escrowv1alpha1.Proposal, err error) {
+	before, err = k.GetProposal(ctx, agent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !proposer.Equals(sdk.AccAddress(before.Proposer)) {
+		return nil, nil, escrowv1alpha1.ErrPermissionDenied.Wrap("not proposer")
+	}
+
+	// the proposal whose refund_actions failed on its expiry may have them
+	// replaced, so that its proposer may cancel it
+	if k.isExpired(ctx, before) && !before.RefundFailed {
+		return nil, nil, escrowv1alpha1.ErrProposalExpired
+	}
+
+	// start from the stored proposal, so that the fields not being updated are
+	// kept as they are
+	updated := *before
+	after = &updated
+	after.PreActions = append(append([]*codectypes.Any{}, before.PreActions...), preActions...)
+
+	if len(postActions) != 0 {
+		if after.Quantity != 0 {
+			if err := k.validateScalableActions(postActions); err != nil {
+				return nil, nil, errors.Wrap(err, "post_actions")
+			}
+		}
+
+		after.PostActions = postActions
+	}
+
+	if metadata != "" {
+		if err := k.validateMetadata(ctx, metadata); err != nil {
+			return nil, nil, err
+		}
+
+		after.Metadata = metadata
+	}
+
+	// the refund_actions are kept on an empty request, so those of a fillable
+	// proposal never become empty
+	if len(refundActions) != 0 {
+		if after.Quantity != 0 {
+			if err := k.validateScalableActions(refundActions); err != nil {
+				return nil, nil, errors.Wrap(err, "refund_actions")
+			}
+		}
+
+		after.RefundActions = refundActions
+	}
+
+	// the pre_actions accumulate over the updates
+	if err := k.validateProposalLimits(ctx, after.PreActions, after.PostActions, after.RefundActions); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.consumeStoredActionsGas(ctx, after); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.setProposal(ctx, agent, after); err != nil {
+		return nil, nil, err
 	}
 
 	for _, phase := range []struct {
@@ -44,15 +221,48 @@ func (k Keeper) SubmitProposal(ctx context.Context, proposer, agent sdk.AccAddre
 			actions: preActions,
 		},
 	} {
-		if err := k.executeActions(ctx, phase.actions); err != nil {
-			return errors.Wrap(err, phase.name)
+		if _, err := k.executeActions(ctx, agent, phase.name, phase.actions); err != nil {
+			return nil, nil, newPhaseError(err, phase.name)
 		}
 	}
 
-	return k.removeAgent(ctx, agent)
+	return before, after, nil
+}
+
+// validateRefundable checks whether the proposal has the refund_actions to
+// return the assets reserved by its pre_actions, which the proposals migrated
+// from the consensus version 1 lack. Its proposer should set the refund_actions
+// by Msg/UpdateProposal first.
+func validateRefundable(proposal *escrowv1alpha1.Proposal) error {
+	if len(proposal.PreActions) != 0 && len(proposal.RefundActions) == 0 {
+		return escrowv1alpha1.ErrNoRefundActions.Wrap("the assets of pre_actions would be stranded")
+	}
+
+	return nil
 }
 
-func (k Keeper) validateActions(actions []*codectypes.Any, signers []sdk.AccAddress) error {
+// validateActions checks the actions against the message type filters in the
+// params, and their signers.
+// Note: the filters apply to the actions being submitted only, so that a change
+// of the filters would not lock the assets of the existing proposals.
+func (k Keeper) validateActions(ctx context.Context, actions []*codectypes.Any, signers []sdk.AccAddress) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, action := range actions {
+		if err := validateMessageType(params, action.TypeUrl); err != nil {
+			return indexedError(err, i)
+		}
+	}
+
+	return k.validateActionSigners(actions, signers)
+}
+
+// validateActionSigners checks that the actions unpack to messages, signed only
+// by the given signers.
+func (k Keeper) validateActionSigners(actions []*codectypes.Any, signers []sdk.AccAddress) error {
 	signerMap := map[string]bool{}
 	for _, signer := range signers {
 		signerMap[string(signer)] = true
@@ -124,6 +334,9 @@ func (k Keeper) fixActions(proposal *escrowv1alpha1.Proposal) {
 	if proposal.PostActions == nil {
 		proposal.PostActions = []*codectypes.Any{}
 	}
+	if proposal.RefundActions == nil {
+		proposal.RefundActions = []*codectypes.Any{}
+	}
 }
 
 func (k Keeper) iterateProposals(ctx context.Context, fn func(agent sdk.AccAddress, proposal escrowv1alpha1.Proposal) error) error {
@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -11,11 +13,18 @@ import (
 
 func (s *KeeperTestSuite) TestSubmitProposal() {
 	type submitProposal struct {
-		proposer    sdk.AccAddress
-		agent       sdk.AccAddress
-		preActions  []*codectypes.Any
-		postActions []*codectypes.Any
-		metadata    string
+		proposer      sdk.AccAddress
+		agent         sdk.AccAddress
+		preActions    []*codectypes.Any
+		postActions   []*codectypes.Any
+		metadata      string
+		refundActions []*codectypes.Any
+		expireHeight  uint64
+		expireTime    *time.Time
+		quantity      uint64
+
+		executors       []sdk.AccAddress
+		executorGroupID uint64
 	}
 
 	tester := func(subject submitProposal) error {
@@ -24,9 +33,10 @@ func (s *KeeperTestSuite) TestSubmitProposal() {
 		s.NotNil(subject.preActions)
 		s.NotNil(subject.postActions)
 		s.NotEmpty(subject.metadata)
+		s.NotNil(subject.refundActions)
 
 		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
-		err := s.keeper.SubmitProposal(ctx, subject.proposer, subject.agent, subject.preActions, subject.postActions, subject.metadata)
+		_, _, err := s.keeper.SubmitProposal(ctx, subject.proposer, subject.agent, subject.preActions, subject.postActions, subject.metadata, subject.refundActions, subject.expireHeight, subject.expireTime, subject.quantity, subject.executors, subject.executorGroupID, 0)
 		if err != nil {
 			return err
 		}
@@ -41,6 +51,14 @@ func (s *KeeperTestSuite) TestSubmitProposal() {
 		s.Require().Equal(subject.preActions, proposalAfter.PreActions)
 		s.Require().Equal(subject.postActions, proposalAfter.PostActions)
 		s.Require().Equal(subject.metadata, proposalAfter.Metadata)
+		s.Require().Equal(subject.refundActions, proposalAfter.RefundActions)
+		s.Require().Equal(subject.expireHeight, proposalAfter.ExpireHeight)
+		s.Require().Equal(subject.expireTime, proposalAfter.ExpireTime)
+		s.Require().Equal(subject.quantity, proposalAfter.Quantity)
+		s.Require().Len(proposalAfter.Executors, len(subject.executors))
+		s.Require().Equal(subject.executorGroupID, proposalAfter.ExecutorGroupId)
+		s.Require().Equal(uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()), proposalAfter.SubmitHeight)
+		s.Require().Equal(sdk.UnwrapSDKContext(ctx).BlockTime(), *proposalAfter.SubmitTime)
 
 		return nil
 	}
@@ -68,6 +86,9 @@ func (s *KeeperTestSuite) TestSubmitProposal() {
 	param, err := s.keeper.GetParams(s.ctx)
 	s.NoError(err)
 
+	blockHeight := uint64(sdk.UnwrapSDKContext(s.ctx).BlockHeight())
+	blockTime := sdk.UnwrapSDKContext(s.ctx).BlockTime()
+
 	cases := []map[string]testutil.Case[submitProposal]{
 		{
 			"proposer already exists": {
@@ -154,6 +175,361 @@ func (s *KeeperTestSuite) TestSubmitProposal() {
 				},
 			},
 		},
+		{
+			"valid refund_actions": {
+				Malleate: func(subject *submitProposal) {
+					subject.refundActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentIdle),
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     validAsset,
+						},
+					})
+				},
+			},
+		},
+		{
+			"no expiry": {},
+			"valid expire_height": {
+				Malleate: func(subject *submitProposal) {
+					subject.expireHeight = blockHeight + 1
+				},
+			},
+			"valid expire_time": {
+				Malleate: func(subject *submitProposal) {
+					expireTime := blockTime.Add(time.Second)
+					subject.expireTime = &expireTime
+				},
+			},
+			"expire_height already passed": {
+				Malleate: func(subject *submitProposal) {
+					subject.expireHeight = blockHeight
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalExpired
+				},
+			},
+			"expire_time already passed": {
+				Malleate: func(subject *submitProposal) {
+					expireTime := blockTime
+					subject.expireTime = &expireTime
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalExpired
+				},
+			},
+		},
+		{
+			"not fillable": {},
+			"fillable with non-scalable actions": {
+				Malleate: func(subject *submitProposal) {
+					subject.quantity = 2
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+		{
+			"no executor policy": {},
+			"executors": {
+				Malleate: func(subject *submitProposal) {
+					subject.executors = []sdk.AccAddress{s.buyer}
+				},
+			},
+			"executor group": {
+				Malleate: func(subject *submitProposal) {
+					subject.executorGroupID = s.executorGroup
+				},
+			},
+			"both of executors and executor group": {
+				Malleate: func(subject *submitProposal) {
+					subject.executors = []sdk.AccAddress{s.buyer}
+					subject.executorGroupID = s.executorGroup
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+			"executor group not found": {
+				Malleate: func(subject *submitProposal) {
+					subject.executorGroupID = s.executorGroup + 1
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrInvalidMessage
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestCancelProposal() {
+	type cancelProposal struct {
+		proposer  sdk.AccAddress
+		agent     sdk.AccAddress
+		blockTime time.Time
+	}
+
+	blockTime := sdk.UnwrapSDKContext(s.ctx).BlockTime()
+
+	tester := func(subject cancelProposal) error {
+		s.NotNil(subject.proposer)
+		s.NotNil(subject.agent)
+		s.NotZero(subject.blockTime)
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		ctx = ctx.WithBlockTime(subject.blockTime)
+		proposal, refundActions, err := s.keeper.CancelProposal(ctx, subject.proposer, subject.agent)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(proposal)
+		s.Require().Equal(subject.proposer, sdk.AccAddress(proposal.Proposer))
+		s.Require().Equal(proposal.RefundActions, refundActions)
+
+		_, err = s.keeper.GetProposal(s.ctx, subject.agent)
+		s.Assert().NoError(err)
+
+		_, err = s.keeper.GetProposal(ctx, subject.agent)
+		s.Require().Error(err)
+
+		// refund_actions of agentAny returns the dog
+		_, err = s.testQueryServer.Asset(ctx, &testv1alpha1.QueryAssetRequest{
+			Account: s.addressBytesToString(subject.proposer),
+			Asset:   "dog",
+		})
+		s.Require().NoError(err)
+
+		return nil
+	}
+	cases := []map[string]testutil.Case[cancelProposal]{
+		{
+			"valid proposer": {
+				Malleate: func(subject *cancelProposal) {
+					subject.proposer = s.seller
+				},
+			},
+			"not proposer": {
+				Malleate: func(subject *cancelProposal) {
+					subject.proposer = s.stranger
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"valid agent": {
+				Malleate: func(subject *cancelProposal) {
+					subject.agent = s.agentAny
+				},
+			},
+			"proposal not found": {
+				Malleate: func(subject *cancelProposal) {
+					subject.agent = s.agentIdle
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalNotFound
+				},
+			},
+		},
+		{
+			"before expiry": {
+				Malleate: func(subject *cancelProposal) {
+					subject.blockTime = blockTime
+				},
+			},
+			"after expiry": {
+				Malleate: func(subject *cancelProposal) {
+					subject.blockTime = blockTime.Add(time.Hour)
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalExpired
+				},
+			},
+		},
+	}
+
+	testutil.DoTest(s.T(), tester, cases)
+}
+
+func (s *KeeperTestSuite) TestUpdateProposal() {
+	type updateProposal struct {
+		proposer      sdk.AccAddress
+		agent         sdk.AccAddress
+		preActions    []*codectypes.Any
+		postActions   []*codectypes.Any
+		metadata      string
+		refundActions []*codectypes.Any
+	}
+
+	tester := func(subject updateProposal) error {
+		s.NotNil(subject.proposer)
+		s.NotNil(subject.agent)
+
+		ctx, _ := sdk.UnwrapSDKContext(s.ctx).CacheContext()
+		before, after, err := s.keeper.UpdateProposal(ctx, subject.proposer, subject.agent, subject.preActions, subject.postActions, subject.metadata, subject.refundActions)
+		if err != nil {
+			return err
+		}
+		s.Require().NotNil(before)
+		s.Require().NotNil(after)
+
+		proposalBefore, err := s.keeper.GetProposal(s.ctx, subject.agent)
+		s.Require().NoError(err)
+		s.Require().Equal(proposalBefore, before)
+
+		proposalAfter, err := s.keeper.GetProposal(ctx, subject.agent)
+		s.Require().NoError(err)
+		s.Require().Equal(proposalAfter, after)
+
+		s.Require().Equal(append(append([]*codectypes.Any{}, before.PreActions...), subject.preActions...), after.PreActions)
+
+		expectedPostActions := subject.postActions
+		if len(expectedPostActions) == 0 {
+			expectedPostActions = before.PostActions
+		}
+		s.Require().Equal(expectedPostActions, after.PostActions)
+
+		expectedMetadata := subject.metadata
+		if expectedMetadata == "" {
+			expectedMetadata = before.Metadata
+		}
+		s.Require().Equal(expectedMetadata, after.Metadata)
+
+		expectedRefundActions := subject.refundActions
+		if len(expectedRefundActions) == 0 {
+			expectedRefundActions = before.RefundActions
+		}
+		s.Require().Equal(expectedRefundActions, after.RefundActions)
+
+		// the other fields are kept
+		s.Require().Equal(before.Executors, after.Executors)
+		s.Require().Equal(before.ExecutorGroupId, after.ExecutorGroupId)
+		s.Require().Equal(before.Deposit, after.Deposit)
+		s.Require().Equal(before.MaxExecGas, after.MaxExecGas)
+
+		return nil
+	}
+
+	param, err := s.keeper.GetParams(s.ctx)
+	s.NoError(err)
+
+	cases := []map[string]testutil.Case[updateProposal]{
+		{
+			"valid proposer": {
+				Malleate: func(subject *updateProposal) {
+					subject.proposer = s.seller
+				},
+			},
+			"not proposer": {
+				Malleate: func(subject *updateProposal) {
+					subject.proposer = s.stranger
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrPermissionDenied
+				},
+			},
+		},
+		{
+			"valid agent": {
+				Malleate: func(subject *updateProposal) {
+					subject.agent = s.agentAny
+				},
+			},
+			"proposal not found": {
+				Malleate: func(subject *updateProposal) {
+					subject.agent = s.agentIdle
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrProposalNotFound
+				},
+			},
+		},
+		{
+			"empty pre_actions": {},
+			"valid pre_actions": {
+				Malleate: func(subject *updateProposal) {
+					subject.preActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.seller),
+							Recipient: s.addressBytesToString(s.agentAny),
+							Asset:     "snake",
+						},
+					})
+				},
+			},
+			"pre_actions failing": {
+				Malleate: func(subject *updateProposal) {
+					subject.preActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.seller),
+							Recipient: s.addressBytesToString(s.agentAny),
+							Asset:     "whale",
+						},
+					})
+				},
+				Error: func() error {
+					return testv1alpha1.ErrAssetNotFound
+				},
+			},
+		},
+		{
+			"empty post_actions": {},
+			"valid post_actions": {
+				Malleate: func(subject *updateProposal) {
+					subject.postActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentAny),
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     "voucher",
+						},
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentAny),
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     "voucher",
+						},
+					})
+				},
+			},
+		},
+		{
+			"empty metadata": {},
+			"valid metadata": {
+				Malleate: func(subject *updateProposal) {
+					subject.metadata = "sell a dog for two vouchers"
+				},
+			},
+			"large metadata": {
+				Malleate: func(subject *updateProposal) {
+					subject.metadata = string(make([]rune, param.MaxMetadataLength+1))
+				},
+				Error: func() error {
+					return escrowv1alpha1.ErrLargeMetadata
+				},
+			},
+		},
+		{
+			"empty refund_actions": {},
+			"valid refund_actions": {
+				Malleate: func(subject *updateProposal) {
+					subject.refundActions = s.encodeMsgs([]sdk.Msg{
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentAny),
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     "dog",
+						},
+						&testv1alpha1.MsgSend{
+							Sender:    s.addressBytesToString(s.agentAny),
+							Recipient: s.addressBytesToString(s.seller),
+							Asset:     "snake",
+						},
+					})
+				},
+			},
+		},
 	}
 
 	testutil.DoTest(s.T(), tester, cases)
@@ -0,0 +1,148 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// phaseError is the error of the actions failing on their execution, which
+// keeps the phase of the actions for the simulations.
+type phaseError struct {
+	phase string
+	err   error
+}
+
+func newPhaseError(err error, phase string) error {
+	return phaseError{
+		phase: phase,
+		err:   err,
+	}
+}
+
+func (e phaseError) Error() string {
+	return fmt.Sprintf("%s: %s", e.phase, e.err)
+}
+
+func (e phaseError) Cause() error {
+	return e.err
+}
+
+func (e phaseError) Unwrap() error {
+	return e.err
+}
+
+// actionError is the error of an action failing on its execution, which
+// keeps the index of the action for the simulations.
+type actionError struct {
+	index int
+	err   error
+}
+
+func newActionError(err error, index int) error {
+	return actionError{
+		index: index,
+		err:   err,
+	}
+}
+
+func (e actionError) Error() string {
+	return fmt.Sprintf("index %d: %s", e.index, e.err)
+}
+
+func (e actionError) Cause() error {
+	return e.err
+}
+
+func (e actionError) Unwrap() error {
+	return e.err
+}
+
+// agentError is the error of the post_actions of an agent failing on their
+// execution, which keeps the index of the agent for the simulations.
+type agentError struct {
+	index int
+	err   error
+}
+
+func newAgentError(err error, index int) error {
+	return agentError{
+		index: index,
+		err:   err,
+	}
+}
+
+func (e agentError) Error() string {
+	return fmt.Sprintf("index %d: %s", e.index, e.err)
+}
+
+func (e agentError) Cause() error {
+	return e.err
+}
+
+func (e agentError) Unwrap() error {
+	return e.err
+}
+
+// simulate runs the function on a branched context with its own gas meter and
+// event manager, discarding the writes. The gas meter is limited by the given
+// gas limit and the gas remaining on the context, and running out of gas fails
+// the simulation.
+func simulate(ctx context.Context, gasLimit uint64, fn func(ctx context.Context) error) (gasUsed uint64, events sdk.Events, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if remaining := sdkCtx.GasMeter().GasRemaining(); remaining < gasLimit {
+		gasLimit = remaining
+	}
+
+	simCtx, _ := sdkCtx.CacheContext()
+	simCtx = simCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit)).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			gasUsed = simCtx.GasMeter().GasConsumedToLimit()
+			events = nil
+			err = sdkerrors.ErrOutOfGas.Wrapf("out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, gasLimit)
+		}
+	}()
+
+	err = fn(simCtx)
+
+	return simCtx.GasMeter().GasConsumedToLimit(), simCtx.EventManager().Events(), err
+}
+
+// failedAction returns the outermost phase of the actions failing on their
+// execution and the index of the failing action in the phase, if any.
+func failedAction(err error) (phase string, actionFailed bool, index uint64) {
+	var phaseErr phaseError
+	if !errors.As(err, &phaseErr) {
+		return "", false, 0
+	}
+
+	var actionErr actionError
+	if !errors.As(phaseErr.err, &actionErr) {
+		return phaseErr.phase, false, 0
+	}
+
+	return phaseErr.phase, true, uint64(actionErr.index)
+}
+
+// failedAgent returns the index of the agent whose post_actions failed on their
+// execution, if any.
+func failedAgent(err error) (agentFailed bool, index uint64) {
+	var agentErr agentError
+	if !errors.As(err, &agentErr) {
+		return false, 0
+	}
+
+	return true, uint64(agentErr.index)
+}
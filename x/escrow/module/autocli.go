@@ -26,7 +26,12 @@ func autoCLIQuery() *autocliv1.ServiceCommandDescriptor {
 			autoCLIQueryAgents(),
 			autoCLIQueryProposal(),
 			autoCLIQueryProposalsByProposer(),
+			autoCLIQueryProposalsByMessageType(),
+			autoCLIQueryProposalsByOfferedAsset(),
+			autoCLIQueryProposalsByAskedAsset(),
 			autoCLIQueryProposals(),
+			autoCLIQuerySimulateExec(),
+			autoCLIQuerySimulateSubmit(),
 		},
 	}
 }
@@ -125,7 +130,14 @@ proposal:
       id: ...
       receiver: cosmos1...
       sender: cosmos1...
-  proposer: cosmos1...`,
+  proposer: cosmos1...
+  refund_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: ...
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...`,
 	}
 }
 
@@ -144,6 +156,7 @@ pagination:
   total: "1"
 proposals:
 - agent: cosmos1...
+  expire_time: "2024-01-01T00:00:00Z"
   metadata: limited time offer for you
   post_actions:
   - type: cosmos-sdk/MsgSend
@@ -166,7 +179,158 @@ proposals:
       id: ...
       receiver: cosmos1...
       sender: cosmos1...
-  proposer: cosmos1ppp...`,
+  proposer: cosmos1ppp...
+  refund_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: ...
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...`,
+	}
+}
+
+func autoCLIQueryProposalsByMessageType() *autocliv1.RpcCommandOptions {
+	return &autocliv1.RpcCommandOptions{
+		RpcMethod: "ProposalsByMessageType",
+		Short:     "queries all the proposals by the type of the messages in their actions.",
+		Use:       "proposals-by-message-type --message-type [message-type]",
+		FlagOptions: map[string]*autocliv1.FlagOptions{
+			"message_type": {
+				Usage: "the type url of a message",
+			},
+			"phase": {
+				Usage: "the phase of the actions having the message, either pre_actions or post_actions (optional)",
+			},
+		},
+		Example: `$ and query escrow proposals-by-message-type --message-type /cosmos.nft.v1beta1.MsgSend --phase pre_actions
+pagination:
+  total: "1"
+proposals:
+- agent: cosmos1...
+  expire_time: "2024-01-01T00:00:00Z"
+  metadata: limited time offer for you
+  post_actions:
+  - type: cosmos-sdk/MsgSend
+    value:
+      amount:
+      - amount: "42"
+        denom: stake
+      from_address: cosmos1...
+      to_address: cosmos1...
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: ...
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...
+  pre_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: ...
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...
+  proposer: cosmos1ppp...
+  refund_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: ...
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...`,
+	}
+}
+
+func autoCLIQueryProposalsByOfferedAsset() *autocliv1.RpcCommandOptions {
+	return &autocliv1.RpcCommandOptions{
+		RpcMethod: "ProposalsByOfferedAsset",
+		Short:     "queries all the proposals offering the asset in their pre-actions.",
+		Use:       "proposals-by-offered-asset [--denom [denom] | --class-id [class-id]]",
+		FlagOptions: map[string]*autocliv1.FlagOptions{
+			"denom": {
+				Usage: "the denom of coins",
+			},
+			"class_id": {
+				Usage: "the class id of nfts",
+			},
+		},
+		Example: `$ and query escrow proposals-by-offered-asset --class-id cat
+pagination:
+  total: "1"
+proposals:
+- agent: cosmos1...
+  expire_time: "2024-01-01T00:00:00Z"
+  metadata: limited time offer for you
+  post_actions:
+  - type: cosmos-sdk/MsgSend
+    value:
+      amount:
+      - amount: "42"
+        denom: stake
+      from_address: cosmos1...
+      to_address: cosmos1...
+  pre_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: cat
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...
+  proposer: cosmos1ppp...
+  refund_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: cat
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...`,
+	}
+}
+
+func autoCLIQueryProposalsByAskedAsset() *autocliv1.RpcCommandOptions {
+	return &autocliv1.RpcCommandOptions{
+		RpcMethod: "ProposalsByAskedAsset",
+		Short:     "queries all the proposals asking the asset in their post-actions.",
+		Use:       "proposals-by-asked-asset [--denom [denom] | --class-id [class-id]]",
+		FlagOptions: map[string]*autocliv1.FlagOptions{
+			"denom": {
+				Usage: "the denom of coins",
+			},
+			"class_id": {
+				Usage: "the class id of nfts",
+			},
+		},
+		Example: `$ and query escrow proposals-by-asked-asset --denom stake
+pagination:
+  total: "1"
+proposals:
+- agent: cosmos1...
+  expire_time: "2024-01-01T00:00:00Z"
+  metadata: limited time offer for you
+  post_actions:
+  - type: cosmos-sdk/MsgSend
+    value:
+      amount:
+      - amount: "42"
+        denom: stake
+      from_address: cosmos1...
+      to_address: cosmos1...
+  pre_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: cat
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...
+  proposer: cosmos1ppp...
+  refund_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: cat
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...`,
 	}
 }
 
@@ -196,7 +360,15 @@ proposals:
       receiver: cosmos1...
       sender: cosmos1...
   proposer: cosmos1...
+  refund_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: ...
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...
 - agent: cosmos1...
+  expire_time: "2024-01-01T00:00:00Z"
   metadata: limited time offer for you
   post_actions:
   - type: cosmos-sdk/MsgSend
@@ -219,7 +391,144 @@ proposals:
       id: ...
       receiver: cosmos1...
       sender: cosmos1...
-  proposer: cosmos1...`,
+  proposer: cosmos1...
+  refund_actions:
+  - type: /cosmos.nft.v1beta1.MsgSend
+    value:
+      class_id: ...
+      id: ...
+      receiver: cosmos1...
+      sender: cosmos1...`,
+	}
+}
+
+func autoCLIQuerySimulateExec() *autocliv1.RpcCommandOptions {
+	return &autocliv1.RpcCommandOptions{
+		RpcMethod: "SimulateExec",
+		Short:     "simulates an execution of proposals.",
+		Long: `simulates an execution of proposals.
+
+Note:
+  it reports whether Msg/Exec would succeed, the gas and the events of it,
+  and the phase and the index of the failing action and agent on the failure,
+  without committing the result.`,
+		Use: "simulate-exec --executor [executor] --agents [agents] --actions [actions]",
+		FlagOptions: map[string]*autocliv1.FlagOptions{
+			"executor": {
+				Usage: "the address of the account executing the proposal",
+			},
+			"agents": {
+				Usage: "the addresses of the agents in charge",
+			},
+			"actions": {
+				Usage: "the messages which will be executed on the execution",
+			},
+			"quantities": {
+				Usage: "the numbers of the units to fill for each agent (optional)",
+			},
+			"declared_value": {
+				Usage: "the value of the execution, on which the fee in basis points is charged (optional)",
+			},
+		},
+		Example: `$ and query escrow simulate-exec --executor cosmos1eee... --agents cosmos1aaa... \
+    --actions '{"@type": "/cosmos.bank.v1beta1.MsgSend",
+                "from_address": "cosmos1eee...",
+                "to_address": "cosmos1aaa...",
+                "amount": [{"amount": "42", "denom": "stake"}]}'
+action_failed: true
+code: 5
+codespace: sdk
+error: 'post_actions: index 0: index 0: spendable balance 0stake is smaller than 42stake:
+  insufficient funds'
+failed_phase: post_actions
+gas_used: "51234"`,
+	}
+}
+
+func autoCLIQuerySimulateSubmit() *autocliv1.RpcCommandOptions {
+	return &autocliv1.RpcCommandOptions{
+		RpcMethod: "SimulateSubmit",
+		Short:     "simulates a submission of a proposal.",
+		Long: `simulates a submission of a proposal.
+
+Note:
+  it reports whether Msg/SubmitProposal would succeed, the gas and the events
+  of it, and the index of the failing pre-action on the failure, without
+  committing the result.`,
+		Use: "simulate-submit --proposer [proposer] --agent [agent] --pre-actions [pre-actions] --post-actions [post-actions] --metadata [metadata] --refund-actions [refund-actions]",
+		FlagOptions: map[string]*autocliv1.FlagOptions{
+			"proposer": {
+				Usage: "the address of the proposer",
+			},
+			"agent": {
+				Usage: "the address of the agent in charge",
+			},
+			"pre_actions": {
+				Usage: "the messages which will be executed on the submission",
+			},
+			"post_actions": {
+				Usage: "the messages which will be executed after the actions included in Msg/Exec",
+			},
+			"metadata": {
+				Usage: "any arbitrary metadata attached to the proposal",
+			},
+			"refund_actions": {
+				Usage: "the messages which will be executed on the cancellation",
+			},
+			"expire_height": {
+				Usage: "the block height from which the proposal is considered expired (optional)",
+			},
+			"expire_time": {
+				Usage: "the block time from which the proposal is considered expired (optional)",
+			},
+			"quantity": {
+				Usage: "the number of the units which can be filled partially (optional)",
+			},
+			"executors": {
+				Usage: "the addresses of the accounts eligible to execute the proposal (optional)",
+			},
+			"executor_group_id": {
+				Usage: "the id of the group whose members are eligible to execute the proposal (optional)",
+			},
+			"max_exec_gas": {
+				Usage: "the maximum gas which the post-actions may consume on Msg/Exec (optional)",
+			},
+		},
+		Example: `$ and query escrow simulate-submit --proposer cosmos1ppp... --agent cosmos1aaa... \
+    --pre-actions '{"@type": "/cosmos.nft.v1beta1.MsgSend",
+                    "class_id": "cat",
+                    "id": "leopardcat",
+                    "sender": "cosmos1ppp...",
+                    "receiver": "cosmos1aaa..."}' \
+    --post-actions '{"@type": "/cosmos.bank.v1beta1.MsgSend",
+                     "from_address": "cosmos1aaa...",
+                     "to_address": "cosmos1ppp...",
+                     "amount": [{"amount": "42", "denom": "stake"}]}' \
+    --metadata 'very good deal' \
+    --refund-actions '{"@type": "/cosmos.nft.v1beta1.MsgSend",
+                       "class_id": "cat",
+                       "id": "leopardcat",
+                       "sender": "cosmos1aaa...",
+                       "receiver": "cosmos1ppp..."}'
+events:
+- attributes:
+  - key: class_id
+    value: '"cat"'
+  - key: id
+    value: '"leopardcat"'
+  - key: sender
+    value: '"cosmos1ppp..."'
+  - key: receiver
+    value: '"cosmos1aaa..."'
+  event_type: cosmos.nft.v1beta1.EventSend
+- attributes:
+  - key: agent
+    value: '"cosmos1aaa..."'
+  - key: metadata
+    value: '"very good deal"'
+  event_type: andromeda.escrow.v1alpha1.EventSubmitProposal
+gas_used: "61234"
+success: true`,
 	}
 }
 
@@ -231,6 +540,8 @@ func autoCLITx() *autocliv1.ServiceCommandDescriptor {
 			autoCLITxCreateAgent(),
 			autoCLITxSubmitProposal(),
 			autoCLITxExec(),
+			autoCLITxCancelProposal(),
+			autoCLITxUpdateProposal(),
 		},
 	}
 }
@@ -244,6 +555,60 @@ func autoCLITxUpdateParams() *autocliv1.RpcCommandOptions {
 			"max_metadata_length": {
 				Usage: "the maximum length allowed for metadata",
 			},
+			"exec_fee_flat": {
+				Usage: "the flat fee charged to the executor on Msg/Exec (optional)",
+			},
+			"exec_fee_bps": {
+				Usage: "the fee charged to the executor on Msg/Exec, in basis points of the declared value (optional)",
+			},
+			"min_proposal_deposit": {
+				Usage: "the deposit escrowed from the proposer on Msg/SubmitProposal (optional)",
+			},
+			"burn_expired_deposits": {
+				Usage: "whether to burn the deposits of the expired proposals, instead of sending them to the community pool (optional)",
+			},
+			"max_pre_actions": {
+				Usage: "the maximum number of pre-actions of a proposal",
+			},
+			"max_post_actions": {
+				Usage: "the maximum number of post-actions of a proposal",
+			},
+			"max_actions": {
+				Usage: "the maximum number of actions of Msg/Exec",
+			},
+			"max_agents_per_exec": {
+				Usage: "the maximum number of agents of Msg/Exec",
+			},
+			"max_action_size": {
+				Usage: "the maximum encoded size of an action, in bytes",
+			},
+			"allowed_message_types": {
+				Usage: "the type urls of the messages allowed in the actions, where a trailing * matches by prefix (optional)",
+			},
+			"denied_message_types": {
+				Usage: "the type urls of the messages denied in the actions, where a trailing * matches by prefix (optional)",
+			},
+			"max_nesting_depth": {
+				Usage: "the maximum depth of the escrow messages nested in the actions, where zero forbids them (optional)",
+			},
+			"gas_per_agent": {
+				Usage: "the gas charged per agent of Msg/Exec (optional)",
+			},
+			"gas_per_action": {
+				Usage: "the gas charged per executed action (optional)",
+			},
+			"gas_per_action_byte": {
+				Usage: "the gas charged per byte of the actions stored in a proposal (optional)",
+			},
+			"max_prunes_per_block": {
+				Usage: "the maximum number of the expired proposals pruned per block",
+			},
+			"max_refund_actions": {
+				Usage: "the maximum number of refund_actions of a proposal",
+			},
+			"max_simulate_gas": {
+				Usage: "the maximum gas consumed by a simulation",
+			},
 		},
 		Example: `$ and tx escrow update-params --from cosmos1aaa... --max-metadata-length 42
 auth_info:
@@ -307,8 +672,15 @@ Note:
   pre-actions:
     the signer of each message must be either the proposer or the agent.
   post-actions:
-    the signer of each message must be either the proposer or the agent.`,
-		Use: "submit-proposal --from [proposer] --agent [agent] --pre-actions [pre-actions] --post-actions [post-actions] --metadata [metadata]",
+    the signer of each message must be either the proposer or the agent.
+  refund-actions:
+    the signer of each message must be the agent.
+  quantity:
+    if non-zero, the post-actions and the refund-actions describe a single
+    unit, and they must consist of the messages having fungible amounts.
+  executors, executor-group-id:
+    at most one of them can be provided.`,
+		Use: "submit-proposal --from [proposer] --agent [agent] --pre-actions [pre-actions] --post-actions [post-actions] --metadata [metadata] --refund-actions [refund-actions]",
 		FlagOptions: map[string]*autocliv1.FlagOptions{
 			"agent": {
 				Usage: "the address of the agent in charge",
@@ -322,6 +694,27 @@ Note:
 			"metadata": {
 				Usage: "any arbitrary metadata attached to the proposal",
 			},
+			"refund_actions": {
+				Usage: "the messages which will be executed on the cancellation",
+			},
+			"expire_height": {
+				Usage: "the block height from which the proposal is considered expired (optional)",
+			},
+			"expire_time": {
+				Usage: "the block time from which the proposal is considered expired (optional)",
+			},
+			"quantity": {
+				Usage: "the number of the units which can be filled partially (optional)",
+			},
+			"executors": {
+				Usage: "the addresses of the accounts eligible to execute the proposal (optional)",
+			},
+			"executor_group_id": {
+				Usage: "the id of the group whose members are eligible to execute the proposal (optional)",
+			},
+			"max_exec_gas": {
+				Usage: "the maximum gas which the post-actions may consume on Msg/Exec (optional)",
+			},
 		},
 		Example: `$ and tx escrow submit-proposal --from cosmos1ppp... --agent cosmos1aaa... \
     --pre-actions '{"@type": "/cosmos.nft.v1beta1.MsgSend",
@@ -333,7 +726,12 @@ Note:
                      "from_address": "cosmos1aaa",
                      "to_address": "cosmos1ppp...",
                      "amount": [{"amount": "42", "denom": "stake"}]}' \
-    --metadata "sell leopardcat for 42stake"
+    --metadata "sell leopardcat for 42stake" \
+    --refund-actions '{"@type": "/cosmos.nft.v1beta1.MsgSend",
+                       "class_id": "cat",
+                       "id": "leopardcat",
+                       "sender": "cosmos1aaa...",
+                       "receiver": "cosmos1ppp..."}'
 auth_info:
   fee:
     amount: []
@@ -364,6 +762,12 @@ body:
         receiver: cosmos1aaa...
         sender: cosmos1ppp...
       proposer: cosmos1ppp...
+    refund_actions:
+    - '@type': /cosmos.nft.v1beta1.MsgSend
+      class_id: cat
+      id: leopardcat
+      receiver: cosmos1ppp...
+      sender: cosmos1aaa...
   non_critical_extension_options: []
   timeout_height: "0"
 signatures: []
@@ -379,7 +783,10 @@ func autoCLITxExec() *autocliv1.RpcCommandOptions {
 
 Note:
   actions:
-    the signer of each message must be either the executor or one of the agents.`,
+    the signer of each message must be either the executor or one of the agents.
+  quantities:
+    each quantity corresponds to the agent at the same position, and zero
+    means all the remaining units.`,
 		Use: "exec --from [executor] --agents [agents] --actions [actions]",
 		FlagOptions: map[string]*autocliv1.FlagOptions{
 			"agents": {
@@ -388,6 +795,12 @@ Note:
 			"actions": {
 				Usage: "the messages which will be executed on the execution",
 			},
+			"quantities": {
+				Usage: "the numbers of the units to fill for each agent (optional)",
+			},
+			"declared_value": {
+				Usage: "the value of the execution, on which the fee in basis points is charged (optional)",
+			},
 		},
 		Example: `$ and tx escrow exec --from cosmos1eee... --agents cosmos1aaa... \
     --actions '{"@type": "/cosmos.nft.v1beta1.MsgSend",
@@ -433,3 +846,110 @@ signatures: []
 confirm transaction before signing and broadcasting [y/N]:`,
 	}
 }
+
+func autoCLITxCancelProposal() *autocliv1.RpcCommandOptions {
+	return &autocliv1.RpcCommandOptions{
+		RpcMethod: "CancelProposal",
+		Short:     "cancels a proposal.",
+		Use:       "cancel-proposal --from [proposer] --agent [agent]",
+		FlagOptions: map[string]*autocliv1.FlagOptions{
+			"agent": {
+				Usage: "the address of the agent in charge",
+			},
+		},
+		Example: `$ and tx escrow cancel-proposal --from cosmos1ppp... --agent cosmos1aaa...
+auth_info:
+  fee:
+    amount: []
+    gas_limit: "200000"
+    granter: ""
+    payer: ""
+  signer_infos: []
+  tip: null
+body:
+  extension_options: []
+  memo: ""
+  messages:
+  - '@type': /andromeda.escrow.v1alpha1.MsgCancelProposal
+    agent: cosmos1aaa...
+    proposer: cosmos1ppp...
+  non_critical_extension_options: []
+  timeout_height: "0"
+signatures: []
+confirm transaction before signing and broadcasting [y/N]:`,
+	}
+}
+
+func autoCLITxUpdateProposal() *autocliv1.RpcCommandOptions {
+	return &autocliv1.RpcCommandOptions{
+		RpcMethod: "UpdateProposal",
+		Short:     "updates a proposal.",
+		Long: `updates a proposal.
+
+Note:
+  pre-actions:
+    the signer of each message must be either the proposer or the agent.
+    they would be executed on the update, in addition to the existing ones.
+  post-actions:
+    the signer of each message must be either the proposer or the agent.
+    empty means no change.
+  metadata:
+    empty means no change.
+  refund-actions:
+    the signer of each message must be the agent.
+    empty means no change.`,
+		Use: "update-proposal --from [proposer] --agent [agent] --pre-actions [pre-actions] --post-actions [post-actions] --metadata [metadata] --refund-actions [refund-actions]",
+		FlagOptions: map[string]*autocliv1.FlagOptions{
+			"agent": {
+				Usage: "the address of the agent in charge",
+			},
+			"pre_actions": {
+				Usage: "the additional messages which will be executed on the update",
+			},
+			"post_actions": {
+				Usage: "the messages which will replace the post_actions of the proposal",
+			},
+			"metadata": {
+				Usage: "the metadata which will replace the metadata of the proposal",
+			},
+			"refund_actions": {
+				Usage: "the messages which will replace the refund_actions of the proposal",
+			},
+		},
+		Example: `$ and tx escrow update-proposal --from cosmos1ppp... --agent cosmos1aaa... \
+    --post-actions '{"@type": "/cosmos.bank.v1beta1.MsgSend",
+                     "from_address": "cosmos1aaa",
+                     "to_address": "cosmos1ppp...",
+                     "amount": [{"amount": "21", "denom": "stake"}]}' \
+    --metadata "sell leopardcat for 21stake"
+auth_info:
+  fee:
+    amount: []
+    gas_limit: "200000"
+    granter: ""
+    payer: ""
+  signer_infos: []
+  tip: null
+body:
+  extension_options: []
+  memo: ""
+  messages:
+  - '@type': /andromeda.escrow.v1alpha1.MsgUpdateProposal
+    agent: cosmos1aaa...
+    metadata: sell leopardcat for 21stake
+    post_actions:
+    - '@type': /cosmos.bank.v1beta1.MsgSend
+      amount:
+      - amount: "21"
+        denom: stake
+      from_address: cosmos1aaa...
+      to_address: cosmos1ppp...
+    pre_actions: []
+    proposer: cosmos1ppp...
+    refund_actions: []
+  non_critical_extension_options: []
+  timeout_height: "0"
+signatures: []
+confirm transaction before signing and broadcasting [y/N]:`,
+	}
+}
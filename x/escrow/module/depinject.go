@@ -1,6 +1,9 @@
 package module
 
 import (
+	"fmt"
+	"sort"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
@@ -11,6 +14,7 @@ import (
 
 	modulev1alpha1 "github.com/0tech/andromeda/x/escrow/api/andromeda/escrow/module/v1alpha1"
 	"github.com/0tech/andromeda/x/escrow/keeper"
+	"github.com/0tech/andromeda/x/escrow/simulation"
 )
 
 var _ appmodule.AppModule = (*AppModule)(nil)
@@ -26,6 +30,7 @@ func (AppModule) IsAppModule() {
 func init() {
 	appmodule.Register(&modulev1alpha1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetEscrowHooks),
 	)
 }
 
@@ -36,8 +41,16 @@ type Inputs struct {
 	Cdc          codec.Codec
 	Config       *modulev1alpha1.Module
 
-	Router     keeper.MessageRouter
-	AuthKeeper keeper.AuthKeeper
+	Router             keeper.MessageRouter
+	AuthKeeper         keeper.AuthKeeper
+	BankKeeper         keeper.BankKeeper
+	DistributionKeeper keeper.DistributionKeeper
+	GroupKeeper        keeper.GroupKeeper
+
+	// for the simulation
+	AccountKeeper simulation.AccountKeeper
+	SimBankKeeper simulation.BankKeeper
+	NFTKeeper     simulation.NFTKeeper `optional:"true"`
 }
 
 type Outputs struct {
@@ -61,12 +74,52 @@ func ProvideModule(in Inputs) Outputs {
 		}
 	}
 
-	k, err := keeper.NewKeeper(in.Cdc, in.StoreService, authority, in.Router, in.AuthKeeper)
+	config := keeper.DefaultConfig()
+	config.RecreateAgentAccounts = in.Config.RecreateAgentAccounts
+
+	k, err := keeper.NewKeeper(in.Cdc, in.StoreService, authority, in.Router, in.AuthKeeper, in.BankKeeper, in.DistributionKeeper, in.GroupKeeper, config)
 	if err != nil {
 		panic(err)
 	}
 
-	m := NewAppModule(*k)
+	m := NewAppModule(in.Cdc.InterfaceRegistry(), *k, in.AccountKeeper, in.SimBankKeeper, in.NFTKeeper)
 
 	return Outputs{Keeper: *k, Module: m}
 }
+
+func InvokeSetEscrowHooks(
+	config *modulev1alpha1.Module,
+	k keeper.Keeper,
+	escrowHooks map[string]keeper.EscrowHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if config == nil || len(escrowHooks) == 0 {
+		return nil
+	}
+
+	order := config.HooksOrder
+	if len(order) == 0 {
+		for name := range escrowHooks {
+			order = append(order, name)
+		}
+		sort.Strings(order)
+	}
+
+	if len(order) != len(escrowHooks) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks: %v)", order, escrowHooks)
+	}
+
+	var multiHooks keeper.MultiEscrowHooks
+	for _, name := range order {
+		hooks, ok := escrowHooks[name]
+		if !ok {
+			return fmt.Errorf("can't find escrow hooks for module %s", name)
+		}
+
+		multiHooks = append(multiHooks, hooks)
+	}
+
+	k.SetHooks(multiHooks)
+
+	return nil
+}
@@ -7,14 +7,20 @@ import (
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
+	"cosmossdk.io/core/appmodule"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	escrowv1beta1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1beta1"
 	"github.com/0tech/andromeda/x/escrow/keeper"
+	"github.com/0tech/andromeda/x/escrow/simulation"
 )
 
 // ----------------------------------------------------------------------------
@@ -39,6 +45,7 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
 
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	escrowv1alpha1.RegisterInterfaces(registry)
+	escrowv1beta1.RegisterInterfaces(registry)
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
@@ -46,6 +53,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *g
 	if err := escrowv1alpha1.RegisterQueryHandlerClient(context.Background(), mux, escrowv1alpha1.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
+	if err := escrowv1beta1.RegisterQueryHandlerClient(context.Background(), mux, escrowv1beta1.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // ----------------------------------------------------------------------------
@@ -56,13 +66,29 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *g
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	registry codectypes.InterfaceRegistry
+	keeper   keeper.Keeper
+
+	// the keepers below are only for the simulation
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+	nftKeeper     simulation.NFTKeeper
 }
 
-// NewAppModule creates a new AppModule object.
-func NewAppModule(keeper keeper.Keeper) AppModule {
+// NewAppModule creates a new AppModule object. The nft keeper may be nil.
+func NewAppModule(
+	registry codectypes.InterfaceRegistry,
+	keeper keeper.Keeper,
+	accountKeeper simulation.AccountKeeper,
+	bankKeeper simulation.BankKeeper,
+	nftKeeper simulation.NFTKeeper,
+) AppModule {
 	return AppModule{
-		keeper: keeper,
+		registry:      registry,
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		nftKeeper:     nftKeeper,
 	}
 }
 
@@ -85,12 +111,46 @@ func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConf
 	return am.keeper.ValidateGenesis(&gs)
 }
 
+// ValidateGenesisAccounts checks the accounts of the agents and the proposers
+// in the genesis state of the module against the accounts in the genesis
+// state of x/auth.
+func (am AppModule) ValidateGenesisAccounts(cdc codec.JSONCodec, genesis map[string]json.RawMessage) error {
+	bz, ok := genesis[escrowv1alpha1.ModuleName]
+	if !ok {
+		return nil
+	}
+
+	var gs escrowv1alpha1.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", escrowv1alpha1.ModuleName, err)
+	}
+
+	var authGenesis authtypes.GenesisState
+	if bz, ok := genesis[authtypes.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, &authGenesis); err != nil {
+			return fmt.Errorf("failed to unmarshal %s genesis state: %w", authtypes.ModuleName, err)
+		}
+	}
+
+	genAccounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to unpack %s accounts: %w", authtypes.ModuleName, err)
+	}
+
+	accounts := make([]sdk.AccountI, len(genAccounts))
+	for i, acc := range genAccounts {
+		accounts[i] = acc
+	}
+
+	return am.keeper.ValidateGenesisAccounts(&gs, accounts)
+}
+
 // ____________________________________________________________________________
 
 var _ module.HasInvariants = (*AppModule)(nil)
 
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
-	// No invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	am.keeper.RegisterInvariants(ir)
 }
 
 // ____________________________________________________________________________
@@ -100,14 +160,18 @@ var _ module.HasServices = (*AppModule)(nil)
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	escrowv1alpha1.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	escrowv1alpha1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+	escrowv1beta1.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerV1Beta1(am.keeper))
+	escrowv1beta1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerV1Beta1(am.keeper))
 
-	// m := keeper.NewMigrator(am.keeper)
-	// migrations := map[uint64]func(sdk.Context) error{}
-	// for ver, handler := range migrations {
-	// 	if err := cfg.RegisterMigration(escrowv1alpha1.ModuleName, ver, handler); err != nil {
-	// 		panic(fmt.Sprintf("failed to migrate x/%s from version %d to %d: %v", escrowv1alpha1.ModuleName, ver, ver+1, err))
-	// 	}
-	// }
+	m := keeper.NewMigrator(am.keeper)
+	migrations := map[uint64]func(sdk.Context) error{
+		1: m.Migrate1to2,
+	}
+	for ver, handler := range migrations {
+		if err := cfg.RegisterMigration(escrowv1alpha1.ModuleName, ver, handler); err != nil {
+			panic(fmt.Sprintf("failed to migrate x/%s from version %d to %d: %v", escrowv1alpha1.ModuleName, ver, ver+1, err))
+		}
+	}
 }
 
 // ____________________________________________________________________________
@@ -136,6 +200,42 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ____________________________________________________________________________
 
+var _ appmodule.HasEndBlocker = (*AppModule)(nil)
+
+// EndBlock prunes the expired proposals.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
+}
+
+// ____________________________________________________________________________
+
+var _ module.AppModuleSimulation = (*AppModule)(nil)
+
+// GenerateGenesisState creates a randomized GenState of the module.
+func (am AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState, am.keeper.DefaultGenesis())
+}
+
+// RegisterStoreDecoder registers a decoder for the module's types.
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[escrowv1alpha1.ModuleName] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema())
+}
+
+// WeightedOperations returns the all the module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		am.registry,
+		simState.AppParams,
+		simState.TxConfig,
+		am.accountKeeper,
+		am.bankKeeper,
+		am.nftKeeper,
+		am.keeper,
+	)
+}
+
+// ____________________________________________________________________________
+
 var _ module.HasConsensusVersion = (*AppModule)(nil)
 
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
@@ -0,0 +1,118 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// MultiRefs defines an index which maps a value into zero or more reference keys.
+// It can be used to index optional fields (no reference key) or repeated fields
+// (multiple reference keys). As Multi, the index does not enforce uniqueness
+// constraints.
+type MultiRefs[ReferenceKey, PrimaryKey, Value any] struct {
+	getRefKeys func(pk PrimaryKey, value Value) ([]ReferenceKey, error)
+	refKeys    collections.KeySet[collections.Pair[ReferenceKey, PrimaryKey]]
+}
+
+// NewMultiRefs instantiates a new MultiRefs instance given a schema,
+// a Prefix, the humanized name for the index, the reference key key codec
+// and the primary key key codec. The getRefKeysFunc is a function that
+// given the primary key and value returns the referencing keys.
+func NewMultiRefs[ReferenceKey, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKeysFunc func(pk PrimaryKey, value Value) ([]ReferenceKey, error),
+) *MultiRefs[ReferenceKey, PrimaryKey, Value] {
+	return &MultiRefs[ReferenceKey, PrimaryKey, Value]{
+		getRefKeys: getRefKeysFunc,
+		refKeys:    collections.NewKeySet(schema, prefix, name, collections.PairKeyCodec(refCodec, pkCodec)),
+	}
+}
+
+func (i *MultiRefs[ReferenceKey, PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	// if no error it means the value existed, and we need to remove the old indexes
+	case err == nil:
+		err = i.unreference(ctx, pk, oldValue)
+		if err != nil {
+			return err
+		}
+	// if error is ErrNotFound, it means that the object does not exist, so we're creating indexes for the first time.
+	// we do nothing.
+	case errors.Is(err, collections.ErrNotFound):
+	// default case means that there was some other error
+	default:
+		return err
+	}
+	// create new indexes
+	refKeys, err := i.getRefKeys(pk, newValue)
+	if err != nil {
+		return err
+	}
+	for _, refKey := range refKeys {
+		if err := i.refKeys.Set(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *MultiRefs[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return i.unreference(ctx, pk, value)
+}
+
+func (i *MultiRefs[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKeys, err := i.getRefKeys(pk, value)
+	if err != nil {
+		return err
+	}
+	for _, refKey := range refKeys {
+		if err := i.refKeys.Remove(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *MultiRefs[ReferenceKey, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]]) (MultiIterator[ReferenceKey, PrimaryKey], error) {
+	iter, err := i.refKeys.Iterate(ctx, ranger)
+	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), err
+}
+
+func (i *MultiRefs[ReferenceKey, PrimaryKey, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]],
+	walkFunc func(indexingKey ReferenceKey, indexedKey PrimaryKey) (stop bool, err error),
+) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Pair[ReferenceKey, PrimaryKey]) (bool, error) {
+		return walkFunc(key.K1(), key.K2())
+	})
+}
+
+func (i *MultiRefs[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (_ collections.Iterator[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue], err error) {
+	iter, err := i.refKeys.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		return
+	}
+	return iter, nil
+}
+
+// MatchExact returns a MultiIterator containing all the primary keys referenced by the provided reference key.
+func (i *MultiRefs[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, refKey ReferenceKey) (MultiIterator[ReferenceKey, PrimaryKey], error) {
+	return i.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
+}
+
+func (i *MultiRefs[K1, K2, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K1, K2]] {
+	return i.refKeys.KeyCodec()
+}
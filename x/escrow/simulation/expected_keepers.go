@@ -0,0 +1,28 @@
+package simulation
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	AccountKeeper interface {
+		GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+		AddressCodec() address.Codec
+	}
+
+	BankKeeper interface {
+		SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+		IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+	}
+
+	NFTKeeper interface {
+		GetClasses(ctx context.Context) []*nft.Class
+		GetNFTsOfClassByOwner(ctx context.Context, classID string, owner sdk.AccAddress) []nft.NFT
+		GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+	}
+)
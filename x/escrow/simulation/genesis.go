@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+)
+
+// the keys of the randomized params
+const (
+	MaxMetadataLength = "max_metadata_length"
+	ExecFeeBps        = "exec_fee_bps"
+	MaxPreActions     = "max_pre_actions"
+	MaxPostActions    = "max_post_actions"
+	MaxActions        = "max_actions"
+	MaxAgentsPerExec  = "max_agents_per_exec"
+	MaxNestingDepth   = "max_nesting_depth"
+	MaxPrunesPerBlock = "max_prunes_per_block"
+	MaxRefundActions  = "max_refund_actions"
+	MaxSimulateGas    = "max_simulate_gas"
+)
+
+// RandomizedGenState generates a random GenesisState of the module, based on
+// the given default genesis. The agents and the proposals are left empty,
+// which are created by the operations.
+func RandomizedGenState(simState *module.SimulationState, defaultGenesis *escrowv1alpha1.GenesisState) {
+	params := *defaultGenesis.Params
+
+	simState.AppParams.GetOrGenerate(MaxMetadataLength, &params.MaxMetadataLength, simState.Rand,
+		func(r *rand.Rand) { params.MaxMetadataLength = uint64(r.Intn(512) + 64) })
+
+	// no flat fee, and the fee in basis points is charged on the declared
+	// value, which the operations do not declare
+	simState.AppParams.GetOrGenerate(ExecFeeBps, &params.ExecFeeBps, simState.Rand,
+		func(r *rand.Rand) { params.ExecFeeBps = uint32(r.Intn(100)) })
+
+	// the operations use at most two actions per phase
+	simState.AppParams.GetOrGenerate(MaxPreActions, &params.MaxPreActions, simState.Rand,
+		func(r *rand.Rand) { params.MaxPreActions = uint64(r.Intn(15) + 2) })
+	simState.AppParams.GetOrGenerate(MaxPostActions, &params.MaxPostActions, simState.Rand,
+		func(r *rand.Rand) { params.MaxPostActions = uint64(r.Intn(15) + 2) })
+	simState.AppParams.GetOrGenerate(MaxRefundActions, &params.MaxRefundActions, simState.Rand,
+		func(r *rand.Rand) { params.MaxRefundActions = uint64(r.Intn(15) + 2) })
+	simState.AppParams.GetOrGenerate(MaxSimulateGas, &params.MaxSimulateGas, simState.Rand,
+		func(r *rand.Rand) { params.MaxSimulateGas = uint64(r.Intn(9000000) + 1000000) })
+	simState.AppParams.GetOrGenerate(MaxActions, &params.MaxActions, simState.Rand,
+		func(r *rand.Rand) { params.MaxActions = uint64(r.Intn(29) + 4) })
+	simState.AppParams.GetOrGenerate(MaxAgentsPerExec, &params.MaxAgentsPerExec, simState.Rand,
+		func(r *rand.Rand) { params.MaxAgentsPerExec = uint64(r.Intn(16) + 1) })
+	simState.AppParams.GetOrGenerate(MaxNestingDepth, &params.MaxNestingDepth, simState.Rand,
+		func(r *rand.Rand) { params.MaxNestingDepth = uint64(r.Intn(3) + 1) })
+
+	// a small cap leaves some of the expired proposals for the later blocks
+	simState.AppParams.GetOrGenerate(MaxPrunesPerBlock, &params.MaxPrunesPerBlock, simState.Rand,
+		func(r *rand.Rand) { params.MaxPrunesPerBlock = uint64(r.Intn(4) + 1) })
+
+	gs := &escrowv1alpha1.GenesisState{
+		Params:    &params,
+		NextAgent: defaultGenesis.NextAgent,
+		Agents:    []*escrowv1alpha1.GenesisState_Agent{},
+		Proposals: []*escrowv1alpha1.GenesisState_Proposal{},
+	}
+	simState.GenState[escrowv1alpha1.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
+}
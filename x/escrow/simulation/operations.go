@@ -0,0 +1,700 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	escrowv1alpha1 "github.com/0tech/andromeda/x/escrow/andromeda/escrow/v1alpha1"
+	"github.com/0tech/andromeda/x/escrow/keeper"
+)
+
+// the keys of the operation weights
+const (
+	OpWeightMsgCreateAgent    = "op_weight_msg_create_agent"
+	OpWeightMsgSubmitProposal = "op_weight_msg_submit_proposal"
+	OpWeightMsgExec           = "op_weight_msg_exec"
+	OpWeightMsgUpdateProposal = "op_weight_msg_update_proposal"
+	OpWeightMsgCancelProposal = "op_weight_msg_cancel_proposal"
+)
+
+// the default operation weights
+const (
+	WeightCreateAgent    = 100
+	WeightSubmitProposal = 100
+	WeightExec           = 50
+	WeightUpdateProposal = 20
+	WeightCancelProposal = 10
+)
+
+var (
+	TypeMsgCreateAgent    = sdk.MsgTypeURL(&escrowv1alpha1.MsgCreateAgent{})
+	TypeMsgSubmitProposal = sdk.MsgTypeURL(&escrowv1alpha1.MsgSubmitProposal{})
+	TypeMsgExec           = sdk.MsgTypeURL(&escrowv1alpha1.MsgExec{})
+	TypeMsgUpdateProposal = sdk.MsgTypeURL(&escrowv1alpha1.MsgUpdateProposal{})
+	TypeMsgCancelProposal = sdk.MsgTypeURL(&escrowv1alpha1.MsgCancelProposal{})
+)
+
+// WeightedOperations returns all the operations of the module with their
+// respective weights. The nft keeper is optional; without it, the proposals
+// trade coins only.
+func WeightedOperations(
+	registry codectypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	nk NFTKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgCreateAgent, weightMsgSubmitProposal, weightMsgExec, weightMsgUpdateProposal, weightMsgCancelProposal int
+
+	appParams.GetOrGenerate(OpWeightMsgCreateAgent, &weightMsgCreateAgent, nil,
+		func(_ *rand.Rand) { weightMsgCreateAgent = WeightCreateAgent })
+	appParams.GetOrGenerate(OpWeightMsgSubmitProposal, &weightMsgSubmitProposal, nil,
+		func(_ *rand.Rand) { weightMsgSubmitProposal = WeightSubmitProposal })
+	appParams.GetOrGenerate(OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) { weightMsgExec = WeightExec })
+	appParams.GetOrGenerate(OpWeightMsgUpdateProposal, &weightMsgUpdateProposal, nil,
+		func(_ *rand.Rand) { weightMsgUpdateProposal = WeightUpdateProposal })
+	appParams.GetOrGenerate(OpWeightMsgCancelProposal, &weightMsgCancelProposal, nil,
+		func(_ *rand.Rand) { weightMsgCancelProposal = WeightCancelProposal })
+
+	cdc := codec.NewProtoCodec(registry)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateAgent,
+			SimulateMsgCreateAgent(cdc, txCfg, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitProposal,
+			SimulateMsgSubmitProposal(cdc, txCfg, ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(cdc, txCfg, ak, bk, nk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateProposal,
+			SimulateMsgUpdateProposal(cdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelProposal,
+			SimulateMsgCancelProposal(cdc, txCfg, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateAgent generates a MsgCreateAgent of a random creator.
+func SimulateMsgCreateAgent(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+		creatorStr, err := ak.AddressCodec().BytesToString(creator.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgCreateAgent, err.Error()), nil, err
+		}
+
+		msg := &escrowv1alpha1.MsgCreateAgent{
+			Creator: creatorStr,
+		}
+
+		return deliver(r, app, ctx, cdc, txCfg, ak, bk, creator, msg, nil)
+	}
+}
+
+// SimulateMsgSubmitProposal generates a MsgSubmitProposal on a random idle
+// agent, proposed by its creator. The proposal offers an asset of the proposer
+// and asks for an asset of another account. Some of the proposals expire in a
+// few blocks, and some of those trading coins are partially fillable by units.
+func SimulateMsgSubmitProposal(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	nk NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		res, err := keeper.NewQueryServer(k).Agents(ctx, &escrowv1alpha1.QueryAgentsRequest{})
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+		if len(res.Agents) == 0 {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, "no idle agent"), nil, nil
+		}
+		agent := res.Agents[r.Intn(len(res.Agents))]
+		agentStr, proposerStr := agent.Address, agent.Creator
+
+		proposerAddr, err := ak.AddressCodec().StringToBytes(proposerStr)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+
+		proposer, found := simtypes.FindAccount(accs, sdk.AccAddress(proposerAddr))
+		if !found {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, "no proposer"), nil, nil
+		}
+
+		offered, ok := randAsset(ctx, r, bk, nk, proposer.Address)
+		if !ok {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, "no asset to offer"), nil, nil
+		}
+
+		counterparty, ok := randCounterparty(r, accs, proposer.Address)
+		if !ok {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, "no counterparty"), nil, nil
+		}
+
+		asked, ok := randAsset(ctx, r, bk, nk, counterparty.Address)
+		if !ok {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, "no asset to ask"), nil, nil
+		}
+
+		// the post_actions and the refund_actions of a fillable proposal are
+		// of a unit, while its pre_actions are of all the units
+		var quantity uint64
+		offeredUnit, askedUnit := offered, asked
+		if offered.nft == nil && asked.nft == nil && r.Intn(3) == 0 {
+			units := uint64(r.Intn(4) + 2)
+			offeredUnit = asset{coins: offered.coins.QuoInt(math.NewIntFromUint64(units))}
+			askedUnit = asset{coins: asked.coins.QuoInt(math.NewIntFromUint64(units))}
+			if offeredUnit.coins.Empty() || askedUnit.coins.Empty() {
+				offeredUnit, askedUnit = offered, asked
+			} else {
+				quantity = units
+				offered = asset{coins: offeredUnit.coins.MulInt(math.NewIntFromUint64(units))}
+			}
+		}
+
+		actions, err := encodeMsgs(
+			offered.send(proposerStr, agentStr),
+			askedUnit.send(agentStr, proposerStr),
+			offeredUnit.send(agentStr, proposerStr),
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+
+		msg := &escrowv1alpha1.MsgSubmitProposal{
+			Proposer:      proposerStr,
+			Agent:         agentStr,
+			PreActions:    actions[:1],
+			PostActions:   actions[1:2],
+			Metadata:      randMetadata(r),
+			RefundActions: actions[2:],
+			Quantity:      quantity,
+		}
+
+		// the expired proposals are pruned in the following blocks
+		if r.Intn(2) == 0 {
+			msg.ExpireHeight = uint64(ctx.BlockHeight()) + uint64(r.Intn(20)+1)
+		}
+
+		opMsg, futureOps, err := deliver(r, app, ctx, cdc, txCfg, ak, bk, proposer, msg, offered.coins)
+		if err != nil || !opMsg.OK {
+			return opMsg, futureOps, err
+		}
+
+		// the proposal is followed by its execution, update or cancellation in
+		// a few blocks, as the random operations would rarely pick it
+		pick := pickProposalOf(k, agentStr)
+		var op simtypes.Operation
+		switch n := r.Intn(10); {
+		case n < 6:
+			op = simulateMsgExec(cdc, txCfg, ak, bk, nk, pick)
+		case n < 8:
+			op = simulateMsgUpdateProposal(cdc, txCfg, ak, bk, pick)
+		default:
+			op = simulateMsgCancelProposal(cdc, txCfg, ak, bk, pick)
+		}
+		futureOps = append(futureOps, simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + r.Intn(5) + 1,
+			Op:          op,
+		})
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgExec generates a MsgExec of a random proposal, whose executor
+// pays the asked asset and takes the offered one. A fillable proposal is
+// filled by a random quantity. It skips the proposal if no account is able to
+// pay for it.
+func SimulateMsgExec(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	nk NFTKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return simulateMsgExec(cdc, txCfg, ak, bk, nk, pickRandProposal(k))
+}
+
+func simulateMsgExec(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	nk NFTKeeper,
+	pick proposalPicker,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposal, ok, err := pick(ctx, r)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, err.Error()), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, "no unexpired proposal"), nil, nil
+		}
+
+		var quantities []uint64
+		var offered, asked []asset
+		if proposal.Quantity == 0 {
+			offered, err = decodeAssets(cdc, proposal.PreActions)
+			if err != nil {
+				return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, err.Error()), nil, nil
+			}
+
+			asked, err = decodeAssets(cdc, proposal.PostActions)
+			if err != nil {
+				return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, err.Error()), nil, nil
+			}
+		} else {
+			fill := uint64(r.Int63n(int64(proposal.Quantity)) + 1)
+			quantities = []uint64{fill}
+
+			// the refund_actions send the offered asset of a unit back
+			offered, err = decodeAssets(cdc, proposal.RefundActions)
+			if err != nil {
+				return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, err.Error()), nil, nil
+			}
+
+			asked, err = decodeAssets(cdc, proposal.PostActions)
+			if err != nil {
+				return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, err.Error()), nil, nil
+			}
+
+			for _, assets := range [][]asset{offered, asked} {
+				for i := range assets {
+					if assets[i].nft != nil {
+						return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, "nft not fillable"), nil, nil
+					}
+					assets[i].coins = assets[i].coins.MulInt(math.NewIntFromUint64(fill))
+				}
+			}
+		}
+
+		var spent sdk.Coins
+		for _, asset := range asked {
+			spent = spent.Add(asset.coins...)
+		}
+		if bk.IsSendEnabledCoins(ctx, spent...) != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, "send disabled"), nil, nil
+		}
+
+		executor, found := findExecutor(ctx, r, ak, bk, nk, accs, proposal.Proposer, asked, spent)
+		if !found {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, "no executor able to pay"), nil, nil
+		}
+
+		executorStr, err := ak.AddressCodec().BytesToString(executor.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, err.Error()), nil, err
+		}
+
+		var msgs []sdk.Msg
+		for _, asset := range asked {
+			msgs = append(msgs, asset.send(executorStr, proposal.Agent))
+		}
+		for _, asset := range offered {
+			msgs = append(msgs, asset.send(proposal.Agent, executorStr))
+		}
+
+		actions, err := encodeMsgs(msgs...)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgExec, err.Error()), nil, err
+		}
+
+		msg := &escrowv1alpha1.MsgExec{
+			Executor:   executorStr,
+			Agents:     []string{proposal.Agent},
+			Actions:    actions,
+			Quantities: quantities,
+		}
+
+		return deliver(r, app, ctx, cdc, txCfg, ak, bk, executor, msg, spent)
+	}
+}
+
+// SimulateMsgUpdateProposal generates a MsgUpdateProposal of a random
+// proposal, which replaces its metadata and may ask for the coins of another
+// account instead.
+func SimulateMsgUpdateProposal(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return simulateMsgUpdateProposal(cdc, txCfg, ak, bk, pickRandProposal(k))
+}
+
+func simulateMsgUpdateProposal(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	pick proposalPicker,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposal, ok, err := pick(ctx, r)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgUpdateProposal, err.Error()), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgUpdateProposal, "no unexpired proposal"), nil, nil
+		}
+
+		proposerAddr, err := ak.AddressCodec().StringToBytes(proposal.Proposer)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgUpdateProposal, err.Error()), nil, err
+		}
+
+		proposer, found := simtypes.FindAccount(accs, sdk.AccAddress(proposerAddr))
+		if !found {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgUpdateProposal, "no proposer"), nil, nil
+		}
+
+		msg := &escrowv1alpha1.MsgUpdateProposal{
+			Proposer: proposal.Proposer,
+			Agent:    proposal.Agent,
+			Metadata: randMetadata(r),
+		}
+
+		// the coins asked by a fillable proposal are of a unit
+		if counterparty, ok := randCounterparty(r, accs, proposer.Address); ok && r.Intn(2) == 0 {
+			coins := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, counterparty.Address))
+			if !coins.Empty() && bk.IsSendEnabledCoins(ctx, coins...) == nil {
+				msg.PostActions, err = encodeMsgs(asset{coins: coins}.send(proposal.Agent, proposal.Proposer))
+				if err != nil {
+					return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgUpdateProposal, err.Error()), nil, err
+				}
+			}
+		}
+
+		return deliver(r, app, ctx, cdc, txCfg, ak, bk, proposer, msg, nil)
+	}
+}
+
+// SimulateMsgCancelProposal generates a MsgCancelProposal of a random
+// proposal, whose refund_actions return the offered asset to its proposer.
+func SimulateMsgCancelProposal(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return simulateMsgCancelProposal(cdc, txCfg, ak, bk, pickRandProposal(k))
+}
+
+func simulateMsgCancelProposal(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	pick proposalPicker,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposal, ok, err := pick(ctx, r)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgCancelProposal, err.Error()), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgCancelProposal, "no unexpired proposal"), nil, nil
+		}
+
+		proposerAddr, err := ak.AddressCodec().StringToBytes(proposal.Proposer)
+		if err != nil {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgCancelProposal, err.Error()), nil, err
+		}
+
+		proposer, found := simtypes.FindAccount(accs, sdk.AccAddress(proposerAddr))
+		if !found {
+			return simtypes.NoOpMsg(escrowv1alpha1.ModuleName, TypeMsgCancelProposal, "no proposer"), nil, nil
+		}
+
+		msg := &escrowv1alpha1.MsgCancelProposal{
+			Proposer: proposal.Proposer,
+			Agent:    proposal.Agent,
+		}
+
+		return deliver(r, app, ctx, cdc, txCfg, ak, bk, proposer, msg, nil)
+	}
+}
+
+// proposalPicker picks the proposal which an operation acts on, returning
+// false if there is no proposal to act on.
+type proposalPicker func(ctx sdk.Context, r *rand.Rand) (*escrowv1alpha1.QueryProposalsResponse_Proposal, bool, error)
+
+// pickRandProposal picks a random proposal which has not expired yet.
+func pickRandProposal(k keeper.Keeper) proposalPicker {
+	return func(ctx sdk.Context, r *rand.Rand) (*escrowv1alpha1.QueryProposalsResponse_Proposal, bool, error) {
+		res, err := keeper.NewQueryServer(k).Proposals(ctx, &escrowv1alpha1.QueryProposalsRequest{})
+		if err != nil {
+			return nil, false, err
+		}
+
+		var proposals []*escrowv1alpha1.QueryProposalsResponse_Proposal
+		for _, proposal := range res.Proposals {
+			if !isExpired(ctx, proposal) {
+				proposals = append(proposals, proposal)
+			}
+		}
+		if len(proposals) == 0 {
+			return nil, false, nil
+		}
+
+		return proposals[r.Intn(len(proposals))], true, nil
+	}
+}
+
+// pickProposalOf picks the proposal of the agent, if it exists and has not
+// expired yet.
+func pickProposalOf(k keeper.Keeper, agent string) proposalPicker {
+	return func(ctx sdk.Context, _ *rand.Rand) (*escrowv1alpha1.QueryProposalsResponse_Proposal, bool, error) {
+		res, err := keeper.NewQueryServer(k).Proposal(ctx, &escrowv1alpha1.QueryProposalRequest{
+			Agent: agent,
+		})
+		if err != nil {
+			// the proposal may have been executed or cancelled in between
+			if errors.Is(err, escrowv1alpha1.ErrProposalNotFound) {
+				return nil, false, nil
+			}
+			return nil, false, err
+		}
+
+		proposal := (*escrowv1alpha1.QueryProposalsResponse_Proposal)(res.Proposal)
+		if isExpired(ctx, proposal) {
+			return nil, false, nil
+		}
+
+		return proposal, true, nil
+	}
+}
+
+// isExpired returns whether the proposal has expired at the current block.
+func isExpired(ctx sdk.Context, proposal *escrowv1alpha1.QueryProposalsResponse_Proposal) bool {
+	if proposal.ExpireHeight != 0 && proposal.ExpireHeight <= uint64(ctx.BlockHeight()) {
+		return true
+	}
+
+	return proposal.ExpireTime != nil && !proposal.ExpireTime.After(ctx.BlockTime())
+}
+
+// randCounterparty picks a random account other than the given one.
+func randCounterparty(r *rand.Rand, accs []simtypes.Account, other sdk.AccAddress) (simtypes.Account, bool) {
+	counterparty, _ := simtypes.RandomAcc(r, accs)
+	if counterparty.Address.Equals(other) {
+		return simtypes.Account{}, false
+	}
+
+	return counterparty, true
+}
+
+// findExecutor finds an account other than the proposer, which owns the asked
+// nfts and is able to spend the asked coins.
+func findExecutor(ctx sdk.Context, r *rand.Rand, ak AccountKeeper, bk BankKeeper, nk NFTKeeper, accs []simtypes.Account, proposerStr string, asked []asset, spent sdk.Coins) (simtypes.Account, bool) {
+	proposer, err := ak.AddressCodec().StringToBytes(proposerStr)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+
+	// the owner of the asked nft, if any, is the only possible executor
+	for _, asset := range asked {
+		if asset.nft == nil {
+			continue
+		}
+		if nk == nil {
+			return simtypes.Account{}, false
+		}
+
+		account, found := simtypes.FindAccount(accs, nk.GetOwner(ctx, asset.nft.ClassId, asset.nft.Id))
+		if !found {
+			return simtypes.Account{}, false
+		}
+		accs = []simtypes.Account{account}
+	}
+
+	for _, i := range r.Perm(len(accs)) {
+		candidate := accs[i]
+		if candidate.Address.Equals(sdk.AccAddress(proposer)) {
+			continue
+		}
+
+		if spent.IsAllLTE(bk.SpendableCoins(ctx, candidate.Address)) {
+			return candidate, true
+		}
+	}
+
+	return simtypes.Account{}, false
+}
+
+// randMetadata returns a random non-empty metadata.
+func randMetadata(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, r.Intn(63)+1)
+}
+
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak AccountKeeper,
+	bk BankKeeper,
+	signer simtypes.Account,
+	msg sdk.Msg,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txCfg,
+		Cdc:             cdc,
+		Msg:             msg,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      escrowv1alpha1.ModuleName,
+	})
+}
+
+// asset is either of coins or an nft, which the operations trade.
+type asset struct {
+	coins sdk.Coins
+	nft   *nft.NFT
+}
+
+// send returns the message which sends the asset.
+func (a asset) send(sender, recipient string) sdk.Msg {
+	if a.nft != nil {
+		return &nft.MsgSend{
+			ClassId:  a.nft.ClassId,
+			Id:       a.nft.Id,
+			Sender:   sender,
+			Receiver: recipient,
+		}
+	}
+
+	return &banktypes.MsgSend{
+		FromAddress: sender,
+		ToAddress:   recipient,
+		Amount:      a.coins,
+	}
+}
+
+// randAsset picks a random asset of the owner, which is either of its nfts or
+// a subset of its spendable coins.
+func randAsset(ctx sdk.Context, r *rand.Rand, bk BankKeeper, nk NFTKeeper, owner sdk.AccAddress) (asset, bool) {
+	var nfts []nft.NFT
+	if nk != nil {
+		for _, class := range nk.GetClasses(ctx) {
+			nfts = append(nfts, nk.GetNFTsOfClassByOwner(ctx, class.Id, owner)...)
+		}
+	}
+
+	// the coins of the denoms whose sends are disabled are not tradable
+	var sendable sdk.Coins
+	for _, coin := range bk.SpendableCoins(ctx, owner) {
+		if bk.IsSendEnabledCoins(ctx, coin) == nil {
+			sendable = append(sendable, coin)
+		}
+	}
+
+	if len(nfts) != 0 && (sendable.Empty() || r.Intn(2) == 0) {
+		return asset{nft: &nfts[r.Intn(len(nfts))]}, true
+	}
+	if sendable.Empty() {
+		return asset{}, false
+	}
+
+	// the random subset may be empty, so fall back on a coin of a random denom
+	coins := simtypes.RandSubsetCoins(r, sendable)
+	if coins.Empty() {
+		coin := sendable[r.Intn(len(sendable))]
+		amount := simtypes.RandomAmount(r, coin.Amount)
+		if amount.IsZero() {
+			amount = math.OneInt()
+		}
+		coins = sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return asset{coins: coins}, true
+}
+
+// decodeAssets returns the assets sent by the actions, which must be of the
+// sends generated by the operations.
+func decodeAssets(cdc *codec.ProtoCodec, actions []*codectypes.Any) ([]asset, error) {
+	assets := make([]asset, len(actions))
+	for i, action := range actions {
+		var msg sdk.Msg
+		if err := cdc.UnpackAny(action, &msg); err != nil {
+			return nil, err
+		}
+
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			assets[i] = asset{coins: msg.Amount}
+		case *nft.MsgSend:
+			assets[i] = asset{nft: &nft.NFT{ClassId: msg.ClassId, Id: msg.Id}}
+		default:
+			return nil, escrowv1alpha1.ErrUnimplemented.Wrapf("unsupported action %s", action.TypeUrl)
+		}
+	}
+
+	return assets, nil
+}
+
+func encodeMsgs(msgs ...sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}